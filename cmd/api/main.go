@@ -1,20 +1,18 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	_ "github.com/cxnub/fas-mgmt-system/docs"
 	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
-	"github.com/cxnub/fas-mgmt-system/internal/adapter/handler/http"
-	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
-	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/repository"
-	pg "github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/sqlc"
-	_ "github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/logger"
 	_ "github.com/cxnub/fas-mgmt-system/internal/core/port"
-	"github.com/cxnub/fas-mgmt-system/internal/core/service"
 	_ "github.com/swaggo/files"       // Swagger files
 	_ "github.com/swaggo/gin-swagger" // Required for Swagger documentation
 	"golang.org/x/net/context"
 	"log"
+	"log/slog"
+	"os"
 )
 
 // @title FAS Management System API
@@ -24,49 +22,61 @@ import (
 // @license.url https://opensource.org/licenses/MIT
 // @host localhost:8080
 // @BasePath /api
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access token from /auth/login, sent as "Bearer <token>".
 func main() {
 	cfg := config.New()
-
-	// Init Database
 	ctx := context.Background()
-	db, err := postgres.New(ctx, cfg)
-	q := pg.New(db)
 
+	appLogger, err := logger.New(cfg)
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer db.Close()
-
-	// Dependency Injection
-	applicantRepo := repository.NewApplicantRepository(db, q)
-	applicantService := service.NewApplicantService(applicantRepo)
-	applicantHandler := http.NewApplicantHandler(applicantService)
+	slog.SetDefault(appLogger)
 
-	schemeRepo := repository.NewSchemeRepository(db, q)
-	schemeService := service.NewSchemeService(schemeRepo, applicantRepo)
-	schemeHandler := http.NewSchemeHandler(schemeService)
-
-	applicationRepo := repository.NewApplicationRepository(db, q)
-	applicationService := service.NewApplicationService(applicationRepo, applicantRepo, schemeRepo)
-	applicationHandler := http.NewApplicationHandler(applicationService)
+	// Serve the API if no command is given
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
 
-	// Init Router
-	router, err := http.NewRouter(
-		cfg,
-		*applicantHandler,
-		*schemeHandler,
-		*applicationHandler,
-	)
+	switch command {
+	case "serve":
+		err = serve(ctx, cfg)
+	case "migrate":
+		err = runMigrate(cfg, args)
+	case "seed":
+		err = seed(ctx, cfg)
+	default:
+		err = usageError(fmt.Sprintf("unknown command %q\n\n%s", command, usage))
+	}
 
-	if err != nil {
-		log.Fatal(err)
+	// Usage is printed as it is, since it spans several lines
+	var usageErr usageError
+	if errors.As(err, &usageErr) {
+		fmt.Fprintln(os.Stderr, usageErr)
+		os.Exit(2)
 	}
 
-	// Start server
-	listenAddr := fmt.Sprintf("%s:%s", cfg.ApiUrl, cfg.ApiPort)
-	log.Print("Starting the HTTP Server", "listen_address", listenAddr)
-	err = router.Serve(listenAddr)
 	if err != nil {
-		log.Fatal(err)
+		slog.Error("Command failed", "command", command, "error", err)
+		os.Exit(1)
 	}
 }
+
+// usageError is returned when a command is given invalid arguments, and describes how the command is used
+type usageError string
+
+func (e usageError) Error() string {
+	return string(e)
+}
+
+// usage describes the commands of the binary
+const usage = `usage: api [command]
+
+commands:
+  serve     start the API server (the default)
+  migrate   migrate the database, see "api migrate" for details
+  seed      create the first superadmin and add sample data`
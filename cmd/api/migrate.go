@@ -0,0 +1,113 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
+	"golang.org/x/net/context"
+	"log/slog"
+	"strconv"
+)
+
+// migrateUsage describes the arguments of the migrate command
+const migrateUsage = `usage: api migrate <command>
+
+commands:
+  up        apply every migration that has not been applied yet
+  down [N]  revert the last N migrations (1 if N is not given)
+  status    show the version of the database schema and of the latest migration
+  to N      apply or revert migrations until the database is at version N`
+
+// runMigrate migrates the Postgres database with the migrations embedded in the binary
+func runMigrate(cfg *config.Config, args []string) (err error) {
+	if len(args) == 0 {
+		return usageError(migrateUsage)
+	}
+
+	// Parse the command before connecting to the database
+	var migrate func(migrator *postgres.Migrator) error
+
+	switch command, args := args[0], args[1:]; {
+	case command == "up" && len(args) == 0:
+		migrate = (*postgres.Migrator).Up
+
+	case command == "down" && len(args) <= 1:
+		steps := 1
+		if len(args) == 1 {
+			if steps, err = strconv.Atoi(args[0]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of migrations %q", args[0])
+			}
+		}
+		migrate = func(migrator *postgres.Migrator) error { return migrator.Down(steps) }
+
+	case command == "to" && len(args) == 1:
+		version, err := strconv.ParseUint(args[0], 10, 0)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[0])
+		}
+		migrate = func(migrator *postgres.Migrator) error { return migrator.To(uint(version)) }
+
+	case command == "status" && len(args) == 0:
+		migrate = func(migrator *postgres.Migrator) error { return nil }
+
+	default:
+		return usageError(migrateUsage)
+	}
+
+	if cfg.StorageDriver != "postgres" {
+		return fmt.Errorf("the %s storage driver has no migrations", cfg.StorageDriver)
+	}
+
+	migrator, err := postgres.NewMigrator(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, migrator.Close())
+	}()
+
+	if err = migrate(migrator); err != nil {
+		return err
+	}
+
+	return printMigrationStatus(migrator)
+}
+
+// printMigrationStatus logs the version of the database schema and of the latest migration
+func printMigrationStatus(migrator *postgres.Migrator) error {
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+
+	attrs := []any{"version", status.Version, "latest", status.Latest}
+
+	switch {
+	case status.Dirty:
+		slog.Error("Database migration failed to apply and must be fixed by hand", attrs...)
+	case status.Version > status.Latest:
+		slog.Warn("Database is ahead of the latest migration in this binary", attrs...)
+	case status.UpToDate():
+		slog.Info("Database is up to date", attrs...)
+	default:
+		slog.Info("Database is behind the latest migration", attrs...)
+	}
+
+	return nil
+}
+
+// checkSchemaVersion returns an error if a migration in the binary has not been applied to the database
+func checkSchemaVersion(ctx context.Context, db *postgres.DB) error {
+	status, err := db.MigrationStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !status.UpToDate() {
+		return fmt.Errorf("database schema is at version %d (dirty: %t) but the latest migration is %d, run \"api migrate up\" first",
+			status.Version, status.Dirty, status.Latest)
+	}
+
+	return nil
+}
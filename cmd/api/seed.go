@@ -0,0 +1,62 @@
+package main
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/service"
+	"golang.org/x/net/context"
+	"log/slog"
+)
+
+// seed creates the first superadmin from ADMIN_EMAIL and ADMIN_PASSWORD if they are set and there are no users yet,
+// and adds the sample applicants, relationships, schemes and application of the seeder migration, with the same IDs,
+// if they are not there yet. Running it again changes nothing.
+func seed(ctx context.Context, cfg *config.Config) error {
+	store, err := newStorage(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer store.close()
+
+	userService := service.NewUserService(store.userRepo, store.transactor)
+
+	if cfg.AdminEmail != "" && cfg.AdminPassword != "" {
+		admin, err := createFirstSuperadmin(ctx, cfg, userService)
+		if err != nil {
+			return err
+		}
+
+		if admin == nil {
+			slog.Info("Users already exist, no superadmin was created")
+		} else {
+			slog.Info("Created superadmin", "email", *admin.Email)
+		}
+	} else {
+		slog.Info("ADMIN_EMAIL and ADMIN_PASSWORD are not set, no superadmin was created")
+	}
+
+	seeded, err := store.seedSampleData(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !seeded {
+		slog.Info("Sample data already exists, none was added")
+		return nil
+	}
+
+	slog.Info("Added sample data")
+	return nil
+}
+
+// createFirstSuperadmin creates a superadmin from ADMIN_EMAIL and ADMIN_PASSWORD if there are no users yet.
+// It returns nil if users already exist.
+func createFirstSuperadmin(ctx context.Context, cfg *config.Config, userService *service.UserService) (*domain.User, error) {
+	adminName := "Administrator"
+	admin := domain.User{
+		Email: &cfg.AdminEmail,
+		Name:  &adminName,
+	}
+
+	return userService.CreateFirstSuperadmin(ctx, &admin, cfg.AdminPassword)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/auth"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/handler/http"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/metrics"
+	"github.com/cxnub/fas-mgmt-system/internal/core/service"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// serve starts the API server and runs it until it receives SIGINT or SIGTERM.
+// Requests in flight are then given time to finish before the storage is closed.
+func serve(ctx context.Context, cfg *config.Config) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Leave gin in debug mode only when debug logs are wanted, since it prints its routes and warnings outside the logger
+	if !slog.Default().Enabled(ctx, slog.LevelDebug) {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	// Init Storage
+	store, err := newStorage(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer store.close()
+
+	// Init Metrics
+	apiMetrics := metrics.New()
+	if store.collector != nil {
+		if err = apiMetrics.Register(store.collector); err != nil {
+			return err
+		}
+	}
+
+	// Dependency Injection
+	tokenService, err := auth.NewJWTService(cfg)
+	if err != nil {
+		return err
+	}
+
+	userService := service.NewUserService(store.userRepo, store.transactor)
+	userHandler := http.NewUserHandler(userService)
+
+	authService := service.NewAuthService(store.userRepo, tokenService)
+	authHandler := http.NewAuthHandler(authService, userService)
+
+	// Create the first superadmin so that the API can be signed into after it is first set up
+	if cfg.AdminEmail != "" && cfg.AdminPassword != "" {
+		if _, err = createFirstSuperadmin(ctx, cfg, userService); err != nil {
+			return err
+		}
+	}
+
+	applicantService := service.NewApplicantService(store.applicantRepo)
+	applicantHandler := http.NewApplicantHandler(applicantService)
+
+	relationshipService := service.NewRelationshipService(store.relationshipRepo, store.applicantRepo, store.transactor)
+	relationshipHandler := http.NewRelationshipHandler(relationshipService)
+
+	schemeService := service.NewSchemeService(store.schemeRepo, store.applicantRepo, store.transactor, apiMetrics)
+	schemeHandler := http.NewSchemeHandler(schemeService)
+
+	applicationService := service.NewApplicationService(store.applicationRepo, store.applicantRepo, store.schemeRepo, store.disbursementRepo, store.transactor, apiMetrics)
+	applicationHandler := http.NewApplicationHandler(applicationService)
+
+	disbursementService := service.NewDisbursementService(store.disbursementRepo, store.applicationRepo, store.applicantRepo, store.transactor)
+	disbursementHandler := http.NewDisbursementHandler(disbursementService)
+
+	auditService := service.NewAuditService(store.auditRepo)
+	auditHandler := http.NewAuditHandler(auditService)
+
+	criteriaHandler := http.NewCriteriaHandler()
+
+	healthHandler := http.NewHealthHandler(store.health)
+	metricsHandler := http.NewMetricsHandler(apiMetrics)
+
+	// Init Router
+	router, err := http.NewRouter(
+		cfg,
+		authService,
+		*authHandler,
+		*userHandler,
+		*auditHandler,
+		*applicantHandler,
+		*relationshipHandler,
+		*schemeHandler,
+		*applicationHandler,
+		*criteriaHandler,
+		*disbursementHandler,
+		*healthHandler,
+		*metricsHandler,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	// Start server
+	listenAddr := fmt.Sprintf("%s:%s", cfg.ApiUrl, cfg.ApiPort)
+	slog.Info("Starting the HTTP server", "listen_address", listenAddr)
+	if err = router.Serve(ctx, cfg); err != nil {
+		return err
+	}
+
+	slog.Info("HTTP server stopped")
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/metrics"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/memory"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/repository"
+	pg "github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/sqlc"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+)
+
+// storage holds the repositories the services are built on, and the transactor that groups their calls into transactions
+type storage struct {
+	transactor       port.Transactor
+	health           port.HealthChecker
+	userRepo         port.UserRepository
+	auditRepo        port.AuditRepository
+	applicantRepo    port.ApplicantRepository
+	relationshipRepo port.RelationshipRepository
+	schemeRepo       port.SchemeRepository
+	applicationRepo  port.ApplicationRepository
+	disbursementRepo port.DisbursementRepository
+
+	// seedSampleData adds the sample data of the seeder migration unless it is there already, and reports whether it did
+	seedSampleData func(ctx context.Context) (bool, error)
+
+	// collector exposes the metrics of the storage, such as its connection pool, or is nil if it has none
+	collector prometheus.Collector
+
+	// close releases the resources held by the storage
+	close func()
+}
+
+// newStorage creates the repositories of the storage driver chosen in the config
+func newStorage(ctx context.Context, cfg *config.Config) (*storage, error) {
+	switch cfg.StorageDriver {
+	case "postgres":
+		db, err := postgres.New(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg.DBRequireLatestSchema {
+			if err = checkSchemaVersion(ctx, db); err != nil {
+				db.Close()
+				return nil, err
+			}
+		}
+		q := pg.New(db)
+
+		return &storage{
+			transactor:       db,
+			health:           db,
+			userRepo:         repository.NewUserRepository(db, q),
+			auditRepo:        repository.NewAuditRepository(db, q),
+			applicantRepo:    repository.NewApplicantRepository(db, q),
+			relationshipRepo: repository.NewRelationshipRepository(db, q),
+			schemeRepo:       repository.NewSchemeRepository(db, q),
+			applicationRepo:  repository.NewApplicationRepository(db, q),
+			disbursementRepo: repository.NewDisbursementRepository(db, q),
+			seedSampleData:   db.SeedSampleData,
+			collector:        metrics.NewPoolCollector(db),
+			close:            db.Close,
+		}, nil
+
+	case "memory":
+		store := memory.NewStore()
+
+		// The store starts out with the sample data, like a database the migrations have been applied to
+		if _, err := store.SeedSampleData(ctx); err != nil {
+			return nil, err
+		}
+
+		return &storage{
+			transactor:       store,
+			health:           store,
+			userRepo:         memory.NewUserRepository(store),
+			auditRepo:        memory.NewAuditRepository(store),
+			applicantRepo:    memory.NewApplicantRepository(store),
+			relationshipRepo: memory.NewRelationshipRepository(store),
+			schemeRepo:       memory.NewSchemeRepository(store),
+			applicationRepo:  memory.NewApplicationRepository(store),
+			disbursementRepo: memory.NewDisbursementRepository(store),
+			seedSampleData:   store.SeedSampleData,
+			close:            func() {},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.StorageDriver)
+	}
+}
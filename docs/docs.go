@@ -21,7 +21,12 @@ const docTemplate = `{
     "paths": {
         "/applicants": {
             "get": {
-                "description": "Retrieves and returns a list of all registered applicants.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a page of registered applicants, optionally sorted and filtered by employment status, marital status and age.",
                 "consumes": [
                     "application/json"
                 ],
@@ -32,14 +37,86 @@ const docTemplate = `{
                     "Applicants"
                 ],
                 "summary": "List All Applicants",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of applicants per page (1-100, default 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the page to retrieve, from the next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "date_of_birth",
+                            "created_at"
+                        ],
+                        "type": "string",
+                        "description": "Field to sort by",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "employed",
+                            "unemployed"
+                        ],
+                        "type": "string",
+                        "description": "Employment status",
+                        "name": "employment_status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "single",
+                            "married",
+                            "widowed",
+                            "divorced"
+                        ],
+                        "type": "string",
+                        "description": "Marital status",
+                        "name": "marital_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum age",
+                        "name": "min_age",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum age",
+                        "name": "max_age",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "Successfully retrieved list of applicants.",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/internal_adapter_handler_http.ApplicantResponse"
-                            }
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicantsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
@@ -51,6 +128,11 @@ const docTemplate = `{
                 }
             },
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "Handles the creation of a new applicant by accepting necessary data and storing it in the system.",
                 "consumes": [
                     "application/json"
@@ -97,6 +179,11 @@ const docTemplate = `{
         },
         "/applicants/{id}": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "Retrieves the details of a single applicant using their unique identifier.",
                 "consumes": [
                     "application/json"
@@ -145,6 +232,11 @@ const docTemplate = `{
                 }
             },
             "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "Updates the specified applicant's details based on the provided payload.",
                 "consumes": [
                     "application/json"
@@ -202,6 +294,11 @@ const docTemplate = `{
                 }
             },
             "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "Deletes the applicant with the specified ID from the system.",
                 "consumes": [
                     "application/json"
@@ -226,7 +323,7 @@ const docTemplate = `{
                     "200": {
                         "description": "Successfully deleted applicant.",
                         "schema": {
-                            "$ref": "#/definitions/http.Response"
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
                         }
                     },
                     "400": {
@@ -250,9 +347,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/applications": {
+        "/applicants/{id}/disbursements": {
             "get": {
-                "description": "Retrieve all applications present in the system.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the disbursements of every application of an applicant, latest due first, and the total amount paid.",
                 "consumes": [
                     "application/json"
                 ],
@@ -260,17 +362,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Applications"
+                    "Disbursements"
+                ],
+                "summary": "Retrieve the payout history of an applicant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Applicant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List all applications",
                 "responses": {
                     "200": {
-                        "description": "Applications retrieved successfully.",
+                        "description": "Payout history retrieved successfully.",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/internal_adapter_handler_http.ApplicationsResponse"
-                            }
+                            "$ref": "#/definitions/internal_adapter_handler_http.PayoutHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid UUID or bad input.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Applicant not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
@@ -280,9 +400,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new application using the specified applicant ID and scheme ID.",
+            }
+        },
+        "/applicants/{id}/relationships": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves all family members linked to the specified applicant.",
                 "consumes": [
                     "application/json"
                 ],
@@ -290,45 +417,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Applications"
+                    "Relationships"
                 ],
-                "summary": "Create a new application",
+                "summary": "List Applicant Relationships",
                 "parameters": [
                     {
-                        "description": "Application creation payload",
-                        "name": "CreateApplicationRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.CreateApplicationRequest"
-                        }
+                        "type": "string",
+                        "description": "Applicant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Application created successfully.",
+                    "200": {
+                        "description": "Successfully retrieved relationships.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.RelationshipsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid input data.",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Applicant Not Found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error.",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/applications/{id}": {
-            "get": {
-                "description": "Get details of an application by its unique ID.",
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Links a family member to the specified applicant. The reverse relationship is created automatically.",
                 "consumes": [
                     "application/json"
                 ],
@@ -336,41 +470,69 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Applications"
+                    "Relationships"
                 ],
-                "summary": "Retrieve application by ID",
+                "summary": "Create a Relationship",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Application ID",
+                        "description": "Applicant ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Payload for creating a relationship",
+                        "name": "CreateRelationshipRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.CreateRelationshipRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Application retrieved successfully.",
+                    "201": {
+                        "description": "Successfully created relationship.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.RelationshipResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid UUID or bad input.",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Application not found.",
+                        "description": "Applicant Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Relationship Already Exists",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/applicants/{id}/relationships/{relationship_id}": {
             "put": {
-                "description": "Update an application's details such as ApplicantID or SchemeID.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the type of an existing relationship. The reverse relationship is updated automatically.",
                 "consumes": [
                     "application/json"
                 ],
@@ -378,48 +540,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Applications"
+                    "Relationships"
                 ],
-                "summary": "Update an application by ID",
+                "summary": "Update a Relationship",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Application ID",
+                        "description": "Applicant ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Application update payload",
-                        "name": "UpdateApplicationRequest",
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "relationship_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payload for updating a relationship",
+                        "name": "UpdateRelationshipRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateApplicationRequest"
+                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateRelationshipRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Application updated successfully.",
+                        "description": "Successfully updated relationship.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.RelationshipResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid input data.",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Application not found.",
+                        "description": "Relationship Not Found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error.",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
@@ -427,7 +596,12 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Remove an application from the system using its unique ID.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a relationship and its reverse relationship.",
                 "consumes": [
                     "application/json"
                 ],
@@ -435,39 +609,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Applications"
+                    "Relationships"
                 ],
-                "summary": "Delete an application by ID",
+                "summary": "Delete a Relationship",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Application ID",
+                        "description": "Applicant ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Relationship ID",
+                        "name": "relationship_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Application deleted successfully.",
+                        "description": "Successfully deleted relationship.",
                         "schema": {
-                            "$ref": "#/definitions/http.Response"
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid UUID or bad input.",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Application not found.",
+                        "description": "Relationship Not Found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error.",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
@@ -475,9 +656,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/schemes": {
+        "/applications": {
             "get": {
-                "description": "Retrieve a comprehensive list of all available schemes.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a page of applications, optionally sorted and filtered by applicant, scheme, status and creation date.",
                 "consumes": [
                     "application/json"
                 ],
@@ -485,21 +671,101 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Applications"
+                ],
+                "summary": "List all applications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of applications per page (1-100, default 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the page to retrieve, from the next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "updated_at",
+                            "status"
+                        ],
+                        "type": "string",
+                        "description": "Field to sort by",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Applicant ID",
+                        "name": "applicant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "submitted",
+                            "under_review",
+                            "approved",
+                            "rejected",
+                            "withdrawn",
+                            "disbursed"
+                        ],
+                        "type": "string",
+                        "description": "Application status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Only include applications created on or after this date",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Only include applications created on or before this date",
+                        "name": "created_to",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List all schemes",
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved list of schemes",
+                        "description": "Applications retrieved successfully.",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
-                            }
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
@@ -507,43 +773,54 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Add a new scheme with the provided details.",
-                "consumes": [
-                    "application/json"
-                ],
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new application using the specified applicant ID and scheme ID.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Applications"
                 ],
-                "summary": "Create a new scheme",
+                "summary": "Create a new application",
                 "parameters": [
                     {
-                        "description": "JSON object containing new scheme details",
-                        "name": "CreateSchemeRequest",
+                        "description": "Application creation payload",
+                        "name": "CreateApplicationRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.CreateSchemeRequest"
+                            "$ref": "#/definitions/internal_adapter_handler_http.CreateApplicationRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Successfully created scheme",
+                        "description": "Application created successfully.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid input data.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Applicant already has an active application for the scheme or is within its reapply cooldown.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
@@ -551,9 +828,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/schemes/benefits/{benefit_id}": {
-            "put": {
-                "description": "Modify an existing benefit of a scheme by specifying the scheme ID and benefit ID.",
+        "/applications/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get details of an application by its unique ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -561,57 +843,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Applications"
                 ],
-                "summary": "Update a benefit of a scheme",
+                "summary": "Retrieve application by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Benefit ID",
-                        "name": "benefit_id",
+                        "description": "Application ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "JSON object with updated benefit details",
-                        "name": "UpdateSchemeBenefitRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateSchemeBenefitRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated benefit",
+                        "description": "Application retrieved successfully.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeBenefitResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid UUID or bad input.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Benefit or Scheme not found",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Application not found.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Remove a benefit from a scheme using its unique identifier.",
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an application's details such as ApplicantID or SchemeID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -619,50 +890,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Applications"
                 ],
-                "summary": "Delete a benefit from a scheme",
+                "summary": "Update an application by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Benefit ID",
-                        "name": "benefit_id",
+                        "description": "Application ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Application update payload",
+                        "name": "UpdateApplicationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateApplicationRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted benefit",
+                        "description": "Application updated successfully.",
                         "schema": {
-                            "$ref": "#/definitions/http.Response"
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid input data.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Benefit not found",
+                        "description": "Application not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Application can no longer be edited.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/schemes/criteria/{scheme_criteria_id}": {
-            "put": {
-                "description": "Modify an existing criteria of a scheme by specifying the scheme ID and criteria ID.",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove an application from the system using its unique ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -670,57 +958,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Applications"
                 ],
-                "summary": "Update a criteria of a scheme",
+                "summary": "Delete an application by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Scheme Criteria ID",
-                        "name": "scheme_criteria_id",
+                        "description": "Application ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "JSON object with updated criteria details",
-                        "name": "UpdateSchemeCriteriaRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateSchemeCriteriaRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated criteria",
+                        "description": "Application deleted successfully.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid UUID or bad input.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Criteria or Scheme not found",
+                        "description": "Application not found.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove a criteria from a scheme using its unique identifier.",
+            }
+        },
+        "/applications/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approves an application that is under review.",
                 "consumes": [
                     "application/json"
                 ],
@@ -728,40 +1013,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Applications"
                 ],
-                "summary": "Delete a criteria from a scheme",
+                "summary": "Approve an application",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Criteria ID",
-                        "name": "scheme_criteria_id",
+                        "description": "Application ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted criteria",
+                        "description": "Application approved successfully.",
                         "schema": {
-                            "$ref": "#/definitions/http.Response"
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid UUID or bad input.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Criteria not found",
+                        "description": "Application not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Invalid status transition.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
@@ -769,9 +1059,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/schemes/eligible": {
-            "get": {
-                "description": "Retrieve a list of schemes available for a specific applicant using their unique identifier.",
+        "/applications/{id}/disburse": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks the benefits of an approved application as disbursed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -779,43 +1074,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Applications"
                 ],
-                "summary": "List Applicant Available Schemes",
+                "summary": "Disburse an application",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Applicant ID",
-                        "name": "applicant",
-                        "in": "query",
+                        "description": "Application ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved available schemes",
+                        "description": "Application disbursed successfully.",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
-                            }
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid UUID or bad input.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Applicant not found",
+                        "description": "Application not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Invalid status transition.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
@@ -823,9 +1120,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/schemes/{scheme_id}": {
+        "/applications/{id}/disbursements": {
             "get": {
-                "description": "Retrieve a scheme using its unique identifier.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the payouts scheduled for an application when it was approved, earliest due first.",
                 "consumes": [
                     "application/json"
                 ],
@@ -833,48 +1135,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Disbursements"
                 ],
-                "summary": "Get Scheme by ID",
+                "summary": "List the disbursements of an application",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Scheme ID",
-                        "name": "scheme_id",
+                        "description": "Application ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved scheme",
+                        "description": "Disbursements retrieved successfully.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.DisbursementsResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid UUID or bad input.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Scheme not found",
+                        "description": "Application not found.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Modify the details of an existing scheme using its unique identifier.",
+            }
+        },
+        "/applications/{id}/disbursements/{disbursement_id}/claw-back": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records that a paid disbursement has been recovered from the applicant.",
                 "consumes": [
                     "application/json"
                 ],
@@ -882,57 +1190,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Disbursements"
                 ],
-                "summary": "Update an existing scheme",
+                "summary": "Claw back a disbursement",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Scheme ID",
-                        "name": "scheme_id",
+                        "description": "Application ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "JSON object with updates to the scheme",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateSchemeRequest"
-                        }
+                        "type": "string",
+                        "description": "Disbursement ID",
+                        "name": "disbursement_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated scheme",
+                        "description": "Disbursement clawed back successfully.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.DisbursementResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid UUID or bad input.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Scheme not found",
+                        "description": "Disbursement not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Invalid status transition.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove a scheme from the system using its unique identifier.",
+            }
+        },
+        "/applications/{id}/disbursements/{disbursement_id}/fail": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records that the payment of a scheduled disbursement failed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -940,40 +1258,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Disbursements"
                 ],
-                "summary": "Delete a scheme",
+                "summary": "Mark a disbursement as failed",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Scheme ID",
-                        "name": "scheme_id",
+                        "description": "Application ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Disbursement ID",
+                        "name": "disbursement_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted scheme",
+                        "description": "Disbursement failed successfully.",
                         "schema": {
-                            "$ref": "#/definitions/http.Response"
+                            "$ref": "#/definitions/internal_adapter_handler_http.DisbursementResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid UUID or bad input.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Scheme not found",
+                        "description": "Disbursement not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Invalid status transition.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
@@ -981,9 +1311,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/schemes/{scheme_id}/benefits": {
+        "/applications/{id}/disbursements/{disbursement_id}/pay": {
             "post": {
-                "description": "Attach a new benefit to an existing scheme by specifying the scheme ID.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records that a scheduled disbursement has been paid.",
                 "consumes": [
                     "application/json"
                 ],
@@ -991,49 +1326,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Disbursements"
                 ],
-                "summary": "Add a benefit to a scheme",
+                "summary": "Mark a disbursement as paid",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Scheme ID",
-                        "name": "scheme_id",
+                        "description": "Application ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "JSON object with benefit details",
-                        "name": "AddSchemeBenefitRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeBenefitRequest"
-                        }
+                        "type": "string",
+                        "description": "Disbursement ID",
+                        "name": "disbursement_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successfully added benefit to scheme",
+                    "200": {
+                        "description": "Disbursement paid successfully.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeBenefitResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.DisbursementResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid UUID or bad input.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Scheme not found",
+                        "description": "Disbursement not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Invalid status transition.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
@@ -1041,9 +1379,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/schemes/{scheme_id}/criteria": {
+        "/applications/{id}/disbursements/{disbursement_id}/retry": {
             "post": {
-                "description": "Add a new criteria to an existing scheme by specifying the scheme ID.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Schedules a failed disbursement to be paid again.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1051,323 +1394,2546 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schemes"
+                    "Disbursements"
                 ],
-                "summary": "Add a criteria to a scheme",
+                "summary": "Retry a failed disbursement",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Scheme ID",
-                        "name": "scheme_id",
+                        "description": "Application ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "JSON object with criteria details",
-                        "name": "AddSchemeCriteriaRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeCriteriaRequest"
-                        }
+                        "type": "string",
+                        "description": "Disbursement ID",
+                        "name": "disbursement_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successfully added criteria to scheme",
+                    "200": {
+                        "description": "Disbursement rescheduled successfully.",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaResponse"
+                            "$ref": "#/definitions/internal_adapter_handler_http.DisbursementResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error occurred",
+                        "description": "Invalid UUID or bad input.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Scheme not found",
+                        "description": "Disbursement not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Invalid status transition.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal server error.",
                         "schema": {
                             "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "big.Int": {
-            "type": "object"
-        },
-        "github_com_cxnub_fas-mgmt-system_internal_core_domain.EmploymentStatus": {
-            "type": "string",
-            "enum": [
-                "employed",
-                "unemployed"
-            ],
-            "x-enum-varnames": [
-                "EmploymentStatusEmployed",
-                "EmploymentStatusUnemployed"
-            ]
-        },
-        "github_com_cxnub_fas-mgmt-system_internal_core_domain.MaritalStatus": {
-            "type": "string",
-            "enum": [
-                "single",
-                "married",
-                "widowed",
-                "divorce"
-            ],
-            "x-enum-varnames": [
-                "MaritalStatusSingle",
-                "MaritalStatusMarried",
-                "MaritalStatusWidowed",
-                "MaritalStatusDivorce"
-            ]
-        },
-        "github_com_cxnub_fas-mgmt-system_internal_core_domain.Sex": {
-            "type": "string",
-            "enum": [
-                "male",
-                "female"
-            ],
-            "x-enum-varnames": [
-                "SexMale",
-                "SexFemale"
-            ]
-        },
-        "http.Header": {
-            "type": "object",
-            "additionalProperties": {
-                "type": "array",
-                "items": {
-                    "type": "string"
-                }
-            }
         },
-        "http.Request": {
-            "type": "object",
-            "properties": {
-                "body": {
-                    "description": "Body is the request's body.\n\nFor client requests, a nil body means the request has no\nbody, such as a GET request. The HTTP Client's Transport\nis responsible for calling the Close method.\n\nFor server requests, the Request Body is always non-nil\nbut will return EOF immediately when no body is present.\nThe Server will close the request body. The ServeHTTP\nHandler does not need to.\n\nBody must allow Read to be called concurrently with Close.\nIn particular, calling Close should unblock a Read waiting\nfor input."
-                },
-                "cancel": {
-                    "description": "Cancel is an optional channel whose closure indicates that the client\nrequest should be regarded as canceled. Not all implementations of\nRoundTripper may support Cancel.\n\nFor server requests, this field is not applicable.\n\nDeprecated: Set the Request's context with NewRequestWithContext\ninstead. If a Request's Cancel field and context are both\nset, it is undefined whether Cancel is respected.",
-                    "type": "object"
-                },
-                "close": {
-                    "description": "Close indicates whether to close the connection after\nreplying to this request (for servers) or after sending this\nrequest and reading its response (for clients).\n\nFor server requests, the HTTP server handles this automatically\nand this field is not needed by Handlers.\n\nFor client requests, setting this field prevents re-use of\nTCP connections between requests to the same hosts, as if\nTransport.DisableKeepAlives were set.",
-                    "type": "boolean"
-                },
-                "contentLength": {
-                    "description": "ContentLength records the length of the associated content.\nThe value -1 indicates that the length is unknown.\nValues \u003e= 0 indicate that the given number of bytes may\nbe read from Body.\n\nFor client requests, a value of 0 with a non-nil Body is\nalso treated as unknown.",
-                    "type": "integer"
-                },
-                "form": {
-                    "description": "Form contains the parsed form data, including both the URL\nfield's query parameters and the PATCH, POST, or PUT form data.\nThis field is only available after ParseForm is called.\nThe HTTP client ignores Form and uses Body instead.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/url.Values"
-                        }
-                    ]
-                },
-                "header": {
-                    "description": "Header contains the request header fields either received\nby the server or to be sent by the client.\n\nIf a server received a request with header lines,\n\n\tHost: example.com\n\taccept-encoding: gzip, deflate\n\tAccept-Language: en-us\n\tfOO: Bar\n\tfoo: two\n\nthen\n\n\tHeader = map[string][]string{\n\t\t\"Accept-Encoding\": {\"gzip, deflate\"},\n\t\t\"Accept-Language\": {\"en-us\"},\n\t\t\"Foo\": {\"Bar\", \"two\"},\n\t}\n\nFor incoming requests, the Host header is promoted to the\nRequest.Host field and removed from the Header map.\n\nHTTP defines that header names are case-insensitive. The\nrequest parser implements this by using CanonicalHeaderKey,\nmaking the first character and any characters following a\nhyphen uppercase and the rest lowercase.\n\nFor client requests, certain headers such as Content-Length\nand Connection are automatically written when needed and\nvalues in Header may be ignored. See the documentation\nfor the Request.Write method.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/http.Header"
-                        }
-                    ]
-                },
-                "host": {
-                    "description": "For server requests, Host specifies the host on which the\nURL is sought. For HTTP/1 (per RFC 7230, section 5.4), this\nis either the value of the \"Host\" header or the host name\ngiven in the URL itself. For HTTP/2, it is the value of the\n\":authority\" pseudo-header field.\nIt may be of the form \"host:port\". For international domain\nnames, Host may be in Punycode or Unicode form. Use\ngolang.org/x/net/idna to convert it to either format if\nneeded.\nTo prevent DNS rebinding attacks, server Handlers should\nvalidate that the Host header has a value for which the\nHandler considers itself authoritative. The included\nServeMux supports patterns registered to particular host\nnames and thus protects its registered Handlers.\n\nFor client requests, Host optionally overrides the Host\nheader to send. If empty, the Request.Write method uses\nthe value of URL.Host. Host may contain an international\ndomain name.",
-                    "type": "string"
-                },
-                "method": {
-                    "description": "Method specifies the HTTP method (GET, POST, PUT, etc.).\nFor client requests, an empty string means GET.",
-                    "type": "string"
-                },
-                "multipartForm": {
-                    "description": "MultipartForm is the parsed multipart form, including file uploads.\nThis field is only available after ParseMultipartForm is called.\nThe HTTP client ignores MultipartForm and uses Body instead.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/multipart.Form"
+        "/applications/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rejects an application that is under review.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Applications"
+                ],
+                "summary": "Reject an application",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Application ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Application rejected successfully.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
                         }
-                    ]
-                },
-                "pattern": {
-                    "description": "Pattern is the [ServeMux] pattern that matched the request.\nIt is empty if the request was not matched against a pattern.",
-                    "type": "string"
-                },
-                "postForm": {
-                    "description": "PostForm contains the parsed form data from PATCH, POST\nor PUT body parameters.\n\nThis field is only available after ParseForm is called.\nThe HTTP client ignores PostForm and uses Body instead.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/url.Values"
+                    },
+                    "400": {
+                        "description": "Invalid UUID or bad input.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
-                    ]
-                },
-                "proto": {
-                    "description": "The protocol version for incoming server requests.\n\nFor client requests, these fields are ignored. The HTTP\nclient code always uses either HTTP/1.1 or HTTP/2.\nSee the docs on Transport for details.",
-                    "type": "string"
-                },
-                "protoMajor": {
-                    "description": "1",
-                    "type": "integer"
-                },
-                "protoMinor": {
-                    "description": "0",
-                    "type": "integer"
-                },
-                "remoteAddr": {
-                    "description": "RemoteAddr allows HTTP servers and other software to record\nthe network address that sent the request, usually for\nlogging. This field is not filled in by ReadRequest and\nhas no defined format. The HTTP server in this package\nsets RemoteAddr to an \"IP:port\" address before invoking a\nhandler.\nThis field is ignored by the HTTP client.",
-                    "type": "string"
-                },
-                "requestURI": {
-                    "description": "RequestURI is the unmodified request-target of the\nRequest-Line (RFC 7230, Section 3.1.1) as sent by the client\nto a server. Usually the URL field should be used instead.\nIt is an error to set this field in an HTTP client request.",
-                    "type": "string"
-                },
-                "response": {
-                    "description": "Response is the redirect response which caused this request\nto be created. This field is only populated during client\nredirects.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/http.Response"
+                    },
+                    "404": {
+                        "description": "Application not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
-                    ]
-                },
-                "tls": {
-                    "description": "TLS allows HTTP servers and other software to record\ninformation about the TLS connection on which the request\nwas received. This field is not filled in by ReadRequest.\nThe HTTP server in this package sets the field for\nTLS-enabled connections before invoking a handler;\notherwise it leaves the field nil.\nThis field is ignored by the HTTP client.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/tls.ConnectionState"
+                    },
+                    "409": {
+                        "description": "Invalid status transition.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
-                    ]
-                },
-                "trailer": {
-                    "description": "Trailer specifies additional headers that are sent after the request\nbody.\n\nFor server requests, the Trailer map initially contains only the\ntrailer keys, with nil values. (The client declares which trailers it\nwill later send.)  While the handler is reading from Body, it must\nnot reference Trailer. After reading from Body returns EOF, Trailer\ncan be read again and will contain non-nil values, if they were sent\nby the client.\n\nFor client requests, Trailer must be initialized to a map containing\nthe trailer keys to later send. The values may be nil or their final\nvalues. The ContentLength must be 0 or -1, to send a chunked request.\nAfter the HTTP request is sent the map values can be updated while\nthe request body is read. Once the body returns EOF, the caller must\nnot mutate Trailer.\n\nFew HTTP clients, servers, or proxies support HTTP trailers.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/http.Header"
+                    },
+                    "500": {
+                        "description": "Internal server error.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
                         }
-                    ]
-                },
-                "transferEncoding": {
-                    "description": "TransferEncoding lists the transfer encodings from outermost to\ninnermost. An empty list denotes the \"identity\" encoding.\nTransferEncoding can usually be ignored; chunked encoding is\nautomatically added and removed as necessary when sending and\nreceiving requests.",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
                     }
-                },
-                "url": {
-                    "description": "URL specifies either the URI being requested (for server\nrequests) or the URL to access (for client requests).\n\nFor server requests, the URL is parsed from the URI\nsupplied on the Request-Line as stored in RequestURI.  For\nmost requests, fields other than Path and RawQuery will be\nempty. (See RFC 7230, Section 5.3)\n\nFor client requests, the URL's Host specifies the server to\nconnect to, while the Request's Host field optionally\nspecifies the Host header value to send in the HTTP\nrequest.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/url.URL"
-                        }
-                    ]
                 }
             }
         },
-        "http.Response": {
+        "/applications/{id}/review": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a submitted application to under review.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Applications"
+                ],
+                "summary": "Review an application",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Application ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Application moved to under review successfully.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid UUID or bad input.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Application not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Invalid status transition.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/applications/{id}/withdraw": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Withdraws an application that is submitted or under review.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Applications"
+                ],
+                "summary": "Withdraw an application",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Application ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Application withdrawn successfully.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid UUID or bad input.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Application not found.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Invalid status transition.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/audit": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a page of audit log entries, newest first, optionally filtered by entity, actor and action.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "List Audit Log Entries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of entries per page (1-100, default 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the page to retrieve, from the next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order of the creation date",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "applicant",
+                            "relationship",
+                            "scheme",
+                            "benefit",
+                            "benefit_criteria",
+                            "scheme_criteria",
+                            "criteria_group",
+                            "scheme_version",
+                            "application",
+                            "disbursement",
+                            "user"
+                        ],
+                        "type": "string",
+                        "description": "Entity type",
+                        "name": "entity_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID of the user who made the change",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "update",
+                            "delete"
+                        ],
+                        "type": "string",
+                        "description": "Action",
+                        "name": "action",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved audit log entries.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.AuditLogsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Checks the email and password of a user and issues an access token to send as \"Authorization: Bearer \u003ctoken\u003e\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Sign In",
+                "parameters": [
+                    {
+                        "description": "Credentials of the user",
+                        "name": "LoginRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully signed in.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid Email or Password",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the details of the user the access token was issued to.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Retrieve the Current User",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved current user.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/criteria/types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve every criterion that schemes and benefits can use, with a description of the values each criterion accepts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Criteria"
+                ],
+                "summary": "List criterion types",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved criterion types",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.CriterionTypesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a page of available schemes, optionally sorted and filtered by name.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "List all schemes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of schemes per page (1-100, default 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the page to retrieve, from the next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "created_at"
+                        ],
+                        "type": "string",
+                        "description": "Field to sort by",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include schemes whose name contains this text",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved list of schemes",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a new scheme with the provided details. Benefits, criteria and criteria groups given with the scheme are created with it in one transaction.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Create a new scheme",
+                "parameters": [
+                    {
+                        "description": "JSON object containing new scheme details",
+                        "name": "CreateSchemeRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.CreateSchemeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully created scheme",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/benefits/{benefit_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Modify an existing benefit of a scheme by specifying the scheme ID and benefit ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Update a benefit of a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Benefit ID",
+                        "name": "benefit_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON object with updated benefit details",
+                        "name": "UpdateSchemeBenefitRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateSchemeBenefitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated benefit",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeBenefitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Benefit or Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a benefit from a scheme using its unique identifier.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Delete a benefit from a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Benefit ID",
+                        "name": "benefit_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted benefit",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Benefit not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/benefits/{benefit_id}/criteria": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all criteria of a benefit by specifying the benefit ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "List the criteria of a benefit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Benefit ID",
+                        "name": "benefit_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved benefit criteria",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.BenefitCriteriaListResponses"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Benefit not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a new criteria to an existing benefit by specifying the benefit ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Add a criteria to a benefit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Benefit ID",
+                        "name": "benefit_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON object with criteria details",
+                        "name": "AddBenefitCriteriaRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.AddBenefitCriteriaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully added criteria to benefit",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.BenefitCriteriaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Benefit not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/benefits/{benefit_id}/criteria/{benefit_criteria_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Modify an existing criteria of a benefit by specifying the benefit ID and criteria ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Update a criteria of a benefit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Benefit ID",
+                        "name": "benefit_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Benefit Criteria ID",
+                        "name": "benefit_criteria_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON object with updated criteria details",
+                        "name": "UpdateBenefitCriteriaRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateBenefitCriteriaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated criteria",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.BenefitCriteriaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Criteria or Benefit not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a criteria from a benefit using its unique identifier.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Delete a criteria from a benefit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Benefit ID",
+                        "name": "benefit_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Benefit Criteria ID",
+                        "name": "benefit_criteria_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted criteria",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Criteria not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/criteria-groups/{criteria_group_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a top-level criteria group, together with its criteria and nested groups, from a scheme.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Delete a criteria group from a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Criteria Group ID",
+                        "name": "criteria_group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted criteria group",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Criteria group not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/criteria/{scheme_criteria_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Modify an existing criteria of a scheme by specifying the scheme ID and criteria ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Update a criteria of a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme Criteria ID",
+                        "name": "scheme_criteria_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON object with updated criteria details",
+                        "name": "UpdateSchemeCriteriaRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateSchemeCriteriaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated criteria",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Criteria or Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a criteria from a scheme using its unique identifier.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Delete a criteria from a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Criteria ID",
+                        "name": "scheme_criteria_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted criteria",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Criteria not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/eligible": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of schemes available for a specific applicant using their unique identifier.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "List Applicant Available Schemes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Applicant ID",
+                        "name": "applicant",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Evaluate eligibility as of this date instead of today",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved available schemes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Applicant not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/versions/{version_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a version of a scheme by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Get a scheme version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme Version ID",
+                        "name": "version_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved scheme version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeVersionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme version not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a draft version of a scheme. Published and retired versions cannot be deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Delete a draft scheme version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme Version ID",
+                        "name": "version_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted scheme version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme version not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Scheme version is not a draft",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/versions/{version_id}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publish a draft version, freezing the current benefits and criteria of the scheme into it. Published versions cannot be changed. A published version of the scheme that is in effect indefinitely from an earlier date ends when the new version comes into effect.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Publish a scheme version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme Version ID",
+                        "name": "version_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully published scheme version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeVersionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme version not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Scheme version is not a draft or overlaps a published version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/versions/{version_id}/retire": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retire a published version so that it is no longer in effect. Applications keep the version they were assessed against.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Retire a scheme version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme Version ID",
+                        "name": "version_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retired scheme version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeVersionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme version not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Scheme version is not published",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/{scheme_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a scheme using its unique identifier.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Get Scheme by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved scheme",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Modify the details of an existing scheme using its unique identifier. Benefits, criteria and criteria groups replace those of the scheme in one transaction if they are given: benefits with an ID are updated, benefits without one are added and the others are deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Update an existing scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON object with updates to the scheme",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateSchemeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated scheme",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a scheme from the system using its unique identifier.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Delete a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted scheme",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/{scheme_id}/benefits": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach a new benefit to an existing scheme by specifying the scheme ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Add a benefit to a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON object with benefit details",
+                        "name": "AddSchemeBenefitRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeBenefitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully added benefit to scheme",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeBenefitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/{scheme_id}/criteria": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a new criteria to an existing scheme by specifying the scheme ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Add a criteria to a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON object with criteria details",
+                        "name": "AddSchemeCriteriaRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeCriteriaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully added criteria to scheme",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/{scheme_id}/criteria-groups": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a criteria group, together with its criteria and nested groups, to an existing scheme.\nAn applicant must meet every criteria and every criteria group of a scheme to be eligible.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Add a criteria group to a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON object with the criteria group tree",
+                        "name": "AddSchemeCriteriaGroupRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeCriteriaGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully added criteria group to scheme",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/{scheme_id}/eligibility": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Explain whether an applicant is eligible for a scheme by listing every criterion with the required value, the applicant's actual value and whether it passed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Explain Applicant Eligibility",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Applicant ID",
+                        "name": "applicant",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Evaluate eligibility as of this date instead of today",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully checked eligibility",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.EligibilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme or applicant not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemes/{scheme_id}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve every version of a scheme, latest first. Published and retired versions include the benefits and criteria they were published with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "List the versions of a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved scheme versions",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeVersionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a draft version of a scheme, in effect from effective_from until the day before effective_to. The benefits and criteria of the scheme are copied into the version when it is published. A scheme can only have one draft at a time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schemes"
+                ],
+                "summary": "Create a draft version of a scheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Scheme ID",
+                        "name": "scheme_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON object with the effective dates of the version",
+                        "name": "CreateSchemeVersionRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.CreateSchemeVersionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully created scheme version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.SchemeVersionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error occurred",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheme not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Scheme already has a draft version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves every user of the system and their roles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List All Users",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved users.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UsersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a user who can sign in with the given email and password. The role decides which routes the user may call.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create a new User",
+                "parameters": [
+                    {
+                        "description": "Payload for creating a user",
+                        "name": "CreateUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully created user.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email Already Used",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the details of a single user using their unique identifier.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Retrieve User by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved user.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the details, role or password of a user. Only the fields given are changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update a User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payload for updating a user",
+                        "name": "UpdateUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated user.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email Already Used",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a user so that they can no longer sign in.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete a User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted user.",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapter_handler_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_cxnub_fas-mgmt-system_internal_core_domain.BenefitFrequency": {
+            "type": "string",
+            "enum": [
+                "one_off",
+                "monthly",
+                "quarterly"
+            ],
+            "x-enum-varnames": [
+                "BenefitFrequencyOneOff",
+                "BenefitFrequencyMonthly",
+                "BenefitFrequencyQuarterly"
+            ]
+        },
+        "github_com_cxnub_fas-mgmt-system_internal_core_domain.Currency": {
+            "type": "string",
+            "enum": [
+                "SGD",
+                "USD",
+                "EUR",
+                "GBP",
+                "MYR",
+                "SGD"
+            ],
+            "x-enum-varnames": [
+                "CurrencySGD",
+                "CurrencyUSD",
+                "CurrencyEUR",
+                "CurrencyGBP",
+                "CurrencyMYR",
+                "DefaultCurrency"
+            ]
+        },
+        "github_com_cxnub_fas-mgmt-system_internal_core_domain.EmploymentStatus": {
+            "type": "string",
+            "enum": [
+                "employed",
+                "unemployed"
+            ],
+            "x-enum-varnames": [
+                "EmploymentStatusEmployed",
+                "EmploymentStatusUnemployed"
+            ]
+        },
+        "github_com_cxnub_fas-mgmt-system_internal_core_domain.MaritalStatus": {
+            "type": "string",
+            "enum": [
+                "single",
+                "married",
+                "widowed",
+                "divorce"
+            ],
+            "x-enum-varnames": [
+                "MaritalStatusSingle",
+                "MaritalStatusMarried",
+                "MaritalStatusWidowed",
+                "MaritalStatusDivorce"
+            ]
+        },
+        "github_com_cxnub_fas-mgmt-system_internal_core_domain.RelationshipType": {
+            "type": "string",
+            "enum": [
+                "spouse",
+                "child",
+                "parent",
+                "sibling"
+            ],
+            "x-enum-varnames": [
+                "RelationshipTypeSpouse",
+                "RelationshipTypeChild",
+                "RelationshipTypeParent",
+                "RelationshipTypeSibling"
+            ]
+        },
+        "github_com_cxnub_fas-mgmt-system_internal_core_domain.Role": {
+            "type": "string",
+            "enum": [
+                "viewer",
+                "caseworker",
+                "scheme_admin",
+                "superadmin"
+            ],
+            "x-enum-varnames": [
+                "RoleViewer",
+                "RoleCaseworker",
+                "RoleSchemeAdmin",
+                "RoleSuperadmin"
+            ]
+        },
+        "github_com_cxnub_fas-mgmt-system_internal_core_domain.Sex": {
+            "type": "string",
+            "enum": [
+                "male",
+                "female"
+            ],
+            "x-enum-varnames": [
+                "SexMale",
+                "SexFemale"
+            ]
+        },
+        "internal_adapter_handler_http.AddBenefitCriteriaRequest": {
             "type": "object",
+            "required": [
+                "name",
+                "value"
+            ],
             "properties": {
-                "body": {
-                    "description": "Body represents the response body.\n\nThe response body is streamed on demand as the Body field\nis read. If the network connection fails or the server\nterminates the response, Body.Read calls return an error.\n\nThe http Client and Transport guarantee that Body is always\nnon-nil, even on responses without a body or responses with\na zero-length body. It is the caller's responsibility to\nclose Body. The default HTTP client's Transport may not\nreuse HTTP/1.x \"keep-alive\" TCP connections if the Body is\nnot read to completion and closed.\n\nThe Body is automatically dechunked if the server replied\nwith a \"chunked\" Transfer-Encoding.\n\nAs of Go 1.12, the Body will also implement io.Writer\non a successful \"101 Switching Protocols\" response,\nas used by WebSockets and HTTP/2's \"h2c\" mode."
-                },
-                "close": {
-                    "description": "Close records whether the header directed that the connection be\nclosed after reading Body. The value is advice for clients: neither\nReadResponse nor Response.Write ever closes a connection.",
-                    "type": "boolean"
-                },
-                "contentLength": {
-                    "description": "ContentLength records the length of the associated content. The\nvalue -1 indicates that the length is unknown. Unless Request.Method\nis \"HEAD\", values \u003e= 0 indicate that the given number of bytes may\nbe read from Body.",
-                    "type": "integer"
-                },
-                "header": {
-                    "description": "Header maps header keys to values. If the response had multiple\nheaders with the same key, they may be concatenated, with comma\ndelimiters.  (RFC 7230, section 3.2.2 requires that multiple headers\nbe semantically equivalent to a comma-delimited sequence.) When\nHeader values are duplicated by other fields in this struct (e.g.,\nContentLength, TransferEncoding, Trailer), the field values are\nauthoritative.\n\nKeys in the map are canonicalized (see CanonicalHeaderKey).",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/http.Header"
-                        }
-                    ]
-                },
-                "proto": {
-                    "description": "e.g. \"HTTP/1.0\"",
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "example": "has_primary_school_children"
                 },
-                "protoMajor": {
-                    "description": "e.g. 1",
-                    "type": "integer"
+                "value": {
+                    "type": "string",
+                    "example": "true"
+                }
+            }
+        },
+        "internal_adapter_handler_http.AddSchemeBenefitRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "name"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "100.00"
                 },
-                "protoMinor": {
-                    "description": "e.g. 0",
-                    "type": "integer"
+                "cap": {
+                    "type": "string",
+                    "example": "1000.00"
                 },
-                "request": {
-                    "description": "Request is the request that was sent to obtain this Response.\nRequest's Body is nil (having already been consumed).\nThis is only populated for Client requests.",
+                "currency": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/http.Request"
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.Currency"
                         }
-                    ]
-                },
-                "status": {
-                    "description": "e.g. \"200 OK\"",
-                    "type": "string"
-                },
-                "statusCode": {
-                    "description": "e.g. 200",
-                    "type": "integer"
+                    ],
+                    "example": "SGD"
                 },
-                "tls": {
-                    "description": "TLS contains information about the TLS connection on which the\nresponse was received. It is nil for unencrypted responses.\nThe pointer is shared between responses and should not be\nmodified.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/tls.ConnectionState"
-                        }
-                    ]
+                "duration_months": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 12
                 },
-                "trailer": {
-                    "description": "Trailer maps trailer keys to values in the same\nformat as Header.\n\nThe Trailer initially contains only nil values, one for\neach key specified in the server's \"Trailer\" header\nvalue. Those values are not added to Header.\n\nTrailer must not be accessed concurrently with Read calls\non the Body.\n\nAfter Body.Read has returned io.EOF, Trailer will contain\nany trailer values sent by the server.",
+                "frequency": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/http.Header"
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.BenefitFrequency"
                         }
-                    ]
-                },
-                "transferEncoding": {
-                    "description": "Contains transfer encodings from outer-most to inner-most. Value is\nnil, means that \"identity\" encoding is used.",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    ],
+                    "example": "monthly"
                 },
-                "uncompressed": {
-                    "description": "Uncompressed reports whether the response was sent compressed but\nwas decompressed by the http package. When true, reading from\nBody yields the uncompressed content instead of the compressed\ncontent actually set from the server, ContentLength is set to -1,\nand the \"Content-Length\" and \"Content-Encoding\" fields are deleted\nfrom the responseHeader. To get the original response from\nthe server, set Transport.DisableCompression to true.",
-                    "type": "boolean"
+                "name": {
+                    "type": "string",
+                    "example": "CDC Vouchers"
                 }
             }
         },
-        "internal_adapter_handler_http.AddSchemeBenefitRequest": {
+        "internal_adapter_handler_http.AddSchemeCriteriaGroupRequest": {
             "type": "object",
             "required": [
-                "amount",
-                "name"
+                "operator"
             ],
             "properties": {
-                "amount": {
-                    "type": "number",
-                    "example": 100
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeCriteriaRequest"
+                    }
                 },
-                "name": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeCriteriaGroupRequest"
+                    }
+                },
+                "operator": {
                     "type": "string",
-                    "example": "CDC Vouchers"
+                    "enum": [
+                        "and",
+                        "or",
+                        "not"
+                    ],
+                    "example": "or"
                 }
             }
         },
@@ -1391,6 +3957,10 @@ const docTemplate = `{
         "internal_adapter_handler_http.ApplicantResponse": {
             "type": "object",
             "properties": {
+                "assets": {
+                    "type": "string",
+                    "example": "10000.00"
+                },
                 "created_at": {
                     "type": "string",
                     "example": "2021-01-01T00:00:00Z"
@@ -1403,6 +3973,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "employed"
                 },
+                "has_disability": {
+                    "type": "boolean",
+                    "example": false
+                },
                 "id": {
                     "type": "string",
                     "example": "00000000-0000-0000-0000-000000000000"
@@ -1411,6 +3985,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "married"
                 },
+                "monthly_income": {
+                    "type": "string",
+                    "example": "2500.00"
+                },
                 "name": {
                     "type": "string",
                     "example": "John Doe"
@@ -1425,6 +4003,25 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_adapter_handler_http.ApplicantsResponse": {
+            "type": "object",
+            "properties": {
+                "applicants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.ApplicantResponse"
+                    }
+                },
+                "next_page_token": {
+                    "type": "string",
+                    "example": "MjA"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
         "internal_adapter_handler_http.ApplicationResponse": {
             "type": "object",
             "properties": {
@@ -1444,6 +4041,14 @@ const docTemplate = `{
                     "type": "string",
                     "example": "00000000-0000-0000-0000-000000000000"
                 },
+                "scheme_version_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "submitted"
+                },
                 "updated_at": {
                     "type": "string",
                     "example": "2021-01-01T00:00:00Z"
@@ -1458,6 +4063,154 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/internal_adapter_handler_http.ApplicationResponse"
                     }
+                },
+                "next_page_token": {
+                    "type": "string",
+                    "example": "MjA"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "internal_adapter_handler_http.AuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "update"
+                },
+                "actor_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "actor_role": {
+                    "type": "string",
+                    "example": "caseworker"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "entity_type": {
+                    "type": "string",
+                    "example": "application"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "new_values": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "old_values": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "internal_adapter_handler_http.AuditLogsResponse": {
+            "type": "object",
+            "properties": {
+                "audit_logs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.AuditLogResponse"
+                    }
+                },
+                "next_page_token": {
+                    "type": "string",
+                    "example": "MjA"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "internal_adapter_handler_http.BenefitCriteriaListResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "has_primary_school_children"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "true"
+                }
+            }
+        },
+        "internal_adapter_handler_http.BenefitCriteriaListResponses": {
+            "type": "object",
+            "properties": {
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.BenefitCriteriaListResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapter_handler_http.BenefitCriteriaResponse": {
+            "type": "object",
+            "properties": {
+                "benefit_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "has_primary_school_children"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "true"
+                }
+            }
+        },
+        "internal_adapter_handler_http.BenefitEligibilityResponse": {
+            "type": "object",
+            "properties": {
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.CriterionResultResponse"
+                    }
+                },
+                "eligible": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "School Meal Vouchers"
                 }
             }
         },
@@ -1467,10 +4220,15 @@ const docTemplate = `{
                 "date_of_birth",
                 "employment_status",
                 "marital_status",
+                "monthly_income",
                 "name",
                 "sex"
             ],
             "properties": {
+                "assets": {
+                    "type": "string",
+                    "example": "10000.00"
+                },
                 "date_of_birth": {
                     "type": "string",
                     "example": "1990-01-01"
@@ -1483,6 +4241,10 @@ const docTemplate = `{
                     ],
                     "example": "employed"
                 },
+                "has_disability": {
+                    "type": "boolean",
+                    "example": false
+                },
                 "marital_status": {
                     "allOf": [
                         {
@@ -1491,6 +4253,10 @@ const docTemplate = `{
                     ],
                     "example": "married"
                 },
+                "monthly_income": {
+                    "type": "string",
+                    "example": "2500.00"
+                },
                 "name": {
                     "type": "string",
                     "example": "John Doe"
@@ -1520,66 +4286,144 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_adapter_handler_http.CreateRelationshipRequest": {
+            "type": "object",
+            "required": [
+                "family_member_id",
+                "relationship_type"
+            ],
+            "properties": {
+                "family_member_id": {
+                    "type": "string",
+                    "example": "c85062f2-e306-4ecd-b586-a3dcbb03deaf"
+                },
+                "relationship_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.RelationshipType"
+                        }
+                    ],
+                    "example": "parent"
+                }
+            }
+        },
         "internal_adapter_handler_http.CreateSchemeRequest": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
+                "benefits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeBenefitRequest"
+                    }
+                },
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeCriteriaRequest"
+                    }
+                },
+                "criteria_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeCriteriaGroupRequest"
+                    }
+                },
                 "name": {
                     "type": "string"
+                },
+                "reapply_cooldown_days": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 30
+                }
+            }
+        },
+        "internal_adapter_handler_http.CreateSchemeVersionRequest": {
+            "type": "object",
+            "required": [
+                "effective_from"
+            ],
+            "properties": {
+                "effective_from": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "effective_to": {
+                    "type": "string",
+                    "example": "2026-01-01"
                 }
             }
         },
-        "internal_adapter_handler_http.ErrorResponse": {
+        "internal_adapter_handler_http.CreateUserRequest": {
             "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password",
+                "role"
+            ],
             "properties": {
-                "errors": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    },
-                    "example": {
-                        "field": "error description"
-                    }
+                "email": {
+                    "type": "string",
+                    "example": "caseworker@example.com"
                 },
-                "message": {
+                "name": {
                     "type": "string",
-                    "example": "Error message"
+                    "example": "Jane Tan"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": false
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "password123"
+                },
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.Role"
+                        }
+                    ],
+                    "example": "caseworker"
                 }
             }
         },
-        "internal_adapter_handler_http.SchemeBenefitListResponse": {
+        "internal_adapter_handler_http.CriteriaGroupResultResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number",
-                    "example": 1000000
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.CriterionResultResponse"
+                    }
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.CriteriaGroupResultResponse"
+                    }
                 },
                 "id": {
                     "type": "string",
                     "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "name": {
+                "operator": {
                     "type": "string",
-                    "example": "CDC Vouchers"
+                    "example": "or"
+                },
+                "passed": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "internal_adapter_handler_http.SchemeBenefitResponse": {
+        "internal_adapter_handler_http.CriterionResultResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number",
-                    "example": 1000000
-                },
-                "created_at": {
+                "actual": {
                     "type": "string",
-                    "example": "2021-01-01T00:00:00Z"
+                    "example": "42"
                 },
                 "id": {
                     "type": "string",
@@ -1587,795 +4431,938 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string",
-                    "example": "CDC Vouchers"
+                    "example": "age"
                 },
-                "scheme_id": {
-                    "type": "string",
-                    "example": "00000000-0000-0000-0000-000000000000"
+                "passed": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "updated_at": {
+                "required": {
                     "type": "string",
-                    "example": "2021-01-01T00:00:00Z"
+                    "example": "\u003e=65"
                 }
             }
         },
-        "internal_adapter_handler_http.SchemeCriteriaListResponse": {
+        "internal_adapter_handler_http.CriterionTypeResponse": {
             "type": "object",
             "properties": {
-                "id": {
+                "description": {
                     "type": "string",
-                    "example": "00000000-0000-0000-0000-000000000000"
+                    "example": "Marital status of the applicant."
                 },
-                "name": {
+                "examples": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "single",
+                        "widowed",
+                        "divorce"
+                    ]
+                },
+                "format": {
                     "type": "string",
-                    "example": "employment_status"
+                    "example": "a comma separated list of single, married, widowed or divorce, optionally prefixed with ! to negate"
                 },
-                "value": {
+                "name": {
                     "type": "string",
-                    "example": "unemployed"
+                    "example": "marital_status"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "single",
+                        "married",
+                        "widowed",
+                        "divorce"
+                    ]
                 }
             }
         },
-        "internal_adapter_handler_http.SchemeCriteriaResponse": {
+        "internal_adapter_handler_http.CriterionTypesResponse": {
+            "type": "object",
+            "properties": {
+                "criterion_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.CriterionTypeResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapter_handler_http.DisbursementResponse": {
             "type": "object",
             "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "100.00"
+                },
+                "application_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "benefit_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "benefit_name": {
+                    "type": "string",
+                    "example": "CDC Vouchers"
+                },
                 "created_at": {
                     "type": "string",
                     "example": "2021-01-01T00:00:00Z"
                 },
+                "currency": {
+                    "type": "string",
+                    "example": "SGD"
+                },
+                "due_date": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
                 "id": {
                     "type": "string",
                     "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "name": {
+                "installment": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "paid_at": {
                     "type": "string",
-                    "example": "employment_status"
+                    "example": "2025-01-01T00:00:00Z"
                 },
-                "scheme_id": {
+                "status": {
                     "type": "string",
-                    "example": "00000000-0000-0000-0000-000000000000"
+                    "example": "scheduled"
                 },
                 "updated_at": {
                     "type": "string",
                     "example": "2021-01-01T00:00:00Z"
-                },
-                "value": {
-                    "type": "string",
-                    "example": "unemployed"
                 }
             }
         },
-        "internal_adapter_handler_http.SchemeResponse": {
+        "internal_adapter_handler_http.DisbursementsResponse": {
+            "type": "object",
+            "properties": {
+                "disbursements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.DisbursementResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapter_handler_http.EligibilityResponse": {
             "type": "object",
             "properties": {
+                "applicant_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "as_of": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
                 "benefits": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeBenefitListResponse"
+                        "$ref": "#/definitions/internal_adapter_handler_http.BenefitEligibilityResponse"
                     }
                 },
                 "criteria": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaListResponse"
+                        "$ref": "#/definitions/internal_adapter_handler_http.CriterionResultResponse"
                     }
                 },
-                "id": {
+                "criteria_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.CriteriaGroupResultResponse"
+                    }
+                },
+                "eligible": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "scheme_id": {
                     "type": "string",
                     "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "name": {
+                "scheme_version_id": {
                     "type": "string",
-                    "example": "Retrenchment Assistance Scheme"
+                    "example": "00000000-0000-0000-0000-000000000000"
                 }
             }
         },
-        "internal_adapter_handler_http.UpdateApplicantRequest": {
+        "internal_adapter_handler_http.ErrorResponse": {
             "type": "object",
             "properties": {
-                "date_of_birth": {
-                    "type": "string",
-                    "example": "1990-01-01"
-                },
-                "employment_status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.EmploymentStatus"
-                        }
-                    ],
-                    "example": "unemployed"
-                },
-                "marital_status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.MaritalStatus"
-                        }
-                    ],
-                    "example": "married"
+                "errors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "field": "error description"
+                    }
                 },
-                "name": {
+                "message": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "Error message"
                 },
-                "sex": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.Sex"
-                        }
-                    ],
-                    "example": "male"
+                "success": {
+                    "type": "boolean",
+                    "example": false
                 }
             }
         },
-        "internal_adapter_handler_http.UpdateApplicationRequest": {
+        "internal_adapter_handler_http.LoginRequest": {
             "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
             "properties": {
-                "applicant_id": {
-                    "type": "string"
+                "email": {
+                    "type": "string",
+                    "example": "admin@example.com"
                 },
-                "scheme_id": {
-                    "type": "string"
+                "password": {
+                    "type": "string",
+                    "example": "password123"
                 }
             }
         },
-        "internal_adapter_handler_http.UpdateSchemeBenefitRequest": {
+        "internal_adapter_handler_http.LoginResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
                 },
-                "name": {
-                    "type": "string"
+                "expires_at": {
+                    "type": "string",
+                    "example": "2021-01-01T01:00:00Z"
                 },
-                "scheme_id": {
-                    "type": "string"
+                "role": {
+                    "type": "string",
+                    "example": "caseworker"
                 }
             }
         },
-        "internal_adapter_handler_http.UpdateSchemeCriteriaRequest": {
+        "internal_adapter_handler_http.MoneyResponse": {
             "type": "object",
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "scheme_id": {
-                    "type": "string"
+                "amount": {
+                    "type": "string",
+                    "example": "300.00"
                 },
-                "value": {
-                    "type": "string"
+                "currency": {
+                    "type": "string",
+                    "example": "SGD"
                 }
             }
         },
-        "internal_adapter_handler_http.UpdateSchemeRequest": {
+        "internal_adapter_handler_http.PayoutHistoryResponse": {
             "type": "object",
             "properties": {
-                "name": {
-                    "type": "string"
+                "disbursements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.DisbursementResponse"
+                    }
+                },
+                "total_paid": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.MoneyResponse"
+                    }
                 }
             }
         },
-        "multipart.FileHeader": {
+        "internal_adapter_handler_http.RelationshipResponse": {
             "type": "object",
             "properties": {
-                "filename": {
-                    "type": "string"
+                "applicant_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "family_member_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "header": {
-                    "$ref": "#/definitions/textproto.MIMEHeader"
+                "relationship_type": {
+                    "type": "string",
+                    "example": "parent"
                 },
-                "size": {
-                    "type": "integer"
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 }
             }
         },
-        "multipart.Form": {
+        "internal_adapter_handler_http.RelationshipsResponse": {
             "type": "object",
             "properties": {
-                "file": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/multipart.FileHeader"
-                        }
+                "relationships": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.RelationshipResponse"
                     }
+                }
+            }
+        },
+        "internal_adapter_handler_http.Response": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "string",
+                    "example": "Success"
                 },
-                "value": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        }
-                    }
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "net.IPNet": {
+        "internal_adapter_handler_http.SchemeBenefitListResponse": {
             "type": "object",
             "properties": {
-                "ip": {
-                    "description": "network number",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "amount": {
+                    "type": "string",
+                    "example": "100.00"
+                },
+                "cap": {
+                    "type": "string",
+                    "example": "1000.00"
                 },
-                "mask": {
-                    "description": "network mask",
+                "criteria": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/internal_adapter_handler_http.BenefitCriteriaListResponse"
                     }
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "SGD"
+                },
+                "duration_months": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "frequency": {
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "CDC Vouchers"
                 }
             }
         },
-        "pkix.AttributeTypeAndValue": {
+        "internal_adapter_handler_http.SchemeBenefitRequest": {
             "type": "object",
+            "required": [
+                "amount",
+                "name"
+            ],
             "properties": {
-                "type": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "amount": {
+                    "type": "string",
+                    "example": "100.00"
                 },
-                "value": {}
-            }
-        },
-        "pkix.Extension": {
-            "type": "object",
-            "properties": {
-                "critical": {
-                    "type": "boolean"
+                "cap": {
+                    "type": "string",
+                    "example": "1000.00"
                 },
-                "id": {
+                "criteria": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/internal_adapter_handler_http.AddBenefitCriteriaRequest"
                     }
                 },
-                "value": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "currency": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.Currency"
+                        }
+                    ],
+                    "example": "SGD"
+                },
+                "duration_months": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 12
+                },
+                "frequency": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.BenefitFrequency"
+                        }
+                    ],
+                    "example": "monthly"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "CDC Vouchers"
                 }
             }
         },
-        "pkix.Name": {
+        "internal_adapter_handler_http.SchemeBenefitResponse": {
             "type": "object",
             "properties": {
-                "commonName": {
-                    "type": "string"
+                "amount": {
+                    "type": "string",
+                    "example": "100.00"
                 },
-                "country": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "cap": {
+                    "type": "string",
+                    "example": "1000.00"
                 },
-                "extraNames": {
-                    "description": "ExtraNames contains attributes to be copied, raw, into any marshaled\ndistinguished names. Values override any attributes with the same OID.\nThe ExtraNames field is not populated when parsing, see Names.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pkix.AttributeTypeAndValue"
-                    }
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 },
-                "locality": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "currency": {
+                    "type": "string",
+                    "example": "SGD"
                 },
-                "names": {
-                    "description": "Names contains all parsed attributes. When parsing distinguished names,\nthis can be used to extract non-standard attributes that are not parsed\nby this package. When marshaling to RDNSequences, the Names field is\nignored, see ExtraNames.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pkix.AttributeTypeAndValue"
-                    }
+                "duration_months": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "organization": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "frequency": {
+                    "type": "string",
+                    "example": "monthly"
                 },
-                "organizationalUnit": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "CDC Vouchers"
                 },
-                "postalCode": {
+                "scheme_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
+                }
+            }
+        },
+        "internal_adapter_handler_http.SchemeCriteriaGroupResponse": {
+            "type": "object",
+            "properties": {
+                "criteria": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaListResponse"
                     }
                 },
-                "province": {
+                "groups": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaGroupResponse"
                     }
                 },
-                "serialNumber": {
-                    "type": "string"
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "streetAddress": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "operator": {
+                    "type": "string",
+                    "example": "or"
                 }
             }
         },
-        "textproto.MIMEHeader": {
+        "internal_adapter_handler_http.SchemeCriteriaListResponse": {
             "type": "object",
-            "additionalProperties": {
-                "type": "array",
-                "items": {
-                    "type": "string"
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "employment_status"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "unemployed"
                 }
             }
         },
-        "tls.ConnectionState": {
+        "internal_adapter_handler_http.SchemeCriteriaResponse": {
             "type": "object",
             "properties": {
-                "cipherSuite": {
-                    "description": "CipherSuite is the cipher suite negotiated for the connection (e.g.\nTLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, TLS_AES_128_GCM_SHA256).",
-                    "type": "integer"
-                },
-                "didResume": {
-                    "description": "DidResume is true if this connection was successfully resumed from a\nprevious session with a session ticket or similar mechanism.",
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 },
-                "echaccepted": {
-                    "description": "ECHAccepted indicates if Encrypted Client Hello was offered by the client\nand accepted by the server. Currently, ECH is supported only on the\nclient side.",
-                    "type": "boolean"
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "handshakeComplete": {
-                    "description": "HandshakeComplete is true if the handshake has concluded.",
-                    "type": "boolean"
+                "name": {
+                    "type": "string",
+                    "example": "employment_status"
                 },
-                "negotiatedProtocol": {
-                    "description": "NegotiatedProtocol is the application protocol negotiated with ALPN.",
-                    "type": "string"
+                "scheme_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "negotiatedProtocolIsMutual": {
-                    "description": "NegotiatedProtocolIsMutual used to indicate a mutual NPN negotiation.\n\nDeprecated: this value is always true.",
-                    "type": "boolean"
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 },
-                "ocspresponse": {
-                    "description": "OCSPResponse is a stapled Online Certificate Status Protocol (OCSP)\nresponse provided by the peer for the leaf certificate, if any.",
+                "value": {
+                    "type": "string",
+                    "example": "unemployed"
+                }
+            }
+        },
+        "internal_adapter_handler_http.SchemeResponse": {
+            "type": "object",
+            "properties": {
+                "benefits": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeBenefitListResponse"
                     }
                 },
-                "peerCertificates": {
-                    "description": "PeerCertificates are the parsed certificates sent by the peer, in the\norder in which they were sent. The first element is the leaf certificate\nthat the connection is verified against.\n\nOn the client side, it can't be empty. On the server side, it can be\nempty if Config.ClientAuth is not RequireAnyClientCert or\nRequireAndVerifyClientCert.\n\nPeerCertificates and its contents should not be modified.",
+                "criteria": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/x509.Certificate"
+                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaListResponse"
                     }
                 },
-                "serverName": {
-                    "description": "ServerName is the value of the Server Name Indication extension sent by\nthe client. It's available both on the server and on the client side.",
-                    "type": "string"
-                },
-                "signedCertificateTimestamps": {
-                    "description": "SignedCertificateTimestamps is a list of SCTs provided by the peer\nthrough the TLS handshake for the leaf certificate, if any.",
+                "criteria_groups": {
                     "type": "array",
                     "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        }
+                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeCriteriaGroupResponse"
                     }
                 },
-                "tlsunique": {
-                    "description": "TLSUnique contains the \"tls-unique\" channel binding value (see RFC 5929,\nSection 3). This value will be nil for TLS 1.3 connections and for\nresumed connections that don't support Extended Master Secret (RFC 7627).",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "verifiedChains": {
-                    "description": "VerifiedChains is a list of one or more chains where the first element is\nPeerCertificates[0] and the last element is from Config.RootCAs (on the\nclient side) or Config.ClientCAs (on the server side).\n\nOn the client side, it's set if Config.InsecureSkipVerify is false. On\nthe server side, it's set if Config.ClientAuth is VerifyClientCertIfGiven\n(and the peer provided a certificate) or RequireAndVerifyClientCert.\n\nVerifiedChains and its contents should not be modified.",
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/x509.Certificate"
-                        }
-                    }
+                "name": {
+                    "type": "string",
+                    "example": "Retrenchment Assistance Scheme"
+                },
+                "reapply_cooldown_days": {
+                    "type": "integer",
+                    "example": 30
                 },
                 "version": {
-                    "description": "Version is the TLS version used by the connection (e.g. VersionTLS12).",
-                    "type": "integer"
+                    "type": "integer",
+                    "example": 1
+                },
+                "version_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 }
             }
         },
-        "url.URL": {
+        "internal_adapter_handler_http.SchemeVersionResponse": {
             "type": "object",
             "properties": {
-                "forceQuery": {
-                    "description": "append a query ('?') even if RawQuery is empty",
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 },
-                "fragment": {
-                    "description": "fragment for references, without '#'",
-                    "type": "string"
+                "definition": {
+                    "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
                 },
-                "host": {
-                    "description": "host or host:port (see Hostname and Port methods)",
-                    "type": "string"
+                "effective_from": {
+                    "type": "string",
+                    "example": "2025-01-01"
                 },
-                "omitHost": {
-                    "description": "do not emit empty host (authority)",
-                    "type": "boolean"
+                "effective_to": {
+                    "type": "string",
+                    "example": "2026-01-01"
                 },
-                "opaque": {
-                    "description": "encoded opaque data",
-                    "type": "string"
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "path": {
-                    "description": "path (relative paths may omit leading slash)",
-                    "type": "string"
+                "published_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 },
-                "rawFragment": {
-                    "description": "encoded fragment hint (see EscapedFragment method)",
-                    "type": "string"
+                "retired_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 },
-                "rawPath": {
-                    "description": "encoded path hint (see EscapedPath method)",
-                    "type": "string"
+                "scheme_id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "rawQuery": {
-                    "description": "encoded query values, without '?'",
-                    "type": "string"
+                "status": {
+                    "type": "string",
+                    "example": "published"
                 },
-                "scheme": {
-                    "type": "string"
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 },
-                "user": {
-                    "description": "username and password information",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/url.Userinfo"
-                        }
-                    ]
-                }
-            }
-        },
-        "url.Userinfo": {
-            "type": "object"
-        },
-        "url.Values": {
-            "type": "object",
-            "additionalProperties": {
-                "type": "array",
-                "items": {
-                    "type": "string"
+                "version": {
+                    "type": "integer",
+                    "example": 2
                 }
             }
         },
-        "x509.Certificate": {
+        "internal_adapter_handler_http.SchemeVersionsResponse": {
             "type": "object",
-            "properties": {
-                "authorityKeyId": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "basicConstraintsValid": {
-                    "description": "BasicConstraintsValid indicates whether IsCA, MaxPathLen,\nand MaxPathLenZero are valid.",
-                    "type": "boolean"
-                },
-                "crldistributionPoints": {
-                    "description": "CRL Distribution Points",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "dnsnames": {
-                    "description": "Subject Alternate Name values. (Note that these values may not be valid\nif invalid values were contained within a parsed certificate. For\nexample, an element of DNSNames may not be a valid DNS domain name.)",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "emailAddresses": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "excludedDNSDomains": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "excludedEmailAddresses": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "excludedIPRanges": {
+            "properties": {
+                "versions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/net.IPNet"
+                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeVersionResponse"
                     }
+                }
+            }
+        },
+        "internal_adapter_handler_http.SchemesResponse": {
+            "type": "object",
+            "properties": {
+                "next_page_token": {
+                    "type": "string",
+                    "example": "MjA"
                 },
-                "excludedURIDomains": {
+                "schemes": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/internal_adapter_handler_http.SchemeResponse"
                     }
                 },
-                "extKeyUsage": {
-                    "description": "Sequence of extended key usages.",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "internal_adapter_handler_http.UpdateApplicantRequest": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "string",
+                    "example": "10000.00"
                 },
-                "extensions": {
-                    "description": "Extensions contains raw X.509 extensions. When parsing certificates,\nthis can be used to extract non-critical extensions that are not\nparsed by this package. When marshaling certificates, the Extensions\nfield is ignored, see ExtraExtensions.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pkix.Extension"
-                    }
+                "date_of_birth": {
+                    "type": "string",
+                    "example": "1990-01-01"
                 },
-                "extraExtensions": {
-                    "description": "ExtraExtensions contains extensions to be copied, raw, into any\nmarshaled certificates. Values override any extensions that would\notherwise be produced based on the other fields. The ExtraExtensions\nfield is not populated when parsing certificates, see Extensions.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pkix.Extension"
-                    }
+                "employment_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.EmploymentStatus"
+                        }
+                    ],
+                    "example": "unemployed"
                 },
-                "inhibitAnyPolicy": {
-                    "description": "InhibitAnyPolicy and InhibitAnyPolicyZero indicate the presence and value\nof the inhibitAnyPolicy extension.\n\nThe value of InhibitAnyPolicy indicates the number of additional\ncertificates in the path after this certificate that may use the\nanyPolicy policy OID to indicate a match with any other policy.\n\nWhen parsing a certificate, a positive non-zero InhibitAnyPolicy means\nthat the field was specified, -1 means it was unset, and\nInhibitAnyPolicyZero being true mean that the field was explicitly set to\nzero. The case of InhibitAnyPolicy==0 with InhibitAnyPolicyZero==false\nshould be treated equivalent to -1 (unset).",
-                    "type": "integer"
+                "has_disability": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "inhibitAnyPolicyZero": {
-                    "description": "InhibitAnyPolicyZero indicates that InhibitAnyPolicy==0 should be\ninterpreted as an actual maximum path length of zero. Otherwise, that\ncombination is interpreted as InhibitAnyPolicy not being set.",
-                    "type": "boolean"
+                "marital_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.MaritalStatus"
+                        }
+                    ],
+                    "example": "married"
                 },
-                "inhibitPolicyMapping": {
-                    "description": "InhibitPolicyMapping and InhibitPolicyMappingZero indicate the presence\nand value of the inhibitPolicyMapping field of the policyConstraints\nextension.\n\nThe value of InhibitPolicyMapping indicates the number of additional\ncertificates in the path after this certificate that may use policy\nmapping.\n\nWhen parsing a certificate, a positive non-zero InhibitPolicyMapping\nmeans that the field was specified, -1 means it was unset, and\nInhibitPolicyMappingZero being true mean that the field was explicitly\nset to zero. The case of InhibitPolicyMapping==0 with\nInhibitPolicyMappingZero==false should be treated equivalent to -1\n(unset).",
-                    "type": "integer"
+                "monthly_income": {
+                    "type": "string",
+                    "example": "2500.00"
                 },
-                "inhibitPolicyMappingZero": {
-                    "description": "InhibitPolicyMappingZero indicates that InhibitPolicyMapping==0 should be\ninterpreted as an actual maximum path length of zero. Otherwise, that\ncombination is interpreted as InhibitAnyPolicy not being set.",
-                    "type": "boolean"
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
                 },
-                "ipaddresses": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
+                "sex": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.Sex"
                         }
-                    }
+                    ],
+                    "example": "male"
+                }
+            }
+        },
+        "internal_adapter_handler_http.UpdateApplicationRequest": {
+            "type": "object",
+            "properties": {
+                "applicant_id": {
+                    "type": "string"
                 },
-                "isCA": {
-                    "type": "boolean"
+                "scheme_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_adapter_handler_http.UpdateBenefitCriteriaRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
                 },
-                "issuer": {
-                    "$ref": "#/definitions/pkix.Name"
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_adapter_handler_http.UpdateRelationshipRequest": {
+            "type": "object",
+            "required": [
+                "relationship_type"
+            ],
+            "properties": {
+                "relationship_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.RelationshipType"
+                        }
+                    ],
+                    "example": "sibling"
+                }
+            }
+        },
+        "internal_adapter_handler_http.UpdateSchemeBenefitRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "100.00"
                 },
-                "issuingCertificateURL": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "cap": {
+                    "type": "string",
+                    "example": "1000.00"
                 },
-                "keyUsage": {
-                    "type": "integer"
+                "currency": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.Currency"
+                        }
+                    ],
+                    "example": "SGD"
                 },
-                "maxPathLen": {
-                    "description": "MaxPathLen and MaxPathLenZero indicate the presence and\nvalue of the BasicConstraints' \"pathLenConstraint\".\n\nWhen parsing a certificate, a positive non-zero MaxPathLen\nmeans that the field was specified, -1 means it was unset,\nand MaxPathLenZero being true mean that the field was\nexplicitly set to zero. The case of MaxPathLen==0 with MaxPathLenZero==false\nshould be treated equivalent to -1 (unset).\n\nWhen generating a certificate, an unset pathLenConstraint\ncan be requested with either MaxPathLen == -1 or using the\nzero value for both MaxPathLen and MaxPathLenZero.",
-                    "type": "integer"
+                "duration_months": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 12
                 },
-                "maxPathLenZero": {
-                    "description": "MaxPathLenZero indicates that BasicConstraintsValid==true\nand MaxPathLen==0 should be interpreted as an actual\nmaximum path length of zero. Otherwise, that combination is\ninterpreted as MaxPathLen not being set.",
-                    "type": "boolean"
+                "frequency": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.BenefitFrequency"
+                        }
+                    ],
+                    "example": "monthly"
                 },
-                "notAfter": {
-                    "description": "Validity bounds.",
+                "name": {
                     "type": "string"
                 },
-                "notBefore": {
-                    "description": "Validity bounds.",
+                "scheme_id": {
                     "type": "string"
+                }
+            }
+        },
+        "internal_adapter_handler_http.UpdateSchemeBenefitsRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "name"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "100.00"
                 },
-                "ocspserver": {
-                    "description": "RFC 5280, 4.2.2.1 (Authority Information Access)",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "permittedDNSDomains": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "permittedDNSDomainsCritical": {
-                    "description": "Name constraints",
-                    "type": "boolean"
-                },
-                "permittedEmailAddresses": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "cap": {
+                    "type": "string",
+                    "example": "1000.00"
                 },
-                "permittedIPRanges": {
+                "criteria": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/net.IPNet"
+                        "$ref": "#/definitions/internal_adapter_handler_http.AddBenefitCriteriaRequest"
                     }
                 },
-                "permittedURIDomains": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "currency": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.Currency"
+                        }
+                    ],
+                    "example": "SGD"
                 },
-                "policies": {
-                    "description": "Policies contains all policy identifiers included in the certificate.\nSee CreateCertificate for context about how this field and the PolicyIdentifiers field\ninteract.\nIn Go 1.22, encoding/gob cannot handle and ignores this field.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/x509.OID"
-                    }
+                "duration_months": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 12
                 },
-                "policyIdentifiers": {
-                    "description": "PolicyIdentifiers contains asn1.ObjectIdentifiers, the components\nof which are limited to int32. If a certificate contains a policy which\ncannot be represented by asn1.ObjectIdentifier, it will not be included in\nPolicyIdentifiers, but will be present in Policies, which contains all parsed\npolicy OIDs.\nSee CreateCertificate for context about how this field and the Policies field\ninteract.",
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
+                "frequency": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.BenefitFrequency"
                         }
-                    }
-                },
-                "policyMappings": {
-                    "description": "PolicyMappings contains a list of policy mappings included in the certificate.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/x509.PolicyMapping"
-                    }
+                    ],
+                    "example": "monthly"
                 },
-                "publicKey": {},
-                "publicKeyAlgorithm": {
-                    "type": "integer"
+                "id": {
+                    "type": "string",
+                    "example": "8f1d4c2a-6b3e-4a9f-9c1e-2d3b4a5c6d7e"
                 },
-                "raw": {
-                    "description": "Complete ASN.1 DER content (certificate, signature algorithm and signature).",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "name": {
+                    "type": "string",
+                    "example": "CDC Vouchers"
+                }
+            }
+        },
+        "internal_adapter_handler_http.UpdateSchemeCriteriaRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
                 },
-                "rawIssuer": {
-                    "description": "DER encoded Issuer",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "scheme_id": {
+                    "type": "string"
                 },
-                "rawSubject": {
-                    "description": "DER encoded Subject",
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_adapter_handler_http.UpdateSchemeRequest": {
+            "type": "object",
+            "properties": {
+                "benefits": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/internal_adapter_handler_http.UpdateSchemeBenefitsRequest"
                     }
                 },
-                "rawSubjectPublicKeyInfo": {
-                    "description": "DER encoded SubjectPublicKeyInfo.",
+                "criteria": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeCriteriaRequest"
                     }
                 },
-                "rawTBSCertificate": {
-                    "description": "Certificate part of raw ASN.1 DER content.",
+                "criteria_groups": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/internal_adapter_handler_http.AddSchemeCriteriaGroupRequest"
                     }
                 },
-                "requireExplicitPolicy": {
-                    "description": "RequireExplicitPolicy and RequireExplicitPolicyZero indicate the presence\nand value of the requireExplicitPolicy field of the policyConstraints\nextension.\n\nThe value of RequireExplicitPolicy indicates the number of additional\ncertificates in the path after this certificate before an explicit policy\nis required for the rest of the path. When an explicit policy is required,\neach subsequent certificate in the path must contain a required policy OID,\nor a policy OID which has been declared as equivalent through the policy\nmapping extension.\n\nWhen parsing a certificate, a positive non-zero RequireExplicitPolicy\nmeans that the field was specified, -1 means it was unset, and\nRequireExplicitPolicyZero being true mean that the field was explicitly\nset to zero. The case of RequireExplicitPolicy==0 with\nRequireExplicitPolicyZero==false should be treated equivalent to -1\n(unset).",
-                    "type": "integer"
-                },
-                "requireExplicitPolicyZero": {
-                    "description": "RequireExplicitPolicyZero indicates that RequireExplicitPolicy==0 should be\ninterpreted as an actual maximum path length of zero. Otherwise, that\ncombination is interpreted as InhibitAnyPolicy not being set.",
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
                 },
-                "serialNumber": {
-                    "$ref": "#/definitions/big.Int"
+                "reapply_cooldown_days": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 30
+                }
+            }
+        },
+        "internal_adapter_handler_http.UpdateUserRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "caseworker@example.com"
                 },
-                "signature": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "name": {
+                    "type": "string",
+                    "example": "Jane Tan"
                 },
-                "signatureAlgorithm": {
-                    "type": "integer"
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "password123"
                 },
-                "subject": {
-                    "$ref": "#/definitions/pkix.Name"
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_cxnub_fas-mgmt-system_internal_core_domain.Role"
+                        }
+                    ],
+                    "example": "scheme_admin"
+                }
+            }
+        },
+        "internal_adapter_handler_http.UserResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 },
-                "subjectKeyId": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "email": {
+                    "type": "string",
+                    "example": "caseworker@example.com"
                 },
-                "unhandledCriticalExtensions": {
-                    "description": "UnhandledCriticalExtensions contains a list of extension IDs that\nwere not (fully) processed when parsing. Verify will fail if this\nslice is non-empty, unless verification is delegated to an OS\nlibrary which understands all the critical extensions.\n\nUsers can access these extensions using Extensions and can remove\nelements from this slice if they believe that they have been\nhandled.",
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        }
-                    }
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "unknownExtKeyUsage": {
-                    "description": "Encountered extended key usages unknown to this package.",
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        }
-                    }
+                "name": {
+                    "type": "string",
+                    "example": "Jane Tan"
                 },
-                "uris": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/url.URL"
-                    }
+                "role": {
+                    "type": "string",
+                    "example": "caseworker"
                 },
-                "version": {
-                    "type": "integer"
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 }
             }
         },
-        "x509.OID": {
-            "type": "object"
-        },
-        "x509.PolicyMapping": {
+        "internal_adapter_handler_http.UsersResponse": {
             "type": "object",
             "properties": {
-                "issuerDomainPolicy": {
-                    "description": "IssuerDomainPolicy contains a policy OID the issuing certificate considers\nequivalent to SubjectDomainPolicy in the subject certificate.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/x509.OID"
-                        }
-                    ]
-                },
-                "subjectDomainPolicy": {
-                    "description": "SubjectDomainPolicy contains a OID the issuing certificate considers\nequivalent to IssuerDomainPolicy in the subject certificate.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/x509.OID"
-                        }
-                    ]
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapter_handler_http.UserResponse"
+                    }
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Access token from /auth/login, sent as \"Bearer \u003ctoken\u003e\".",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
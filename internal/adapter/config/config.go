@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/viper"
 	"log"
 	"sync"
+	"time"
 )
 
 // Config structure to hold application settings
@@ -12,11 +13,33 @@ type Config struct {
 	ApiPort        string
 	AllowedOrigins string
 
+	// Timeouts of the HTTP server. Requests in flight when the server is asked to stop are given ShutdownTimeout to finish.
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+
+	// LogLevel is one of debug, info, warn or error, and LogFormat is either json or text
+	LogLevel  string
+	LogFormat string
+
+	// StorageDriver is either "postgres" or "memory". The memory driver keeps every record in memory until the server stops.
+	StorageDriver string
+
 	DBHost     string
 	DBPort     uint16
 	DBUser     string
 	DBPassword string
 	DBName     string
+
+	// DBRequireLatestSchema makes the server refuse to start until every migration in the binary has been applied
+	DBRequireLatestSchema bool
+
+	TokenSecret   string
+	TokenDuration time.Duration
+
+	AdminEmail    string
+	AdminPassword string
 }
 
 var instantiated *Config
@@ -29,6 +52,15 @@ func New() *Config {
 
 		// set default values
 		viper.SetDefault("API_PORT", "8080")
+		viper.SetDefault("HTTP_READ_TIMEOUT", "15s")
+		viper.SetDefault("HTTP_WRITE_TIMEOUT", "30s")
+		viper.SetDefault("HTTP_IDLE_TIMEOUT", "60s")
+		viper.SetDefault("HTTP_SHUTDOWN_TIMEOUT", "30s")
+		viper.SetDefault("LOG_LEVEL", "info")
+		viper.SetDefault("LOG_FORMAT", "text")
+		viper.SetDefault("TOKEN_DURATION", "1h")
+		viper.SetDefault("STORAGE_DRIVER", "postgres")
+		viper.SetDefault("DB_REQUIRE_LATEST_SCHEMA", false)
 
 		if err := viper.ReadInConfig(); err != nil {
 			//log.Fatal("Failed to read config file, ensure .env file exists in the root directory.")
@@ -41,11 +73,29 @@ func New() *Config {
 			ApiPort:        viper.GetString("API_PORT"),
 			AllowedOrigins: viper.GetString("ALLOWED_ORIGINS"),
 
+			ReadTimeout:     viper.GetDuration("HTTP_READ_TIMEOUT"),
+			WriteTimeout:    viper.GetDuration("HTTP_WRITE_TIMEOUT"),
+			IdleTimeout:     viper.GetDuration("HTTP_IDLE_TIMEOUT"),
+			ShutdownTimeout: viper.GetDuration("HTTP_SHUTDOWN_TIMEOUT"),
+
+			LogLevel:  viper.GetString("LOG_LEVEL"),
+			LogFormat: viper.GetString("LOG_FORMAT"),
+
+			StorageDriver: viper.GetString("STORAGE_DRIVER"),
+
 			DBHost:     viper.GetString("DB_HOST"),
 			DBPort:     uint16(viper.GetInt("DB_PORT")),
 			DBUser:     viper.GetString("DB_USER"),
 			DBPassword: viper.GetString("DB_PASSWORD"),
 			DBName:     viper.GetString("DB_NAME"),
+
+			DBRequireLatestSchema: viper.GetBool("DB_REQUIRE_LATEST_SCHEMA"),
+
+			TokenSecret:   viper.GetString("TOKEN_SECRET"),
+			TokenDuration: viper.GetDuration("TOKEN_DURATION"),
+
+			AdminEmail:    viper.GetString("ADMIN_EMAIL"),
+			AdminPassword: viper.GetString("ADMIN_PASSWORD"),
 		}
 	})
 
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"encoding/json"
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
 	"github.com/cxnub/fas-mgmt-system/internal/core/port"
 	"github.com/gin-gonic/gin"
@@ -25,6 +26,7 @@ func NewApplicantHandler(s port.ApplicantService) *ApplicantHandler {
 // @Tags		 Applicants
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		id   path	  string  true  "Applicant ID"
 // @Success	  200  {object}  ApplicantResponse  "Successfully retrieved applicant."
 // @Failure	  400  {object}  ErrorResponse	  "Bad Request"
@@ -62,22 +64,54 @@ func (h *ApplicantHandler) GetApplicant(ctx *gin.Context) {
 
 // ListApplicants godoc
 // @Summary		List All Applicants
-// @Description	Retrieves and returns a list of all registered applicants.
+// @Description	Retrieves a page of registered applicants, optionally sorted and filtered by employment status, marital status and age.
 // @Tags		   Applicants
 // @Accept		 json
 // @Produce		json
-// @Success		200  {array}   ApplicantResponse "Successfully retrieved list of applicants."
+// @Security	BearerAuth
+// @Param		  page_size		  query	 int	 false  "Number of applicants per page (1-100, default 20)"
+// @Param		  page_token		 query	 string  false  "Token of the page to retrieve, from the next_page_token of the previous page"
+// @Param		  sort_by			query	 string  false  "Field to sort by" Enums(name, date_of_birth, created_at)
+// @Param		  sort_order		 query	 string  false  "Sort order" Enums(asc, desc)
+// @Param		  employment_status  query	 string  false  "Employment status" Enums(employed, unemployed)
+// @Param		  marital_status	 query	 string  false  "Marital status" Enums(single, married, widowed, divorced)
+// @Param		  min_age			query	 int	 false  "Minimum age"
+// @Param		  max_age			query	 int	 false  "Maximum age"
+// @Success		200  {object}  ApplicantsResponse "Successfully retrieved list of applicants."
+// @Failure		400  {object}  ErrorResponse	 "Bad Request"
 // @Failure		500  {object}  ErrorResponse	 "Internal Server Error"
 // @Router		 /applicants [get]
 func (h *ApplicantHandler) ListApplicants(ctx *gin.Context) {
-	applicants, err := h.s.ListApplicants(ctx)
+	var req ListApplicantsRequest
 
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	opts, err := newListOptions(req.PageSize, req.PageToken, req.SortBy, req.SortOrder)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	rsp := newApplicantsResponse(applicants)
+	filter := domain.ApplicantFilter{
+		ListOptions:      opts,
+		EmploymentStatus: req.EmploymentStatus,
+		MaritalStatus:    req.MaritalStatus,
+		MinAge:           req.MinAge,
+		MaxAge:           req.MaxAge,
+	}
+
+	applicants, total, err := h.s.ListApplicants(ctx, filter)
+
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newApplicantsResponse(applicants, total, nextPageToken(opts, len(applicants), total))
 	handleSuccess(ctx, http.StatusOK, "Successfully retrieved applicants.", rsp)
 	return
 }
@@ -88,6 +122,7 @@ func (h *ApplicantHandler) ListApplicants(ctx *gin.Context) {
 // @Tags		 Applicants
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		CreateApplicantRequest  body	  CreateApplicantRequest  true  "Payload for creating a new applicant"
 // @Success	  201   {object}  ApplicantResponse  "Successfully created applicant."
 // @Failure	  400   {object}  ErrorResponse	  "Bad Request"
@@ -106,12 +141,21 @@ func (h *ApplicantHandler) CreateApplicant(ctx *gin.Context) {
 
 	dob, err := time.Parse("2006-01-02", req.DateOfBirth)
 
+	monthlyIncome, assets, err := parseApplicantAmounts(req.MonthlyIncome, req.Assets)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	applicant := domain.Applicant{
 		Name:             &req.Name,
 		EmploymentStatus: &req.EmploymentStatus,
 		Sex:              &req.Sex,
 		DateOfBirth:      &dob,
 		MaritalStatus:    &req.MaritalStatus,
+		MonthlyIncome:    monthlyIncome,
+		Assets:           assets,
+		HasDisability:    req.HasDisability,
 	}
 
 	newApplicant, err = h.s.CreateApplicant(ctx, &applicant)
@@ -133,6 +177,7 @@ func (h *ApplicantHandler) CreateApplicant(ctx *gin.Context) {
 // @Tags		 Applicants
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		id					  path	  string				  true   "Applicant ID"
 // @Param		UpdateApplicantRequest  body	  UpdateApplicantRequest  true   "Payload for updating an applicant"
 // @Success	  200					 {object}  ApplicantResponse  "Successfully updated applicant."
@@ -168,12 +213,21 @@ func (h *ApplicantHandler) UpdateApplicant(ctx *gin.Context) {
 		return
 	}
 
+	monthlyIncome, assets, err := parseApplicantAmounts(req.MonthlyIncome, req.Assets)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	newApplicantValues := domain.Applicant{
 		ID:               &id,
 		Sex:              req.Sex,
 		MaritalStatus:    req.MaritalStatus,
 		EmploymentStatus: req.EmploymentStatus,
 		Name:             req.Name,
+		MonthlyIncome:    monthlyIncome,
+		Assets:           assets,
+		HasDisability:    req.HasDisability,
 	}
 
 	if req.DateOfBirth != nil {
@@ -201,6 +255,7 @@ func (h *ApplicantHandler) UpdateApplicant(ctx *gin.Context) {
 // @Tags		 Applicants
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		id   path	  string  true  "Applicant ID"
 // @Success	  200  {object}  Response  "Successfully deleted applicant."
 // @Failure	  400  {object}  ErrorResponse	"Bad Request"
@@ -232,3 +287,21 @@ func (h *ApplicantHandler) DeleteApplicant(ctx *gin.Context) {
 	handleSuccess(ctx, http.StatusOK, "Successfully deleted applicant.", nil)
 	return
 }
+
+// parseApplicantAmounts converts the monthly income and assets given in a request into money in the default currency.
+// Returns domain.InvalidAmountError if either has more than two decimal places or is negative.
+func parseApplicantAmounts(monthlyIncome *json.Number, assets *json.Number) (*domain.Money, *domain.Money, error) {
+	currency := domain.DefaultCurrency
+
+	income, err := parseAmount(monthlyIncome, &currency)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assetsAmount, err := parseAmount(assets, &currency)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return income, assetsAmount, nil
+}
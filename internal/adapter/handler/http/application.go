@@ -3,6 +3,7 @@ package http
 import (
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
 	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/cxnub/fas-mgmt-system/internal/core/util"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"net/http"
@@ -25,6 +26,7 @@ func NewApplicationHandler(s port.ApplicationService) *ApplicationHandler {
 // @Tags Applications
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "Application ID"
 // @Success 200 {object} ApplicationResponse "Application retrieved successfully."
 // @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
@@ -61,22 +63,90 @@ func (h *ApplicationHandler) GetApplication(ctx *gin.Context) {
 // ListApplications godoc
 //
 // @Summary List all applications
-// @Description Retrieve all applications present in the system.
+// @Description Retrieve a page of applications, optionally sorted and filtered by applicant, scheme, status and creation date.
 // @Tags Applications
 // @Accept json
 // @Produce json
-// @Success 200 {array} ApplicationsResponse "Applications retrieved successfully."
+// @Security BearerAuth
+// @Param page_size query int false "Number of applications per page (1-100, default 20)"
+// @Param page_token query string false "Token of the page to retrieve, from the next_page_token of the previous page"
+// @Param sort_by query string false "Field to sort by" Enums(created_at, updated_at, status)
+// @Param sort_order query string false "Sort order" Enums(asc, desc)
+// @Param applicant_id query string false "Applicant ID" format(uuid)
+// @Param scheme_id query string false "Scheme ID" format(uuid)
+// @Param status query string false "Application status" Enums(submitted, under_review, approved, rejected, withdrawn, disbursed)
+// @Param created_from query string false "Only include applications created on or after this date" format(date)
+// @Param created_to query string false "Only include applications created on or before this date" format(date)
+// @Success 200 {object} ApplicationsResponse "Applications retrieved successfully."
+// @Failure 400 {object} ErrorResponse "Invalid input data."
 // @Failure 500 {object} ErrorResponse "Internal server error."
 // @Router /applications [get]
 func (h *ApplicationHandler) ListApplications(ctx *gin.Context) {
-	applications, err := h.s.ListApplications(ctx)
+	var req ListApplicationsRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	opts, err := newListOptions(req.PageSize, req.PageToken, req.SortBy, req.SortOrder)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	filter := domain.ApplicationFilter{
+		ListOptions: opts,
+		Status:      req.Status,
+	}
+
+	if req.ApplicantID != "" {
+		applicantID, err := uuid.Parse(req.ApplicantID)
+		if err != nil {
+			handleError(ctx, domain.InvalidApplicantError)
+			return
+		}
+		filter.ApplicantID = &applicantID
+	}
+
+	if req.SchemeID != "" {
+		schemeID, err := uuid.Parse(req.SchemeID)
+		if err != nil {
+			handleError(ctx, domain.InvalidSchemeError)
+			return
+		}
+		filter.SchemeID = &schemeID
+	}
+
+	if req.CreatedFrom != "" {
+		createdFrom, err := util.ParseDate(req.CreatedFrom)
+		if err != nil {
+			handleError(ctx, domain.InvalidDateRangeError)
+			return
+		}
+		filter.CreatedFrom = &createdFrom
+	}
+
+	// The range includes the whole of its last day
+	if req.CreatedTo != "" {
+		createdTo, err := util.ParseDate(req.CreatedTo)
+		if err != nil {
+			handleError(ctx, domain.InvalidDateRangeError)
+			return
+		}
+		createdBefore := createdTo.AddDate(0, 0, 1)
+		filter.CreatedBefore = &createdBefore
+	}
+
+	applications, total, err := h.s.ListApplications(ctx, filter)
 
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	rsp := newApplicationsResponse(applications)
+	rsp := newApplicationsResponse(applications, total, nextPageToken(opts, len(applications), total))
 	handleSuccess(ctx, http.StatusOK, "Successfully retrieved applications.", rsp)
 	return
 }
@@ -88,9 +158,11 @@ func (h *ApplicationHandler) ListApplications(ctx *gin.Context) {
 // @Tags Applications
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param CreateApplicationRequest body CreateApplicationRequest true "Application creation payload"
 // @Success 201 {object} ApplicationResponse "Application created successfully."
 // @Failure 400 {object} ErrorResponse "Invalid input data."
+// @Failure 409 {object} ErrorResponse "Applicant already has an active application for the scheme or is within its reapply cooldown."
 // @Failure 500 {object} ErrorResponse "Internal server error."
 // @Router /applications [post]
 func (h *ApplicationHandler) CreateApplication(ctx *gin.Context) {
@@ -140,11 +212,13 @@ func (h *ApplicationHandler) CreateApplication(ctx *gin.Context) {
 // @Tags Applications
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "Application ID"
 // @Param UpdateApplicationRequest body UpdateApplicationRequest true "Application update payload"
 // @Success 200 {object} ApplicationResponse "Application updated successfully."
 // @Failure 400 {object} ErrorResponse "Invalid input data."
 // @Failure 404 {object} ErrorResponse "Application not found."
+// @Failure 409 {object} ErrorResponse "Application can no longer be edited."
 // @Failure 500 {object} ErrorResponse "Internal server error."
 // @Router /applications/{id} [put]
 func (h *ApplicationHandler) UpdateApplication(ctx *gin.Context) {
@@ -191,6 +265,127 @@ func (h *ApplicationHandler) UpdateApplication(ctx *gin.Context) {
 	return
 }
 
+// ReviewApplication godoc
+//
+// @Summary Review an application
+// @Description Moves a submitted application to under review.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Success 200 {object} ApplicationResponse "Application moved to under review successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Application not found."
+// @Failure 409 {object} ErrorResponse "Invalid status transition."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/review [post]
+func (h *ApplicationHandler) ReviewApplication(ctx *gin.Context) {
+	h.transitionApplication(ctx, domain.ApplicationStatusUnderReview, "Successfully moved application to under review.")
+}
+
+// ApproveApplication godoc
+//
+// @Summary Approve an application
+// @Description Approves an application that is under review.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Success 200 {object} ApplicationResponse "Application approved successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Application not found."
+// @Failure 409 {object} ErrorResponse "Invalid status transition."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/approve [post]
+func (h *ApplicationHandler) ApproveApplication(ctx *gin.Context) {
+	h.transitionApplication(ctx, domain.ApplicationStatusApproved, "Successfully approved application.")
+}
+
+// RejectApplication godoc
+//
+// @Summary Reject an application
+// @Description Rejects an application that is under review.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Success 200 {object} ApplicationResponse "Application rejected successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Application not found."
+// @Failure 409 {object} ErrorResponse "Invalid status transition."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/reject [post]
+func (h *ApplicationHandler) RejectApplication(ctx *gin.Context) {
+	h.transitionApplication(ctx, domain.ApplicationStatusRejected, "Successfully rejected application.")
+}
+
+// WithdrawApplication godoc
+//
+// @Summary Withdraw an application
+// @Description Withdraws an application that is submitted or under review.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Success 200 {object} ApplicationResponse "Application withdrawn successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Application not found."
+// @Failure 409 {object} ErrorResponse "Invalid status transition."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/withdraw [post]
+func (h *ApplicationHandler) WithdrawApplication(ctx *gin.Context) {
+	h.transitionApplication(ctx, domain.ApplicationStatusWithdrawn, "Successfully withdrew application.")
+}
+
+// DisburseApplication godoc
+//
+// @Summary Disburse an application
+// @Description Marks the benefits of an approved application as disbursed.
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Success 200 {object} ApplicationResponse "Application disbursed successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Application not found."
+// @Failure 409 {object} ErrorResponse "Invalid status transition."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/disburse [post]
+func (h *ApplicationHandler) DisburseApplication(ctx *gin.Context) {
+	h.transitionApplication(ctx, domain.ApplicationStatusDisbursed, "Successfully disbursed application.")
+}
+
+// transitionApplication moves the application in the request URI to the given status and sends the updated application.
+func (h *ApplicationHandler) transitionApplication(ctx *gin.Context, status domain.ApplicationStatus, message string) {
+	var reqUri ApplicationRequestUri
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	id, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidApplicationError)
+		return
+	}
+
+	application, err := h.s.TransitionApplication(ctx, id, status)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newApplicationResponse(*application)
+	handleSuccess(ctx, http.StatusOK, message, rsp)
+}
+
 // DeleteApplication godoc
 //
 // @Summary Delete an application by ID
@@ -198,6 +393,7 @@ func (h *ApplicationHandler) UpdateApplication(ctx *gin.Context) {
 // @Tags Applications
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "Application ID"
 // @Success 200 {object} Response "Application deleted successfully."
 // @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+// AuditHandler provides HTTP handler methods for reading the audit log using an AuditService.
+type AuditHandler struct {
+	s port.AuditService
+}
+
+// NewAuditHandler initializes a new AuditHandler with the provided AuditService.
+func NewAuditHandler(s port.AuditService) *AuditHandler {
+	return &AuditHandler{s: s}
+}
+
+// ListAuditLogs godoc
+// @Summary	  List Audit Log Entries
+// @Description  Retrieves a page of audit log entries, newest first, optionally filtered by entity, actor and action.
+// @Tags		 Audit
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		page_size	query	 int	 false  "Number of entries per page (1-100, default 20)"
+// @Param		page_token   query	 string  false  "Token of the page to retrieve, from the next_page_token of the previous page"
+// @Param		sort_order   query	 string  false  "Sort order of the creation date" Enums(asc, desc)
+// @Param		entity_type  query	 string  false  "Entity type" Enums(applicant, relationship, scheme, benefit, benefit_criteria, scheme_criteria, criteria_group, scheme_version, application, disbursement, user)
+// @Param		entity_id	query	 string  false  "Entity ID" format(uuid)
+// @Param		actor_id	 query	 string  false  "ID of the user who made the change" format(uuid)
+// @Param		action	   query	 string  false  "Action" Enums(create, update, delete)
+// @Success	  200  {object}  AuditLogsResponse  "Successfully retrieved audit log entries."
+// @Failure	  400  {object}  ErrorResponse	  "Bad Request"
+// @Failure	  401  {object}  ErrorResponse	  "Unauthorized"
+// @Failure	  403  {object}  ErrorResponse	  "Forbidden"
+// @Failure	  500  {object}  ErrorResponse	  "Internal Server Error"
+// @Router	   /audit [get]
+func (h *AuditHandler) ListAuditLogs(ctx *gin.Context) {
+	var req ListAuditLogsRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	// Entries are always sorted by when they were recorded
+	sortBy := ""
+	if req.SortOrder != "" {
+		sortBy = "created_at"
+	}
+
+	opts, err := newListOptions(req.PageSize, req.PageToken, sortBy, req.SortOrder)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	filter := domain.AuditLogFilter{
+		ListOptions: opts,
+		EntityType:  req.EntityType,
+		Action:      req.Action,
+	}
+
+	if req.EntityID != "" {
+		entityID, err := uuid.Parse(req.EntityID)
+		if err != nil {
+			handleError(ctx, domain.InvalidAuditEntityError)
+			return
+		}
+		filter.EntityID = &entityID
+	}
+
+	if req.ActorID != "" {
+		actorID, err := uuid.Parse(req.ActorID)
+		if err != nil {
+			handleError(ctx, domain.InvalidUserError)
+			return
+		}
+		filter.ActorID = &actorID
+	}
+
+	auditLogs, total, err := h.s.ListAuditLogs(ctx, filter)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newAuditLogsResponse(auditLogs, total, nextPageToken(opts, len(auditLogs), total))
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved audit log entries.", rsp)
+}
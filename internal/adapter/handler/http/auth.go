@@ -0,0 +1,78 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// AuthHandler provides HTTP handler methods for signing in using an AuthService and UserService.
+type AuthHandler struct {
+	s  port.AuthService
+	us port.UserService
+}
+
+// NewAuthHandler initializes a new AuthHandler with the provided AuthService and UserService.
+func NewAuthHandler(s port.AuthService, us port.UserService) *AuthHandler {
+	return &AuthHandler{s: s, us: us}
+}
+
+// Login godoc
+// @Summary	  Sign In
+// @Description  Checks the email and password of a user and issues an access token to send as "Authorization: Bearer <token>".
+// @Tags		 Auth
+// @Accept	   json
+// @Produce	  json
+// @Param		LoginRequest  body	  LoginRequest  true  "Credentials of the user"
+// @Success	  200  {object}  LoginResponse  "Successfully signed in."
+// @Failure	  400  {object}  ErrorResponse  "Bad Request"
+// @Failure	  401  {object}  ErrorResponse  "Invalid Email or Password"
+// @Failure	  500  {object}  ErrorResponse  "Internal Server Error"
+// @Router	   /auth/login [post]
+func (h *AuthHandler) Login(ctx *gin.Context) {
+	var req LoginRequest
+
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	accessToken, payload, err := h.s.Login(ctx, req.Email, req.Password)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newLoginResponse(accessToken, *payload)
+	handleSuccess(ctx, http.StatusOK, "Successfully signed in.", rsp)
+}
+
+// Me godoc
+// @Summary	  Retrieve the Current User
+// @Description  Retrieves the details of the user the access token was issued to.
+// @Tags		 Auth
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Success	  200  {object}  UserResponse   "Successfully retrieved current user."
+// @Failure	  401  {object}  ErrorResponse  "Unauthorized"
+// @Failure	  500  {object}  ErrorResponse  "Internal Server Error"
+// @Router	   /auth/me [get]
+func (h *AuthHandler) Me(ctx *gin.Context) {
+	payload := getAuthPayload(ctx)
+	if payload == nil {
+		handleError(ctx, domain.MissingTokenError)
+		return
+	}
+
+	user, err := h.us.GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newUserResponse(*user)
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved current user.", rsp)
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/util"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// CriteriaHandler provides HTTP handler methods for discovering the criteria that schemes and benefits can use.
+type CriteriaHandler struct{}
+
+// NewCriteriaHandler initializes a new CriteriaHandler.
+func NewCriteriaHandler() *CriteriaHandler {
+	return &CriteriaHandler{}
+}
+
+// ListCriterionTypes godoc
+// @Summary	  List criterion types
+// @Description  Retrieve every criterion that schemes and benefits can use, with a description of the values each criterion accepts.
+// @Tags		  Criteria
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Success	  200	   {object}  CriterionTypesResponse  "Successfully retrieved criterion types"
+// @Failure	  500	   {object}  ErrorResponse		   "Internal server error"
+// @Router		  /criteria/types [get]
+func (h *CriteriaHandler) ListCriterionTypes(ctx *gin.Context) {
+	rsp := newCriterionTypesResponse(util.CriterionTypes())
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved criterion types.", rsp)
+}
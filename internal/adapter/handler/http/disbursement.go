@@ -0,0 +1,214 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+// DisbursementHandler provides HTTP handler methods for the disbursement ledger using a DisbursementService.
+type DisbursementHandler struct {
+	s port.DisbursementService
+}
+
+// NewDisbursementHandler initializes a new DisbursementHandler with the provided DisbursementService.
+func NewDisbursementHandler(s port.DisbursementService) *DisbursementHandler {
+	return &DisbursementHandler{s: s}
+}
+
+// ListApplicationDisbursements godoc
+//
+// @Summary List the disbursements of an application
+// @Description Retrieves the payouts scheduled for an application when it was approved, earliest due first.
+// @Tags Disbursements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Success 200 {object} DisbursementsResponse "Disbursements retrieved successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Application not found."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/disbursements [get]
+func (h *DisbursementHandler) ListApplicationDisbursements(ctx *gin.Context) {
+	var reqUri ApplicationRequestUri
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	applicationID, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidApplicationError)
+		return
+	}
+
+	disbursements, err := h.s.ListApplicationDisbursements(ctx, applicationID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newDisbursementsResponse(disbursements)
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved disbursements.", rsp)
+}
+
+// ListApplicantDisbursements godoc
+//
+// @Summary Retrieve the payout history of an applicant
+// @Description Retrieves the disbursements of every application of an applicant, latest due first, and the total amount paid.
+// @Tags Disbursements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Applicant ID"
+// @Success 200 {object} PayoutHistoryResponse "Payout history retrieved successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Applicant not found."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applicants/{id}/disbursements [get]
+func (h *DisbursementHandler) ListApplicantDisbursements(ctx *gin.Context) {
+	var reqUri ApplicantRequestUri
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	applicantID, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidApplicantError)
+		return
+	}
+
+	disbursements, err := h.s.ListApplicantDisbursements(ctx, applicantID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp, err := newPayoutHistoryResponse(disbursements)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved payout history.", rsp)
+}
+
+// PayDisbursement godoc
+//
+// @Summary Mark a disbursement as paid
+// @Description Records that a scheduled disbursement has been paid.
+// @Tags Disbursements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Param disbursement_id path string true "Disbursement ID"
+// @Success 200 {object} DisbursementResponse "Disbursement paid successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Disbursement not found."
+// @Failure 409 {object} ErrorResponse "Invalid status transition."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/disbursements/{disbursement_id}/pay [post]
+func (h *DisbursementHandler) PayDisbursement(ctx *gin.Context) {
+	h.transitionDisbursement(ctx, domain.DisbursementStatusPaid, "Successfully paid disbursement.")
+}
+
+// FailDisbursement godoc
+//
+// @Summary Mark a disbursement as failed
+// @Description Records that the payment of a scheduled disbursement failed.
+// @Tags Disbursements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Param disbursement_id path string true "Disbursement ID"
+// @Success 200 {object} DisbursementResponse "Disbursement failed successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Disbursement not found."
+// @Failure 409 {object} ErrorResponse "Invalid status transition."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/disbursements/{disbursement_id}/fail [post]
+func (h *DisbursementHandler) FailDisbursement(ctx *gin.Context) {
+	h.transitionDisbursement(ctx, domain.DisbursementStatusFailed, "Successfully marked disbursement as failed.")
+}
+
+// RetryDisbursement godoc
+//
+// @Summary Retry a failed disbursement
+// @Description Schedules a failed disbursement to be paid again.
+// @Tags Disbursements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Param disbursement_id path string true "Disbursement ID"
+// @Success 200 {object} DisbursementResponse "Disbursement rescheduled successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Disbursement not found."
+// @Failure 409 {object} ErrorResponse "Invalid status transition."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/disbursements/{disbursement_id}/retry [post]
+func (h *DisbursementHandler) RetryDisbursement(ctx *gin.Context) {
+	h.transitionDisbursement(ctx, domain.DisbursementStatusScheduled, "Successfully rescheduled disbursement.")
+}
+
+// ClawBackDisbursement godoc
+//
+// @Summary Claw back a disbursement
+// @Description Records that a paid disbursement has been recovered from the applicant.
+// @Tags Disbursements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Param disbursement_id path string true "Disbursement ID"
+// @Success 200 {object} DisbursementResponse "Disbursement clawed back successfully."
+// @Failure 400 {object} ErrorResponse "Invalid UUID or bad input."
+// @Failure 404 {object} ErrorResponse "Disbursement not found."
+// @Failure 409 {object} ErrorResponse "Invalid status transition."
+// @Failure 500 {object} ErrorResponse "Internal server error."
+// @Router /applications/{id}/disbursements/{disbursement_id}/claw-back [post]
+func (h *DisbursementHandler) ClawBackDisbursement(ctx *gin.Context) {
+	h.transitionDisbursement(ctx, domain.DisbursementStatusClawedBack, "Successfully clawed back disbursement.")
+}
+
+// transitionDisbursement moves the disbursement in the request URI to the given status and sends the updated disbursement.
+func (h *DisbursementHandler) transitionDisbursement(ctx *gin.Context, status domain.DisbursementStatus, message string) {
+	var reqUri DisbursementRequestUri
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	applicationID, err := uuid.Parse(reqUri.ApplicationID)
+	if err != nil {
+		handleError(ctx, domain.InvalidApplicationError)
+		return
+	}
+
+	id, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidDisbursementError)
+		return
+	}
+
+	disbursement, err := h.s.TransitionDisbursement(ctx, applicationID, id, status)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newDisbursementResponse(*disbursement)
+	handleSuccess(ctx, http.StatusOK, message, rsp)
+}
@@ -48,42 +48,230 @@ var errorMap = map[error]struct {
 	},
 	domain.InvalidSchemeCriteriaNameError: {
 		StatusCode: http.StatusBadRequest,
-		Message:    "Invalid scheme criteria name, only employment_status, marital_status, has_children, or age are allowed.",
+		Message:    "Invalid scheme criteria name.",
 	},
-	domain.InvalidSchemeCriteriaAgeValueError: {
+	domain.InvalidSchemeCriteriaValueError: {
 		StatusCode: http.StatusBadRequest,
-		Message:    "Invalid scheme criteria age value, must start with an operator and ends with a number. Valid operators are: >, >=, <, <=, and ==. (e.g. >25, >=25, <25, <=25, ==25).",
+		Message:    "Invalid scheme criteria value.",
 	},
-	domain.InvalidSchemeCriteriaEmploymentStatusValueError: {
+	domain.InvalidSchemeCriteriaError: {
 		StatusCode: http.StatusBadRequest,
-		Message:    "Invalid scheme criteria employment status value, must be either employed or unemployed.",
+		Message:    "Invalid scheme criteria.",
 	},
-	domain.InvalidSchemeCriteriaMaritalStatusValueError: {
+	domain.InvalidCriteriaGroupError: {
 		StatusCode: http.StatusBadRequest,
-		Message:    "Invalid scheme criteria marital status value, must be either single, married, widowed or divorced.",
+		Message:    "Invalid criteria group id.",
 	},
-	domain.InvalidSchemeCriteriaHasChildrenValueError: {
+	domain.InvalidCriteriaGroupOperatorError: {
 		StatusCode: http.StatusBadRequest,
-		Message:    "Invalid scheme criteria has children value, must be either true or false.",
+		Message:    "Invalid criteria group operator, must be either and, or, or not.",
 	},
-	domain.InvalidSchemeCriteriaError: {
+	domain.EmptyCriteriaGroupError: {
 		StatusCode: http.StatusBadRequest,
-		Message:    "Invalid scheme criteria.",
+		Message:    "Criteria group must contain at least one criteria or nested group.",
+	},
+	domain.InvalidNotCriteriaGroupError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "A not criteria group must contain exactly one criteria or nested group.",
+	},
+	domain.CriteriaGroupNotFoundError: {
+		StatusCode: http.StatusNotFound,
+		Message:    "Criteria group not found.",
+	},
+	domain.NestedSchemeCriteriaError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Criteria belongs to a criteria group, delete its top-level criteria group instead.",
+	},
+	domain.EmptySchemeCriteriaError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Criteria name and value are required.",
+	},
+	domain.InvalidAsOfDateError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid as_of date, must be in the format YYYY-MM-DD.",
+	},
+	domain.InvalidSchemeVersionError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid scheme version id.",
+	},
+	domain.SchemeVersionNotFoundError: {
+		StatusCode: http.StatusNotFound,
+		Message:    "Scheme version not found.",
+	},
+	domain.InvalidSchemeVersionTransitionError: {
+		StatusCode: http.StatusConflict,
+		Message:    "Scheme version cannot move to this status from its current status.",
+	},
+	domain.InvalidEffectiveDatesError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid effective dates, effective_to must be after effective_from and both must be in the format YYYY-MM-DD.",
+	},
+	domain.BackdatedSchemeVersionError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Scheme version cannot take effect before today.",
+	},
+	domain.SchemeVersionOverlapError: {
+		StatusCode: http.StatusConflict,
+		Message:    "Scheme version overlaps with the effective dates of another published version.",
+	},
+	domain.DuplicateSchemeVersionDraftError: {
+		StatusCode: http.StatusConflict,
+		Message:    "Scheme already has a draft version.",
+	},
+	domain.SchemeVersionNotDraftError: {
+		StatusCode: http.StatusConflict,
+		Message:    "Only draft scheme versions can be deleted.",
+	},
+	domain.SchemeNotEffectiveError: {
+		StatusCode: http.StatusConflict,
+		Message:    "Scheme has no published version in effect on this date.",
+	},
+	domain.InvalidBenefitCriteriaError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid benefit criteria id.",
+	},
+	domain.BenefitCriteriaNotFoundError: {
+		StatusCode: http.StatusNotFound,
+		Message:    "Benefit criteria not found.",
 	},
 	domain.BenefitNotFoundError: {
 		StatusCode: http.StatusNotFound,
 		Message:    "Benefit not found.",
 	},
+	domain.InvalidPageTokenError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid page token.",
+	},
+	domain.InvalidSortFieldError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid sort field.",
+	},
+	domain.InvalidDateRangeError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "The start of the date range must be before its end.",
+	},
+	domain.InvalidAgeRangeError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "The minimum age must not be greater than the maximum age.",
+	},
 	domain.ApplicationNotFoundError: {
 		StatusCode: http.StatusNotFound,
 		Message:    "Application not found.",
 	},
+	domain.InvalidApplicationStatusTransitionError: {
+		StatusCode: http.StatusConflict,
+		Message:    "The application cannot be moved to this status from its current status.",
+	},
+	domain.ApplicationNotEditableError: {
+		StatusCode: http.StatusConflict,
+		Message:    "Only submitted applications can be edited.",
+	},
+	domain.DuplicateApplicationError: {
+		StatusCode: http.StatusConflict,
+		Message:    "Applicant already has an active application for this scheme.",
+	},
+	domain.ApplicationCooldownError: {
+		StatusCode: http.StatusConflict,
+		Message:    "Applicant cannot reapply to this scheme until the reapply cooldown has passed.",
+	},
 	domain.NoUpdateFieldsError: {
 		StatusCode: http.StatusBadRequest,
 		Message:    "No fields to update.",
 	},
+	domain.InvalidRelationshipError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid relationship id.",
+	},
+	domain.RelationshipNotFoundError: {
+		StatusCode: http.StatusNotFound,
+		Message:    "Relationship not found.",
+	},
+	domain.SelfRelationshipError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "An applicant cannot be related to themselves.",
+	},
+	domain.DuplicateRelationshipError: {
+		StatusCode: http.StatusConflict,
+		Message:    "These applicants are already related.",
+	},
+	domain.InvalidUserError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid user id.",
+	},
+	domain.UserNotFoundError: {
+		StatusCode: http.StatusNotFound,
+		Message:    "User not found.",
+	},
+	domain.DuplicateUserEmailError: {
+		StatusCode: http.StatusConflict,
+		Message:    "Another user already has this email.",
+	},
+	domain.InvalidBenefitScheduleError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Monthly and quarterly benefits must have a duration in months, and one-off benefits must not have one.",
+	},
+	domain.MissingBenefitAmountError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Benefits must have an amount.",
+	},
+	domain.InvalidDisbursementError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid disbursement id.",
+	},
+	domain.DisbursementNotFoundError: {
+		StatusCode: http.StatusNotFound,
+		Message:    "Disbursement not found.",
+	},
+	domain.InvalidDisbursementTransitionError: {
+		StatusCode: http.StatusConflict,
+		Message:    "The disbursement cannot be moved to this status from its current status.",
+	},
+	domain.InvalidAmountError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid amount, must be a non-negative decimal with at most two decimal places (e.g. 100.50).",
+	},
+	domain.AmountOutOfRangeError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Amount is too large, must be at most 999999999999.99.",
+	},
+	domain.CurrencyMismatchError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "The amount and cap of a benefit must be in the same currency.",
+	},
+	domain.InvalidCredentialsError: {
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Invalid email or password.",
+	},
+	domain.MissingTokenError: {
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Authorization token is missing.",
+	},
+	domain.InvalidTokenError: {
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Authorization token is invalid.",
+	},
+	domain.ExpiredTokenError: {
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Authorization token has expired.",
+	},
+	domain.ForbiddenError: {
+		StatusCode: http.StatusForbidden,
+		Message:    "You are not allowed to perform this action.",
+	},
+	domain.InvalidAuditEntityError: {
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid audit entity id.",
+	},
 	domain.SchemeNotEligibleError: {
 		StatusCode: http.StatusBadRequest,
 		Message:    "Applicant does not meet the eligibility criteria for the scheme.",
 	},
+	domain.DatabaseUnavailableError: {
+		StatusCode: http.StatusServiceUnavailable,
+		Message:    "Database is unavailable.",
+	},
+	domain.OutdatedSchemaError: {
+		StatusCode: http.StatusServiceUnavailable,
+		Message:    "Database schema is behind the latest migration.",
+	},
 }
@@ -2,10 +2,14 @@ package http
 
 import (
 	"errors"
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
 	"github.com/cxnub/fas-mgmt-system/internal/core/util"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"log/slog"
 	"net/http"
+	"strings"
 )
 
 // InternalServerError sends a 500 Internal Server Error response with a generic error message in JSON format.
@@ -26,14 +30,59 @@ func validationError(ctx *gin.Context, err error, obj interface{}) {
 		ctx.JSON(http.StatusBadRequest, newValidationErrorResponse(errorMap))
 		return
 	}
+
+	// The request could not be parsed, e.g. malformed JSON or a query parameter of the wrong type
+	ctx.JSON(http.StatusBadRequest, newErrorResponse("Invalid request."))
 }
 
 func handleError(ctx *gin.Context, err error) {
-	if errInfo, exists := errorMap[err]; exists {
-		ctx.JSON(errInfo.StatusCode, newErrorResponse(errInfo.Message))
-	} else {
-		InternalServerError(ctx)
+	for target, errInfo := range errorMap {
+		if errors.Is(err, target) {
+			rsp := newErrorResponse(errorMessage(err, errInfo.Message))
+			rsp.Errors = errorDetails(err)
+			ctx.JSON(errInfo.StatusCode, rsp)
+			return
+		}
+	}
+
+	slog.ErrorContext(ctx.Request.Context(), "Unexpected error while handling request", "error", err)
+	InternalServerError(ctx)
+}
+
+// errorMessage describes errors whose message depends on the registered criteria, such as an invalid criterion value,
+// and returns the given message for any other error.
+func errorMessage(err error, message string) string {
+	if errors.Is(err, domain.InvalidSchemeCriteriaNameError) {
+		return fmt.Sprintf("Invalid scheme criteria name, only %s are allowed.", util.JoinAlternatives(util.CriterionNames()))
+	}
+
+	var criterionValueErr *domain.InvalidCriterionValueError
+	if errors.As(err, &criterionValueErr) {
+		criterionType := criterionValueErr.Criterion
+		return fmt.Sprintf(
+			"Invalid scheme criteria %s value, must be %s (e.g. %s).",
+			criterionType.Name,
+			criterionType.Format,
+			strings.Join(criterionType.Examples, ", "),
+		)
 	}
+
+	return message
+}
+
+// errorDetails extracts additional details from errors that carry them, such as the ID of a conflicting application.
+func errorDetails(err error) map[string]string {
+	var activeApplicationErr *domain.ActiveApplicationError
+	if errors.As(err, &activeApplicationErr) {
+		return map[string]string{"application_id": activeApplicationErr.ApplicationID.String()}
+	}
+
+	var cooldownErr *domain.ReapplicationCooldownError
+	if errors.As(err, &cooldownErr) {
+		return map[string]string{"reapply_from": cooldownErr.ReapplyFrom.Format(util.DateLayout)}
+	}
+
+	return nil
 }
 
 // handleSuccess sends a JSON response with the provided HTTP status code, message, and data.
@@ -0,0 +1,35 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// HealthHandler provides HTTP handler methods for probing whether the API is alive and ready to serve requests.
+// The probes are served outside of /api and are not documented in Swagger, which only describes routes under /api.
+type HealthHandler struct {
+	checker port.HealthChecker
+}
+
+// NewHealthHandler initializes a new HealthHandler with the provided checker of the API's dependencies.
+func NewHealthHandler(checker port.HealthChecker) *HealthHandler {
+	return &HealthHandler{checker}
+}
+
+// Liveness responds to the liveness probe at /healthz. It succeeds as long as the server can handle requests,
+// without checking the database, so that an unavailable database does not get the server restarted.
+func (h *HealthHandler) Liveness(ctx *gin.Context) {
+	handleSuccess(ctx, http.StatusOK, "Alive.", nil)
+}
+
+// Readiness responds to the readiness probe at /readyz. It fails with 503 Service Unavailable if the database cannot be
+// reached or migrations in the binary have not been applied to it.
+func (h *HealthHandler) Readiness(ctx *gin.Context) {
+	if err := h.checker.CheckReadiness(ctx); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, http.StatusOK, "Ready.", nil)
+}
@@ -0,0 +1,22 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsHandler provides the HTTP handler that exposes the metrics of the API to Prometheus.
+// Like the health probes, the metrics are served outside of /api and are not documented in Swagger.
+type MetricsHandler struct {
+	metrics *metrics.Metrics
+}
+
+// NewMetricsHandler initializes a new MetricsHandler with the provided metrics.
+func NewMetricsHandler(metrics *metrics.Metrics) *MetricsHandler {
+	return &MetricsHandler{metrics}
+}
+
+// Metrics serves the metrics at /metrics in the Prometheus exposition format.
+func (h *MetricsHandler) Metrics(ctx *gin.Context) {
+	h.metrics.Handler().ServeHTTP(ctx.Writer, ctx.Request)
+}
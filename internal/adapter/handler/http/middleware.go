@@ -0,0 +1,200 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/metrics"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+const (
+	// authorizationHeaderKey is the header that carries the access token
+	authorizationHeaderKey = "Authorization"
+	// authorizationType is the only supported authorization scheme
+	authorizationType = "bearer"
+	// authorizationPayloadKey is the key under which the verified token payload is stored in the gin context
+	authorizationPayloadKey = "authorization_payload"
+)
+
+// authMiddleware is a middleware that authenticates the bearer token of a request with the given AuthService
+// and stores its payload in the context, where repositories can attribute changes to the user.
+// Requests without a valid token, or from users that have been deleted, are rejected, and the payload carries the
+// current role of the user rather than the one the token was issued with.
+func authMiddleware(authService port.AuthService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader(authorizationHeaderKey)
+		if header == "" {
+			abortWithError(ctx, domain.MissingTokenError)
+			return
+		}
+
+		fields := strings.Fields(header)
+		if len(fields) != 2 || strings.ToLower(fields[0]) != authorizationType {
+			abortWithError(ctx, domain.InvalidTokenError)
+			return
+		}
+
+		payload, err := authService.Authenticate(ctx, fields[1])
+		if err != nil {
+			abortWithError(ctx, err)
+			return
+		}
+
+		ctx.Set(authorizationPayloadKey, payload)
+		ctx.Request = ctx.Request.WithContext(domain.ContextWithActor(ctx.Request.Context(), payload))
+		ctx.Next()
+	}
+}
+
+// requirePermission is a middleware that rejects requests from users whose role is not granted the given permission.
+// It must run after authMiddleware.
+func requirePermission(permission domain.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload := getAuthPayload(ctx)
+		if payload == nil {
+			abortWithError(ctx, domain.MissingTokenError)
+			return
+		}
+
+		if !payload.Role.Can(permission) {
+			abortWithError(ctx, domain.ForbiddenError)
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// getAuthPayload returns the token payload of the authenticated user, or nil if the request was not authenticated.
+func getAuthPayload(ctx *gin.Context) *domain.TokenPayload {
+	payload, exists := ctx.Get(authorizationPayloadKey)
+	if !exists {
+		return nil
+	}
+
+	return payload.(*domain.TokenPayload)
+}
+
+// abortWithError sends an error response and stops the remaining handlers from running.
+func abortWithError(ctx *gin.Context, err error) {
+	handleError(ctx, err)
+	ctx.Abort()
+}
+
+// requestIDHeaderKey is the header that carries the ID of a request
+const requestIDHeaderKey = "X-Request-ID"
+
+// maxRequestIDLength is the longest request ID accepted from a client
+const maxRequestIDLength = 128
+
+// requestIDMiddleware is a middleware that gives every request an ID and stores it in the request context, where it is
+// added to everything logged while handling the request. The ID sent by the client in the X-Request-ID header is used
+// if it is valid, so that requests can be traced through a proxy, and a new one is generated otherwise.
+// The ID is returned in the X-Request-ID header of the response.
+func requestIDMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(requestIDHeaderKey)
+		if !isValidRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+
+		ctx.Header(requestIDHeaderKey, requestID)
+		ctx.Request = ctx.Request.WithContext(domain.ContextWithRequestID(ctx.Request.Context(), requestID))
+		ctx.Next()
+	}
+}
+
+// isValidRequestID checks if a request ID is short and made of printable ASCII characters other than spaces,
+// so that it can be logged as it is
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, c := range requestID {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// accessLogMiddleware is a middleware that logs every request once it has been handled, with its route, status and latency.
+// Requests that fail with a server error are logged as errors.
+func accessLogMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		ctx.Next()
+
+		status := ctx.Writer.Status()
+		level := slog.LevelInfo
+		if status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+
+		slog.LogAttrs(ctx.Request.Context(), level, "HTTP request",
+			slog.String("method", ctx.Request.Method),
+			slog.String("route", ctx.FullPath()),
+			slog.String("path", ctx.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.Int("size", max(ctx.Writer.Size(), 0)),
+			slog.String("client_ip", ctx.ClientIP()),
+		)
+	}
+}
+
+// recoveryMiddleware is a middleware that recovers from panics in the handlers of a request, logging the panic and
+// responding with an internal server error, so that a panic does not stop the server.
+func recoveryMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			// The handler gave up on the response on purpose, so let the server drop the connection
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			slog.ErrorContext(ctx.Request.Context(), "Recovered from panic while handling request",
+				"panic", recovered,
+				"stack", string(debug.Stack()),
+			)
+
+			if !ctx.Writer.Written() {
+				InternalServerError(ctx)
+			}
+			ctx.Abort()
+		}()
+
+		ctx.Next()
+	}
+}
+
+// metricsMiddleware is a middleware that records how long every request took to handle, by route and status.
+// Requests that match no route are recorded under a single route so that unknown paths do not create new series.
+func metricsMiddleware(metrics *metrics.Metrics) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		metrics.ObserveHTTPRequest(ctx.Request.Method, route, ctx.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newMiddlewareTestRouter creates a router with the request middlewares of NewRouter and the given handler at /test
+func newMiddlewareTestRouter(handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(requestIDMiddleware(), accessLogMiddleware(), recoveryMiddleware())
+	router.ContextWithFallback = true
+	router.GET("/test", handler)
+	return router
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var contextRequestID string
+	router := newMiddlewareTestRouter(func(ctx *gin.Context) {
+		contextRequestID = domain.RequestIDFromContext(ctx)
+		handleSuccess(ctx, http.StatusOK, "OK.", nil)
+	})
+
+	tests := []struct {
+		name          string
+		header        string
+		wantGenerated bool
+	}{
+		{name: "generated when missing", header: "", wantGenerated: true},
+		{name: "propagated from the client", header: "client-request-42", wantGenerated: false},
+		{name: "generated when too long", header: strings.Repeat("a", maxRequestIDLength+1), wantGenerated: true},
+		{name: "generated when not printable", header: "bad id\twith spaces", wantGenerated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			if tt.header != "" {
+				req.Header.Set(requestIDHeaderKey, tt.header)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			requestID := w.Header().Get(requestIDHeaderKey)
+			if requestID == "" {
+				t.Fatalf("response has no %s header", requestIDHeaderKey)
+			}
+			if requestID != contextRequestID {
+				t.Errorf("request context has ID %q, response header has %q", contextRequestID, requestID)
+			}
+			if generated := requestID != tt.header; generated != tt.wantGenerated {
+				t.Errorf("request ID %q generated = %t, want %t", requestID, generated, tt.wantGenerated)
+			}
+		})
+	}
+}
+
+func TestRecoveryMiddleware(t *testing.T) {
+	router := newMiddlewareTestRouter(func(ctx *gin.Context) {
+		panic("something went wrong")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("GET /test returned %d, want %d: %s", w.Code, http.StatusInternalServerError, w.Body.String())
+	}
+	if w.Header().Get(requestIDHeaderKey) == "" {
+		t.Errorf("response has no %s header", requestIDHeaderKey)
+	}
+}
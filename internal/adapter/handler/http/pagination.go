@@ -0,0 +1,60 @@
+package http
+
+import (
+	"encoding/base64"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"strconv"
+)
+
+const (
+	// defaultPageSize is the number of items returned by list endpoints when no page size is requested
+	defaultPageSize = 20
+)
+
+// newListOptions converts the paging and sorting query string parameters of a list request into domain.ListOptions.
+func newListOptions(pageSize int, pageToken string, sortBy string, sortOrder domain.SortOrder) (domain.ListOptions, error) {
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	offset, err := decodePageToken(pageToken)
+	if err != nil {
+		return domain.ListOptions{}, err
+	}
+
+	return domain.ListOptions{
+		Limit:     pageSize,
+		Offset:    offset,
+		SortBy:    sortBy,
+		SortOrder: sortOrder,
+	}, nil
+}
+
+// nextPageToken returns the token of the page after the one described by opts, or an empty string if it was the last page.
+func nextPageToken(opts domain.ListOptions, count int, total int) string {
+	next := opts.Offset + count
+	if count == 0 || next >= total {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(next)))
+}
+
+// decodePageToken returns the offset encoded in a page token. An empty token is the first page.
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, domain.InvalidPageTokenError
+	}
+
+	offset, err := strconv.Atoi(string(decoded))
+	if err != nil || offset < 0 {
+		return 0, domain.InvalidPageTokenError
+	}
+
+	return offset, nil
+}
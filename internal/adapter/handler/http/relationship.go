@@ -0,0 +1,233 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+// RelationshipHandler provides HTTP handler methods for managing applicant relationships using a RelationshipService.
+type RelationshipHandler struct {
+	s port.RelationshipService
+}
+
+// NewRelationshipHandler initializes a new RelationshipHandler with the provided RelationshipService.
+func NewRelationshipHandler(s port.RelationshipService) *RelationshipHandler {
+	return &RelationshipHandler{s: s}
+}
+
+// ListApplicantRelationships godoc
+// @Summary	  List Applicant Relationships
+// @Description  Retrieves all family members linked to the specified applicant.
+// @Tags		 Relationships
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		id   path	  string  true  "Applicant ID"
+// @Success	  200  {object}  RelationshipsResponse  "Successfully retrieved relationships."
+// @Failure	  400  {object}  ErrorResponse		  "Bad Request"
+// @Failure	  404  {object}  ErrorResponse		  "Applicant Not Found"
+// @Failure	  500  {object}  ErrorResponse		  "Internal Server Error"
+// @Router	   /applicants/{id}/relationships [get]
+func (h *RelationshipHandler) ListApplicantRelationships(ctx *gin.Context) {
+	var req ApplicantRequestUri
+
+	err := ctx.ShouldBindUri(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	applicantID, err := uuid.Parse(req.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidApplicantError)
+		return
+	}
+
+	relationships, err := h.s.ListApplicantRelationships(ctx, applicantID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRelationshipsResponse(relationships)
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved relationships.", rsp)
+}
+
+// CreateRelationship godoc
+// @Summary	  Create a Relationship
+// @Description  Links a family member to the specified applicant. The reverse relationship is created automatically.
+// @Tags		 Relationships
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		id						 path	  string					 true  "Applicant ID"
+// @Param		CreateRelationshipRequest  body	  CreateRelationshipRequest  true  "Payload for creating a relationship"
+// @Success	  201  {object}  RelationshipResponse  "Successfully created relationship."
+// @Failure	  400  {object}  ErrorResponse		 "Bad Request"
+// @Failure	  404  {object}  ErrorResponse		 "Applicant Not Found"
+// @Failure	  409  {object}  ErrorResponse		 "Relationship Already Exists"
+// @Failure	  500  {object}  ErrorResponse		 "Internal Server Error"
+// @Router	   /applicants/{id}/relationships [post]
+func (h *RelationshipHandler) CreateRelationship(ctx *gin.Context) {
+	var reqUri ApplicantRequestUri
+	var req CreateRelationshipRequest
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	applicantID, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidApplicantError)
+		return
+	}
+
+	familyMemberID, err := uuid.Parse(req.FamilyMemberID)
+	if err != nil {
+		handleError(ctx, domain.InvalidApplicantError)
+		return
+	}
+
+	relationship := domain.Relationship{
+		ApplicantAID:     &applicantID,
+		ApplicantBID:     &familyMemberID,
+		RelationshipType: &req.RelationshipType,
+	}
+
+	newRelationship, err := h.s.CreateRelationship(ctx, &relationship)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRelationshipResponse(*newRelationship)
+	handleSuccess(ctx, http.StatusCreated, "Successfully created relationship.", rsp)
+}
+
+// UpdateRelationship godoc
+// @Summary	  Update a Relationship
+// @Description  Changes the type of an existing relationship. The reverse relationship is updated automatically.
+// @Tags		 Relationships
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		id						 path	  string					 true  "Applicant ID"
+// @Param		relationship_id			path	  string					 true  "Relationship ID"
+// @Param		UpdateRelationshipRequest  body	  UpdateRelationshipRequest  true  "Payload for updating a relationship"
+// @Success	  200  {object}  RelationshipResponse  "Successfully updated relationship."
+// @Failure	  400  {object}  ErrorResponse		 "Bad Request"
+// @Failure	  404  {object}  ErrorResponse		 "Relationship Not Found"
+// @Failure	  500  {object}  ErrorResponse		 "Internal Server Error"
+// @Router	   /applicants/{id}/relationships/{relationship_id} [put]
+func (h *RelationshipHandler) UpdateRelationship(ctx *gin.Context) {
+	var reqUri RelationshipRequestUri
+	var req UpdateRelationshipRequest
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	existingRelationship, ok := h.getApplicantRelationship(ctx, reqUri)
+	if !ok {
+		return
+	}
+
+	newRelationshipValues := domain.Relationship{
+		ID:               existingRelationship.ID,
+		RelationshipType: &req.RelationshipType,
+	}
+
+	updatedRelationship, err := h.s.UpdateRelationship(ctx, &newRelationshipValues)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRelationshipResponse(*updatedRelationship)
+	handleSuccess(ctx, http.StatusOK, "Successfully updated relationship.", rsp)
+}
+
+// DeleteRelationship godoc
+// @Summary	  Delete a Relationship
+// @Description  Removes a relationship and its reverse relationship.
+// @Tags		 Relationships
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		id			   path	  string  true  "Applicant ID"
+// @Param		relationship_id  path	  string  true  "Relationship ID"
+// @Success	  200  {object}  Response	   "Successfully deleted relationship."
+// @Failure	  400  {object}  ErrorResponse  "Bad Request"
+// @Failure	  404  {object}  ErrorResponse  "Relationship Not Found"
+// @Failure	  500  {object}  ErrorResponse  "Internal Server Error"
+// @Router	   /applicants/{id}/relationships/{relationship_id} [delete]
+func (h *RelationshipHandler) DeleteRelationship(ctx *gin.Context) {
+	var reqUri RelationshipRequestUri
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	existingRelationship, ok := h.getApplicantRelationship(ctx, reqUri)
+	if !ok {
+		return
+	}
+
+	err = h.s.DeleteRelationship(ctx, *existingRelationship.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, http.StatusOK, "Successfully deleted relationship.", nil)
+}
+
+// getApplicantRelationship retrieves the relationship in the request URI and ensures it belongs to the applicant in the URI.
+// An error response is sent and false is returned if the relationship cannot be found.
+func (h *RelationshipHandler) getApplicantRelationship(ctx *gin.Context, reqUri RelationshipRequestUri) (*domain.Relationship, bool) {
+	applicantID, err := uuid.Parse(reqUri.ApplicantID)
+	if err != nil {
+		handleError(ctx, domain.InvalidApplicantError)
+		return nil, false
+	}
+
+	id, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidRelationshipError)
+		return nil, false
+	}
+
+	relationship, err := h.s.GetRelationshipByID(ctx, id)
+	if err != nil {
+		handleError(ctx, err)
+		return nil, false
+	}
+
+	if *relationship.ApplicantAID != applicantID {
+		handleError(ctx, domain.RelationshipNotFoundError)
+		return nil, false
+	}
+
+	return relationship, true
+}
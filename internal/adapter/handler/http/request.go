@@ -1,6 +1,9 @@
 package http
 
-import "github.com/cxnub/fas-mgmt-system/internal/core/domain"
+import (
+	"encoding/json"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+)
 
 // ===========================================
 // ============ Applicant Routes =============
@@ -12,13 +15,29 @@ type ApplicantRequestUri struct {
 }
 
 // CreateApplicantRequest represents the required information to create a new applicant in the system.
-// The struct requires fields for name, employment status, sex, date of birth, and marital status with validation constraints.
+// The struct requires fields for name, employment status, sex, date of birth, marital status and monthly income with
+// validation constraints. Assets and disability are optional.
 type CreateApplicantRequest struct {
 	Name             string                  `json:"name" binding:"required" example:"John Doe"`
 	EmploymentStatus domain.EmploymentStatus `json:"employment_status" binding:"required,employment_status" example:"employed"`
 	Sex              domain.Sex              `json:"sex" binding:"required,sex" example:"male"`
 	DateOfBirth      string                  `json:"date_of_birth" binding:"required,date" example:"1990-01-01"`
 	MaritalStatus    domain.MaritalStatus    `json:"marital_status" binding:"required,marital_status" example:"married"`
+	MonthlyIncome    *json.Number            `json:"monthly_income" binding:"required" swaggertype:"string" example:"2500.00"`
+	Assets           *json.Number            `json:"assets" swaggertype:"string" example:"10000.00"`
+	HasDisability    *bool                   `json:"has_disability" example:"false"`
+}
+
+// ListApplicantsRequest represents the query string parameters for paging, sorting and filtering applicants.
+type ListApplicantsRequest struct {
+	PageSize         int                      `form:"page_size" json:"page_size" binding:"omitempty,min=1,max=100" example:"20"`
+	PageToken        string                   `form:"page_token" json:"page_token" example:"MjA"`
+	SortBy           string                   `form:"sort_by" json:"sort_by" binding:"omitempty,oneof=name date_of_birth created_at" example:"name"`
+	SortOrder        domain.SortOrder         `form:"sort_order" json:"sort_order" binding:"omitempty,oneof=asc desc" example:"asc"`
+	EmploymentStatus *domain.EmploymentStatus `form:"employment_status" json:"employment_status" binding:"omitempty,employment_status" example:"unemployed"`
+	MaritalStatus    *domain.MaritalStatus    `form:"marital_status" json:"marital_status" binding:"omitempty,marital_status" example:"single"`
+	MinAge           *int                     `form:"min_age" json:"min_age" binding:"omitempty,min=0" example:"18"`
+	MaxAge           *int                     `form:"max_age" json:"max_age" binding:"omitempty,min=0" example:"65"`
 }
 
 // UpdateApplicantRequest represents a request payload to update an applicant's details.
@@ -28,6 +47,31 @@ type UpdateApplicantRequest struct {
 	Sex              *domain.Sex              `json:"sex" binding:"omitempty,sex" example:"male"`
 	DateOfBirth      *string                  `json:"date_of_birth" binding:"omitempty,date" example:"1990-01-01"`
 	MaritalStatus    *domain.MaritalStatus    `json:"marital_status" binding:"omitempty,marital_status" example:"married"`
+	MonthlyIncome    *json.Number             `json:"monthly_income" swaggertype:"string" example:"2500.00"`
+	Assets           *json.Number             `json:"assets" swaggertype:"string" example:"10000.00"`
+	HasDisability    *bool                    `json:"has_disability" example:"false"`
+}
+
+// ===========================================
+// =========== Relationship Routes ===========
+// ===========================================
+
+// RelationshipRequestUri represents URI parameters for a relationship request of a specific applicant.
+type RelationshipRequestUri struct {
+	ApplicantID string `uri:"id" binding:"required,uuid" example:"b6c29c96-024b-4e70-834b-8e0dd2c66645"`
+	ID          string `uri:"relationship_id" binding:"required,uuid" example:"a12cf984-78f3-4eef-bb3c-66dae34a2fa1"`
+}
+
+// CreateRelationshipRequest represents a request payload to link a family member to an applicant.
+// The relationship type describes the family member relative to the applicant (e.g. parent means the family member is the applicant's parent).
+type CreateRelationshipRequest struct {
+	FamilyMemberID   string                  `json:"family_member_id" binding:"required,uuid" example:"c85062f2-e306-4ecd-b586-a3dcbb03deaf"`
+	RelationshipType domain.RelationshipType `json:"relationship_type" binding:"required,relationship_type" example:"parent"`
+}
+
+// UpdateRelationshipRequest represents a request payload to change the type of an existing relationship.
+type UpdateRelationshipRequest struct {
+	RelationshipType domain.RelationshipType `json:"relationship_type" binding:"required,relationship_type" example:"sibling"`
 }
 
 // ===========================================
@@ -39,12 +83,32 @@ type ApplicationRequestUri struct {
 	ID string `uri:"id" binding:"required,uuid" example:"fe897b4f-568b-4ea1-8d95-99a91c97faf2"`
 }
 
+// DisbursementRequestUri represents URI parameters for a disbursement of a specific application.
+type DisbursementRequestUri struct {
+	ApplicationID string `uri:"id" binding:"required,uuid" example:"fe897b4f-568b-4ea1-8d95-99a91c97faf2"`
+	ID            string `uri:"disbursement_id" binding:"required,uuid" example:"3c7e1f0a-9d2b-4c8e-a6f5-1b2d3e4f5a6b"`
+}
+
 // CreateApplicationRequest represents a request for creating a new application with mandatory applicant and scheme identifiers.
 type CreateApplicationRequest struct {
 	ApplicantID string `json:"applicant_id" binding:"required"`
 	SchemeID    string `json:"scheme_id" binding:"required"`
 }
 
+// ListApplicationsRequest represents the query string parameters for paging, sorting and filtering applications.
+// The created date range is inclusive of both dates.
+type ListApplicationsRequest struct {
+	PageSize    int                       `form:"page_size" json:"page_size" binding:"omitempty,min=1,max=100" example:"20"`
+	PageToken   string                    `form:"page_token" json:"page_token" example:"MjA"`
+	SortBy      string                    `form:"sort_by" json:"sort_by" binding:"omitempty,oneof=created_at updated_at status" example:"created_at"`
+	SortOrder   domain.SortOrder          `form:"sort_order" json:"sort_order" binding:"omitempty,oneof=asc desc" example:"desc"`
+	ApplicantID string                    `form:"applicant_id" json:"applicant_id" binding:"omitempty,uuid" example:"c85062f2-e306-4ecd-b586-a3dcbb03deaf"`
+	SchemeID    string                    `form:"scheme_id" json:"scheme_id" binding:"omitempty,uuid" example:"812b056b-8d12-472f-ac9b-419715a55b94"`
+	Status      *domain.ApplicationStatus `form:"status" json:"status" binding:"omitempty,application_status" example:"submitted"`
+	CreatedFrom string                    `form:"created_from" json:"created_from" binding:"omitempty,date" example:"2025-01-01"`
+	CreatedTo   string                    `form:"created_to" json:"created_to" binding:"omitempty,date" example:"2025-12-31"`
+}
+
 // UpdateApplicationRequest represents a request to update an application by its ID, ApplicantID, or SchemeID.
 type UpdateApplicationRequest struct {
 	ApplicantID *string `json:"applicant_id"`
@@ -65,19 +129,73 @@ type SchemeCriteriaRequestUri struct {
 	ID string `uri:"scheme_criteria_id" binding:"required,uuid"`
 }
 
+// SchemeCriteriaGroupRequestUri represents the request URI structure for a scheme criteria group, containing a mandatory UUID identifier.
+type SchemeCriteriaGroupRequestUri struct {
+	ID string `uri:"criteria_group_id" binding:"required,uuid"`
+}
+
 // BenefitRequestUri represents the URI structure for identifying a specific benefit request by its unique ID.
 type BenefitRequestUri struct {
 	ID string `uri:"benefit_id" binding:"required,uuid"`
 }
 
-// CreateSchemeRequest represents a request payload for creating a new scheme with a mandatory name field.
+// BenefitCriteriaRequestUri represents the URI structure for identifying a specific criteria of a benefit.
+type BenefitCriteriaRequestUri struct {
+	BenefitID string `uri:"benefit_id" binding:"required,uuid"`
+	ID        string `uri:"benefit_criteria_id" binding:"required,uuid"`
+}
+
+// ListSchemesRequest represents the query string parameters for paging, sorting and filtering schemes.
+type ListSchemesRequest struct {
+	PageSize  int              `form:"page_size" json:"page_size" binding:"omitempty,min=1,max=100" example:"20"`
+	PageToken string           `form:"page_token" json:"page_token" example:"MjA"`
+	SortBy    string           `form:"sort_by" json:"sort_by" binding:"omitempty,oneof=name created_at" example:"name"`
+	SortOrder domain.SortOrder `form:"sort_order" json:"sort_order" binding:"omitempty,oneof=asc desc" example:"asc"`
+	Name      *string          `form:"name" json:"name" example:"retrenchment"`
+}
+
+// CreateSchemeRequest represents a request payload for creating a new scheme with a mandatory name field,
+// optionally together with its benefits, criteria and criteria groups.
 type CreateSchemeRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name                string                          `json:"name" binding:"required"`
+	ReapplyCooldownDays *int                            `json:"reapply_cooldown_days" binding:"omitempty,min=0" example:"30"`
+	Benefits            []SchemeBenefitRequest          `json:"benefits" binding:"dive"`
+	Criteria            []AddSchemeCriteriaRequest      `json:"criteria" binding:"dive"`
+	CriteriaGroups      []AddSchemeCriteriaGroupRequest `json:"criteria_groups" binding:"dive"`
 }
 
 // UpdateSchemeRequest represents a request payload for updating an existing scheme.
+// Benefits, criteria and criteria groups replace those of the scheme if they are given.
 type UpdateSchemeRequest struct {
-	Name *string `json:"name"`
+	Name                *string                         `json:"name"`
+	ReapplyCooldownDays *int                            `json:"reapply_cooldown_days" binding:"omitempty,min=0" example:"30"`
+	Benefits            []UpdateSchemeBenefitsRequest   `json:"benefits" binding:"dive"`
+	Criteria            []AddSchemeCriteriaRequest      `json:"criteria" binding:"dive"`
+	CriteriaGroups      []AddSchemeCriteriaGroupRequest `json:"criteria_groups" binding:"dive"`
+}
+
+// SchemeBenefitRequest represents a benefit, together with its criteria, given when creating a scheme.
+type SchemeBenefitRequest struct {
+	Name           string                      `json:"name" binding:"required" example:"CDC Vouchers"`
+	Amount         json.Number                 `json:"amount" binding:"required" swaggertype:"string" example:"100.00"`
+	Currency       *domain.Currency            `json:"currency" binding:"omitempty,currency" example:"SGD"`
+	Frequency      *domain.BenefitFrequency    `json:"frequency" binding:"omitempty,benefit_frequency" example:"monthly"`
+	DurationMonths *int                        `json:"duration_months" binding:"omitempty,min=1" example:"12"`
+	Cap            *json.Number                `json:"cap" swaggertype:"string" example:"1000.00"`
+	Criteria       []AddBenefitCriteriaRequest `json:"criteria" binding:"dive"`
+}
+
+// UpdateSchemeBenefitsRequest represents a benefit given when updating a scheme. Benefits with an ID update an existing
+// benefit of the scheme and benefits without one are added. The criteria of the benefit are replaced if they are given.
+type UpdateSchemeBenefitsRequest struct {
+	ID             *string                     `json:"id" binding:"omitempty,uuid" example:"8f1d4c2a-6b3e-4a9f-9c1e-2d3b4a5c6d7e"`
+	Name           string                      `json:"name" binding:"required" example:"CDC Vouchers"`
+	Amount         json.Number                 `json:"amount" binding:"required" swaggertype:"string" example:"100.00"`
+	Currency       *domain.Currency            `json:"currency" binding:"omitempty,currency" example:"SGD"`
+	Frequency      *domain.BenefitFrequency    `json:"frequency" binding:"omitempty,benefit_frequency" example:"monthly"`
+	DurationMonths *int                        `json:"duration_months" binding:"omitempty,min=1" example:"12"`
+	Cap            *json.Number                `json:"cap" swaggertype:"string" example:"1000.00"`
+	Criteria       []AddBenefitCriteriaRequest `json:"criteria" binding:"dive"`
 }
 
 // DeleteSchemeRequest represents a request to delete a scheme.
@@ -85,16 +203,27 @@ type DeleteSchemeRequest struct {
 }
 
 // AddSchemeBenefitRequest represents a request payload for adding a benefit to a scheme with required details.
+// Benefits are paid once unless a monthly or quarterly frequency and a duration are given, and the cap limits the total paid.
+// Amounts are decimal strings (numbers are also accepted) in the currency of the benefit, which is SGD if none is given.
 type AddSchemeBenefitRequest struct {
-	Name   string  `json:"name" binding:"required" example:"CDC Vouchers"`
-	Amount float64 `json:"amount" binding:"required" example:"100"`
+	Name           string                   `json:"name" binding:"required" example:"CDC Vouchers"`
+	Amount         json.Number              `json:"amount" binding:"required" swaggertype:"string" example:"100.00"`
+	Currency       *domain.Currency         `json:"currency" binding:"omitempty,currency" example:"SGD"`
+	Frequency      *domain.BenefitFrequency `json:"frequency" binding:"omitempty,benefit_frequency" example:"monthly"`
+	DurationMonths *int                     `json:"duration_months" binding:"omitempty,min=1" example:"12"`
+	Cap            *json.Number             `json:"cap" swaggertype:"string" example:"1000.00"`
 }
 
 // UpdateSchemeBenefitRequest represents a request structure for updating a scheme benefit.
+// The currency of a benefit can only be changed together with its amount.
 type UpdateSchemeBenefitRequest struct {
-	Name     *string  `json:"name"`
-	Amount   *float64 `json:"amount"`
-	SchemeID *string  `json:"scheme_id"`
+	Name           *string                  `json:"name"`
+	Amount         *json.Number             `json:"amount" binding:"required_with=Currency" swaggertype:"string" example:"100.00"`
+	Currency       *domain.Currency         `json:"currency" binding:"omitempty,currency" example:"SGD"`
+	Frequency      *domain.BenefitFrequency `json:"frequency" binding:"omitempty,benefit_frequency" example:"monthly"`
+	DurationMonths *int                     `json:"duration_months" binding:"omitempty,min=1" example:"12"`
+	Cap            *json.Number             `json:"cap" swaggertype:"string" example:"1000.00"`
+	SchemeID       *string                  `json:"scheme_id"`
 }
 
 // AddSchemeCriteriaRequest represents the request to add a new criteria to an existing scheme.
@@ -103,9 +232,91 @@ type AddSchemeCriteriaRequest struct {
 	Value string `json:"value" binding:"required" example:"18-50"`
 }
 
+// AddSchemeCriteriaGroupRequest represents the request to add a criteria group to an existing scheme.
+// Groups can be nested to build expressions such as "age >= 65 OR (unemployed AND has_children)".
+type AddSchemeCriteriaGroupRequest struct {
+	Operator string                          `json:"operator" binding:"required" example:"or" enums:"and,or,not"`
+	Criteria []AddSchemeCriteriaRequest      `json:"criteria" binding:"dive"`
+	Groups   []AddSchemeCriteriaGroupRequest `json:"groups" binding:"dive"`
+}
+
 // UpdateSchemeCriteriaRequest represents the payload for updating a scheme criteria.
 type UpdateSchemeCriteriaRequest struct {
 	Name     *string `json:"name"`
 	Value    *string `json:"value"`
 	SchemeID *string `json:"scheme_id"`
 }
+
+// AddBenefitCriteriaRequest represents the request to add a new criteria to an existing benefit.
+type AddBenefitCriteriaRequest struct {
+	Name  string `json:"name" binding:"required" example:"has_primary_school_children"`
+	Value string `json:"value" binding:"required" example:"true"`
+}
+
+// UpdateBenefitCriteriaRequest represents the payload for updating a benefit criteria.
+type UpdateBenefitCriteriaRequest struct {
+	Name  *string `json:"name"`
+	Value *string `json:"value"`
+}
+
+// SchemeVersionRequestUri represents the URI structure for identifying a specific version of a scheme.
+type SchemeVersionRequestUri struct {
+	ID string `uri:"version_id" binding:"required,uuid"`
+}
+
+// CreateSchemeVersionRequest represents the request to create a draft version of a scheme. The version is in effect
+// indefinitely if effective_to is not given.
+type CreateSchemeVersionRequest struct {
+	EffectiveFrom string  `json:"effective_from" binding:"required,date" example:"2025-01-01"`
+	EffectiveTo   *string `json:"effective_to" binding:"omitempty,date" example:"2026-01-01"`
+}
+
+// ===========================================
+// ============== Auth Routes ================
+// ===========================================
+
+// LoginRequest represents the credentials a user signs in with.
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email" example:"admin@example.com"`
+	Password string `json:"password" binding:"required" example:"password123"`
+}
+
+// ===========================================
+// ============== User Routes ================
+// ===========================================
+
+// UserRequestUri represents URI parameters for a user request containing a required ID as a UUID.
+type UserRequestUri struct {
+	ID string `uri:"id" binding:"required,uuid" example:"5b1c3a8e-4f0d-4b8a-9e67-2d7f1c9a3e10"`
+}
+
+// CreateUserRequest represents a request payload to create a new user with a role.
+type CreateUserRequest struct {
+	Email    string      `json:"email" binding:"required,email" example:"caseworker@example.com"`
+	Name     string      `json:"name" binding:"required" example:"Jane Tan"`
+	Password string      `json:"password" binding:"required,min=8" example:"password123"`
+	Role     domain.Role `json:"role" binding:"required,role" example:"caseworker"`
+}
+
+// UpdateUserRequest represents a request payload to update a user's details, role or password.
+type UpdateUserRequest struct {
+	Email    *string      `json:"email" binding:"omitempty,email" example:"caseworker@example.com"`
+	Name     *string      `json:"name" binding:"omitempty" example:"Jane Tan"`
+	Password *string      `json:"password" binding:"omitempty,min=8" example:"password123"`
+	Role     *domain.Role `json:"role" binding:"omitempty,role" example:"scheme_admin"`
+}
+
+// ===========================================
+// ============== Audit Routes ===============
+// ===========================================
+
+// ListAuditLogsRequest represents the query string parameters for paging and filtering audit log entries.
+type ListAuditLogsRequest struct {
+	PageSize   int                     `form:"page_size" json:"page_size" binding:"omitempty,min=1,max=100" example:"20"`
+	PageToken  string                  `form:"page_token" json:"page_token" example:"MjA"`
+	SortOrder  domain.SortOrder        `form:"sort_order" json:"sort_order" binding:"omitempty,oneof=asc desc" example:"desc"`
+	EntityType *domain.AuditEntityType `form:"entity_type" json:"entity_type" binding:"omitempty,audit_entity_type" example:"application"`
+	EntityID   string                  `form:"entity_id" json:"entity_id" binding:"omitempty,uuid" example:"fe897b4f-568b-4ea1-8d95-99a91c97faf2"`
+	ActorID    string                  `form:"actor_id" json:"actor_id" binding:"omitempty,uuid" example:"5b1c3a8e-4f0d-4b8a-9e67-2d7f1c9a3e10"`
+	Action     *domain.AuditAction     `form:"action" json:"action" binding:"omitempty,audit_action" example:"update"`
+}
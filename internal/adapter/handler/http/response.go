@@ -2,6 +2,7 @@ package http
 
 import (
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/util"
 )
 
 // Response represents a response body format
@@ -22,14 +23,17 @@ func newResponse(success bool, message string, data any) Response {
 
 // ApplicantResponse represents the response containing applicant's personal and status information.
 type ApplicantResponse struct {
-	ID               string `json:"id" example:"00000000-0000-0000-0000-000000000000"`
-	Name             string `json:"name" example:"John Doe"`
-	EmploymentStatus string `json:"employment_status" example:"employed"`
-	MaritalStatus    string `json:"marital_status" example:"married"`
-	Sex              string `json:"sex" example:"male"`
-	DateOfBirth      string `json:"date_of_birth" example:"2000-01-01"`
-	CreatedAt        string `json:"created_at" example:"2021-01-01T00:00:00Z"`
-	UpdatedAt        string `json:"updated_at" example:"2021-01-01T00:00:00Z"`
+	ID               string  `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Name             string  `json:"name" example:"John Doe"`
+	EmploymentStatus string  `json:"employment_status" example:"employed"`
+	MaritalStatus    string  `json:"marital_status" example:"married"`
+	Sex              string  `json:"sex" example:"male"`
+	DateOfBirth      string  `json:"date_of_birth" example:"2000-01-01"`
+	MonthlyIncome    *string `json:"monthly_income" example:"2500.00"`
+	Assets           *string `json:"assets" example:"10000.00"`
+	HasDisability    bool    `json:"has_disability" example:"false"`
+	CreatedAt        string  `json:"created_at" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt        string  `json:"updated_at" example:"2021-01-01T00:00:00Z"`
 }
 
 func newApplicantResponse(applicant domain.Applicant) ApplicantResponse {
@@ -40,6 +44,9 @@ func newApplicantResponse(applicant domain.Applicant) ApplicantResponse {
 		MaritalStatus:    string(*applicant.MaritalStatus),
 		Sex:              string(*applicant.Sex),
 		DateOfBirth:      applicant.DateOfBirth.String(),
+		MonthlyIncome:    moneyString(applicant.MonthlyIncome),
+		Assets:           moneyString(applicant.Assets),
+		HasDisability:    applicant.HasDisability != nil && *applicant.HasDisability,
 		CreatedAt:        applicant.CreatedAt.String(),
 		UpdatedAt:        applicant.UpdatedAt.String(),
 	}
@@ -47,35 +54,108 @@ func newApplicantResponse(applicant domain.Applicant) ApplicantResponse {
 
 // ApplicantsResponse represents a collection of applicant responses.
 type ApplicantsResponse struct {
-	Applicants []ApplicantResponse `json:"applicants" example:"applicants"`
+	Applicants    []ApplicantResponse `json:"applicants"`
+	Total         int                 `json:"total" example:"42"`
+	NextPageToken string              `json:"next_page_token,omitempty" example:"MjA"`
 }
 
-func newApplicantsResponse(applicants []domain.Applicant) ApplicantsResponse {
-	var applicantResponses []ApplicantResponse
+func newApplicantsResponse(applicants []domain.Applicant, total int, nextPageToken string) ApplicantsResponse {
+	applicantResponses := make([]ApplicantResponse, 0, len(applicants))
 	for _, a := range applicants {
 		applicantResponses = append(applicantResponses, newApplicantResponse(a))
 	}
 	return ApplicantsResponse{
-		Applicants: applicantResponses,
+		Applicants:    applicantResponses,
+		Total:         total,
+		NextPageToken: nextPageToken,
+	}
+}
+
+// RelationshipResponse represents a relationship between an applicant and one of their family members.
+type RelationshipResponse struct {
+	ID               string `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	ApplicantID      string `json:"applicant_id" example:"00000000-0000-0000-0000-000000000000"`
+	FamilyMemberID   string `json:"family_member_id" example:"00000000-0000-0000-0000-000000000000"`
+	RelationshipType string `json:"relationship_type" example:"parent"`
+	CreatedAt        string `json:"created_at" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt        string `json:"updated_at" example:"2021-01-01T00:00:00Z"`
+}
+
+func newRelationshipResponse(relationship domain.Relationship) RelationshipResponse {
+	return RelationshipResponse{
+		ID:               relationship.ID.String(),
+		ApplicantID:      relationship.ApplicantAID.String(),
+		FamilyMemberID:   relationship.ApplicantBID.String(),
+		RelationshipType: string(*relationship.RelationshipType),
+		CreatedAt:        relationship.CreatedAt.String(),
+		UpdatedAt:        relationship.UpdatedAt.String(),
+	}
+}
+
+// RelationshipsResponse represents a collection of relationship responses.
+type RelationshipsResponse struct {
+	Relationships []RelationshipResponse `json:"relationships"`
+}
+
+func newRelationshipsResponse(relationships []domain.Relationship) RelationshipsResponse {
+	var relationshipResponses []RelationshipResponse
+	for _, r := range relationships {
+		relationshipResponses = append(relationshipResponses, newRelationshipResponse(r))
+	}
+	return RelationshipsResponse{
+		Relationships: relationshipResponses,
 	}
 }
 
 // SchemeBenefitListResponse represents a response structure containing benefit details like name and amount for a scheme.
 type SchemeBenefitListResponse struct {
-	ID     string  `json:"id" example:"00000000-0000-0000-0000-000000000000"`
-	Name   string  `json:"name" example:"CDC Vouchers"`
-	Amount float64 `json:"amount" example:"1000000"`
+	ID             string                        `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Name           string                        `json:"name" example:"CDC Vouchers"`
+	Amount         string                        `json:"amount" example:"100.00"`
+	Currency       string                        `json:"currency" example:"SGD"`
+	Frequency      string                        `json:"frequency" example:"monthly"`
+	DurationMonths *int                          `json:"duration_months" example:"12"`
+	Cap            *string                       `json:"cap" example:"1000.00"`
+	Criteria       []BenefitCriteriaListResponse `json:"criteria"`
+}
+
+// moneyString formats an amount of money, or returns nil if there is none.
+func moneyString(m *domain.Money) *string {
+	if m == nil {
+		return nil
+	}
+	s := m.String()
+	return &s
+}
+
+// benefitFrequency returns the frequency of a benefit, which is one-off if it has none.
+func benefitFrequency(benefit domain.Benefit) string {
+	if benefit.Frequency == nil {
+		return string(domain.BenefitFrequencyOneOff)
+	}
+	return string(*benefit.Frequency)
 }
 
 func newSchemeBenefitListResponse(benefit []domain.Benefit) []SchemeBenefitListResponse {
 	var schemeBenefitListResponses []SchemeBenefitListResponse
 
 	for _, b := range benefit {
-		schemeBenefitListResponses = append(schemeBenefitListResponses, SchemeBenefitListResponse{
-			ID:     b.ID.String(),
-			Name:   *b.Name,
-			Amount: *b.Amount,
-		})
+		response := SchemeBenefitListResponse{
+			ID:             b.ID.String(),
+			Name:           *b.Name,
+			Amount:         b.Amount.String(),
+			Currency:       string(b.Amount.Currency),
+			Frequency:      benefitFrequency(b),
+			DurationMonths: b.DurationMonths,
+			Cap:            moneyString(b.Cap),
+		}
+
+		// Check if criteria is not empty
+		if b.Criteria != nil {
+			response.Criteria = newBenefitCriteriaListResponse(*b.Criteria)
+		}
+
+		schemeBenefitListResponses = append(schemeBenefitListResponses, response)
 	}
 
 	return schemeBenefitListResponses
@@ -83,22 +163,83 @@ func newSchemeBenefitListResponse(benefit []domain.Benefit) []SchemeBenefitListR
 
 // SchemeBenefitResponse represents a response structure encapsulating scheme benefit details with associated metadata.
 type SchemeBenefitResponse struct {
-	ID        string  `json:"id" example:"00000000-0000-0000-0000-000000000000"`
-	SchemeID  string  `json:"scheme_id" example:"00000000-0000-0000-0000-000000000000"`
-	Name      string  `json:"name" example:"CDC Vouchers"`
-	Amount    float64 `json:"amount" example:"1000000"`
-	CreatedAt string  `json:"created_at" example:"2021-01-01T00:00:00Z"`
-	UpdatedAt string  `json:"updated_at" example:"2021-01-01T00:00:00Z"`
+	ID             string  `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	SchemeID       string  `json:"scheme_id" example:"00000000-0000-0000-0000-000000000000"`
+	Name           string  `json:"name" example:"CDC Vouchers"`
+	Amount         string  `json:"amount" example:"100.00"`
+	Currency       string  `json:"currency" example:"SGD"`
+	Frequency      string  `json:"frequency" example:"monthly"`
+	DurationMonths *int    `json:"duration_months" example:"12"`
+	Cap            *string `json:"cap" example:"1000.00"`
+	CreatedAt      string  `json:"created_at" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt      string  `json:"updated_at" example:"2021-01-01T00:00:00Z"`
 }
 
 func newSchemebenefitResponse(benefit domain.Benefit) SchemeBenefitResponse {
 	return SchemeBenefitResponse{
-		Name:      *benefit.Name,
-		Amount:    *benefit.Amount,
-		ID:        benefit.ID.String(),
-		SchemeID:  benefit.SchemeID.String(),
-		CreatedAt: benefit.CreatedAt.String(),
-		UpdatedAt: benefit.UpdatedAt.String(),
+		Name:           *benefit.Name,
+		Amount:         benefit.Amount.String(),
+		Currency:       string(benefit.Amount.Currency),
+		Frequency:      benefitFrequency(benefit),
+		DurationMonths: benefit.DurationMonths,
+		Cap:            moneyString(benefit.Cap),
+		ID:             benefit.ID.String(),
+		SchemeID:       benefit.SchemeID.String(),
+		CreatedAt:      benefit.CreatedAt.String(),
+		UpdatedAt:      benefit.UpdatedAt.String(),
+	}
+}
+
+// BenefitCriteriaListResponse represents a response containing a criterion's name and value associated with a benefit.
+type BenefitCriteriaListResponse struct {
+	ID    string `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Name  string `json:"name" example:"has_primary_school_children"`
+	Value string `json:"value" example:"true"`
+}
+
+func newBenefitCriteriaListResponse(criteria []domain.BenefitCriteria) []BenefitCriteriaListResponse {
+	var benefitCriteriaListResponses []BenefitCriteriaListResponse
+
+	for _, bc := range criteria {
+		benefitCriteriaListResponses = append(benefitCriteriaListResponses, BenefitCriteriaListResponse{
+			ID:    bc.ID.String(),
+			Name:  *bc.Name,
+			Value: *bc.Value,
+		})
+	}
+
+	return benefitCriteriaListResponses
+}
+
+// BenefitCriteriaResponse represents the response structure for a single criteria of a benefit.
+type BenefitCriteriaResponse struct {
+	ID        string `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	BenefitID string `json:"benefit_id" example:"00000000-0000-0000-0000-000000000000"`
+	Name      string `json:"name" example:"has_primary_school_children"`
+	Value     string `json:"value" example:"true"`
+	CreatedAt string `json:"created_at" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt string `json:"updated_at" example:"2021-01-01T00:00:00Z"`
+}
+
+func newBenefitCriteriaResponse(criteria domain.BenefitCriteria) BenefitCriteriaResponse {
+	return BenefitCriteriaResponse{
+		Name:      *criteria.Name,
+		Value:     *criteria.Value,
+		ID:        criteria.ID.String(),
+		BenefitID: criteria.BenefitID.String(),
+		CreatedAt: criteria.CreatedAt.String(),
+		UpdatedAt: criteria.UpdatedAt.String(),
+	}
+}
+
+// BenefitCriteriaListResponses represents the response structure containing all criteria of a benefit.
+type BenefitCriteriaListResponses struct {
+	Criteria []BenefitCriteriaListResponse `json:"criteria"`
+}
+
+func newBenefitCriteriaListResponses(criteria []domain.BenefitCriteria) BenefitCriteriaListResponses {
+	return BenefitCriteriaListResponses{
+		Criteria: newBenefitCriteriaListResponse(criteria),
 	}
 }
 
@@ -144,12 +285,55 @@ func newSchemeCriteriaResponse(criteria domain.SchemeCriteria) SchemeCriteriaRes
 	}
 }
 
+// SchemeCriteriaGroupResponse represents a criteria group of a scheme together with its criteria and nested groups.
+type SchemeCriteriaGroupResponse struct {
+	ID       string                        `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Operator string                        `json:"operator" example:"or"`
+	Criteria []SchemeCriteriaListResponse  `json:"criteria"`
+	Groups   []SchemeCriteriaGroupResponse `json:"groups"`
+}
+
+func newSchemeCriteriaGroupResponse(group domain.SchemeCriteriaGroup) SchemeCriteriaGroupResponse {
+	response := SchemeCriteriaGroupResponse{
+		ID:       group.ID.String(),
+		Operator: string(*group.Operator),
+	}
+
+	// Check if criteria is not empty
+	if group.Criteria != nil {
+		response.Criteria = newSchemeCriteriaListResponse(*group.Criteria)
+	}
+
+	// Check if nested groups is not empty
+	if group.Groups != nil {
+		response.Groups = newSchemeCriteriaGroupListResponse(*group.Groups)
+	}
+
+	return response
+}
+
+func newSchemeCriteriaGroupListResponse(groups []domain.SchemeCriteriaGroup) []SchemeCriteriaGroupResponse {
+	var schemeCriteriaGroupResponses []SchemeCriteriaGroupResponse
+
+	for _, g := range groups {
+		schemeCriteriaGroupResponses = append(schemeCriteriaGroupResponses, newSchemeCriteriaGroupResponse(g))
+	}
+
+	return schemeCriteriaGroupResponses
+}
+
 // SchemeResponse represents the response structure containing details of a scheme, including ID, name, criteria, and benefits.
+// version_id and version are only set when the details are those of a published version of the scheme.
+// An applicant must meet every criteria and every criteria group to be eligible for the scheme.
 type SchemeResponse struct {
-	ID       string                       `json:"id" example:"00000000-0000-0000-0000-000000000000"`
-	Name     string                       `json:"name" example:"Retrenchment Assistance Scheme"`
-	Criteria []SchemeCriteriaListResponse `json:"criteria"`
-	Benefits []SchemeBenefitListResponse  `json:"benefits"`
+	ID                  string                        `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Name                string                        `json:"name" example:"Retrenchment Assistance Scheme"`
+	ReapplyCooldownDays int                           `json:"reapply_cooldown_days" example:"30"`
+	VersionID           string                        `json:"version_id,omitempty" example:"00000000-0000-0000-0000-000000000000"`
+	Version             int                           `json:"version,omitempty" example:"1"`
+	Criteria            []SchemeCriteriaListResponse  `json:"criteria"`
+	CriteriaGroups      []SchemeCriteriaGroupResponse `json:"criteria_groups"`
+	Benefits            []SchemeBenefitListResponse   `json:"benefits"`
 }
 
 func newSchemeResponse(scheme domain.Scheme) SchemeResponse {
@@ -158,11 +342,25 @@ func newSchemeResponse(scheme domain.Scheme) SchemeResponse {
 		Name: *scheme.Name,
 	}
 
+	if scheme.ReapplyCooldownDays != nil {
+		response.ReapplyCooldownDays = *scheme.ReapplyCooldownDays
+	}
+
+	if scheme.VersionID != nil {
+		response.VersionID = scheme.VersionID.String()
+		response.Version = *scheme.Version
+	}
+
 	// Check if criteria is not empty
 	if scheme.Criteria != nil {
 		response.Criteria = newSchemeCriteriaListResponse(*scheme.Criteria)
 	}
 
+	// Check if criteria groups is not empty
+	if scheme.CriteriaGroups != nil {
+		response.CriteriaGroups = newSchemeCriteriaGroupListResponse(*scheme.CriteriaGroups)
+	}
+
 	// Check if benefits is not empty
 	if scheme.Benefits != nil {
 		response.Benefits = newSchemeBenefitListResponse(*scheme.Benefits)
@@ -173,50 +371,316 @@ func newSchemeResponse(scheme domain.Scheme) SchemeResponse {
 
 // SchemesResponse represents the response structure containing a list of schemes with their respective details.
 type SchemesResponse struct {
-	Schemes []SchemeResponse `json:"schemes"`
+	Schemes       []SchemeResponse `json:"schemes"`
+	Total         int              `json:"total" example:"42"`
+	NextPageToken string           `json:"next_page_token,omitempty" example:"MjA"`
 }
 
-func newSchemesResponse(schemes []domain.Scheme) SchemesResponse {
-	var schemeResponses []SchemeResponse
+func newSchemesResponse(schemes []domain.Scheme, total int, nextPageToken string) SchemesResponse {
+	schemeResponses := make([]SchemeResponse, 0, len(schemes))
 	for _, s := range schemes {
 		schemeResponses = append(schemeResponses, newSchemeResponse(s))
 	}
 	return SchemesResponse{
-		Schemes: schemeResponses,
+		Schemes:       schemeResponses,
+		Total:         total,
+		NextPageToken: nextPageToken,
+	}
+}
+
+// SchemeVersionResponse represents the response structure of a version of a scheme. effective_to is omitted when the
+// version is in effect indefinitely, and definition is omitted for drafts, which have not been published yet.
+type SchemeVersionResponse struct {
+	ID            string          `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	SchemeID      string          `json:"scheme_id" example:"00000000-0000-0000-0000-000000000000"`
+	Version       int             `json:"version" example:"2"`
+	Status        string          `json:"status" example:"published"`
+	EffectiveFrom string          `json:"effective_from" example:"2025-01-01"`
+	EffectiveTo   string          `json:"effective_to,omitempty" example:"2026-01-01"`
+	PublishedAt   string          `json:"published_at,omitempty" example:"2021-01-01T00:00:00Z"`
+	RetiredAt     string          `json:"retired_at,omitempty" example:"2021-01-01T00:00:00Z"`
+	Definition    *SchemeResponse `json:"definition,omitempty"`
+	CreatedAt     string          `json:"created_at" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt     string          `json:"updated_at" example:"2021-01-01T00:00:00Z"`
+}
+
+func newSchemeVersionResponse(version domain.SchemeVersion) SchemeVersionResponse {
+	response := SchemeVersionResponse{
+		ID:            version.ID.String(),
+		SchemeID:      version.SchemeID.String(),
+		Version:       *version.Version,
+		Status:        string(*version.Status),
+		EffectiveFrom: version.EffectiveFrom.Format(util.DateLayout),
+		CreatedAt:     version.CreatedAt.String(),
+		UpdatedAt:     version.UpdatedAt.String(),
+	}
+
+	if version.EffectiveTo != nil {
+		response.EffectiveTo = version.EffectiveTo.Format(util.DateLayout)
+	}
+
+	if version.PublishedAt != nil {
+		response.PublishedAt = version.PublishedAt.String()
+	}
+
+	if version.RetiredAt != nil {
+		response.RetiredAt = version.RetiredAt.String()
+	}
+
+	if version.Definition != nil {
+		definition := newSchemeResponse(*version.Definition)
+		response.Definition = &definition
+	}
+
+	return response
+}
+
+// SchemeVersionsResponse represents the response structure containing the versions of a scheme.
+type SchemeVersionsResponse struct {
+	Versions []SchemeVersionResponse `json:"versions"`
+}
+
+func newSchemeVersionsResponse(versions []domain.SchemeVersion) SchemeVersionsResponse {
+	versionResponses := make([]SchemeVersionResponse, 0, len(versions))
+	for _, v := range versions {
+		versionResponses = append(versionResponses, newSchemeVersionResponse(v))
+	}
+	return SchemeVersionsResponse{
+		Versions: versionResponses,
 	}
 }
 
 // ApplicationResponse represents the response structure containing application details.
 type ApplicationResponse struct {
-	ID          string `json:"id" example:"00000000-0000-0000-0000-000000000000"`
-	ApplicantID string `json:"applicant_id" example:"00000000-0000-0000-0000-000000000000"`
-	SchemeID    string `json:"scheme_id" example:"00000000-0000-0000-0000-000000000000"`
-	CreatedAt   string `json:"created_at" example:"2021-01-01T00:00:00Z"`
-	UpdatedAt   string `json:"updated_at" example:"2021-01-01T00:00:00Z"`
+	ID              string `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	ApplicantID     string `json:"applicant_id" example:"00000000-0000-0000-0000-000000000000"`
+	SchemeID        string `json:"scheme_id" example:"00000000-0000-0000-0000-000000000000"`
+	SchemeVersionID string `json:"scheme_version_id,omitempty" example:"00000000-0000-0000-0000-000000000000"`
+	Status          string `json:"status" example:"submitted"`
+	CreatedAt       string `json:"created_at" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt       string `json:"updated_at" example:"2021-01-01T00:00:00Z"`
 }
 
 func newApplicationResponse(application domain.Application) ApplicationResponse {
-	return ApplicationResponse{
+	response := ApplicationResponse{
 		ID:          application.ID.String(),
 		ApplicantID: application.ApplicantID.String(),
 		SchemeID:    application.SchemeID.String(),
+		Status:      string(*application.Status),
 		CreatedAt:   application.CreatedAt.String(),
 		UpdatedAt:   application.UpdatedAt.String(),
 	}
+
+	if application.SchemeVersionID != nil {
+		response.SchemeVersionID = application.SchemeVersionID.String()
+	}
+
+	return response
+}
+
+// DisbursementResponse represents a scheduled or completed payout of a benefit of an application.
+type DisbursementResponse struct {
+	ID            string `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	ApplicationID string `json:"application_id" example:"00000000-0000-0000-0000-000000000000"`
+	BenefitID     string `json:"benefit_id" example:"00000000-0000-0000-0000-000000000000"`
+	BenefitName   string `json:"benefit_name" example:"CDC Vouchers"`
+	Installment   int    `json:"installment" example:"1"`
+	Amount        string `json:"amount" example:"100.00"`
+	Currency      string `json:"currency" example:"SGD"`
+	DueDate       string `json:"due_date" example:"2025-01-01"`
+	Status        string `json:"status" example:"scheduled"`
+	PaidAt        string `json:"paid_at,omitempty" example:"2025-01-01T00:00:00Z"`
+	CreatedAt     string `json:"created_at" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt     string `json:"updated_at" example:"2021-01-01T00:00:00Z"`
+}
+
+func newDisbursementResponse(disbursement domain.Disbursement) DisbursementResponse {
+	response := DisbursementResponse{
+		ID:            disbursement.ID.String(),
+		ApplicationID: disbursement.ApplicationID.String(),
+		BenefitID:     disbursement.BenefitID.String(),
+		BenefitName:   *disbursement.BenefitName,
+		Installment:   *disbursement.Installment,
+		Amount:        disbursement.Amount.String(),
+		Currency:      string(disbursement.Amount.Currency),
+		DueDate:       disbursement.DueDate.Format(util.DateLayout),
+		Status:        string(*disbursement.Status),
+		CreatedAt:     disbursement.CreatedAt.String(),
+		UpdatedAt:     disbursement.UpdatedAt.String(),
+	}
+
+	if disbursement.PaidAt != nil {
+		response.PaidAt = disbursement.PaidAt.String()
+	}
+
+	return response
+}
+
+// DisbursementsResponse represents the disbursements of an application.
+type DisbursementsResponse struct {
+	Disbursements []DisbursementResponse `json:"disbursements"`
+}
+
+func newDisbursementsResponse(disbursements []domain.Disbursement) DisbursementsResponse {
+	disbursementResponses := make([]DisbursementResponse, 0, len(disbursements))
+	for _, d := range disbursements {
+		disbursementResponses = append(disbursementResponses, newDisbursementResponse(d))
+	}
+	return DisbursementsResponse{
+		Disbursements: disbursementResponses,
+	}
+}
+
+// MoneyResponse represents an amount of money and its currency.
+type MoneyResponse struct {
+	Amount   string `json:"amount" example:"300.00"`
+	Currency string `json:"currency" example:"SGD"`
+}
+
+// PayoutHistoryResponse represents the disbursements of every application of an applicant, together with the total
+// amount paid to them in each currency.
+type PayoutHistoryResponse struct {
+	Disbursements []DisbursementResponse `json:"disbursements"`
+	TotalPaid     []MoneyResponse        `json:"total_paid"`
+}
+
+func newPayoutHistoryResponse(disbursements []domain.Disbursement) (PayoutHistoryResponse, error) {
+	totals, err := domain.PaidTotals(disbursements)
+	if err != nil {
+		return PayoutHistoryResponse{}, err
+	}
+
+	totalPaid := make([]MoneyResponse, 0, len(totals))
+	for _, total := range totals {
+		totalPaid = append(totalPaid, MoneyResponse{Amount: total.String(), Currency: string(total.Currency)})
+	}
+
+	return PayoutHistoryResponse{
+		Disbursements: newDisbursementsResponse(disbursements).Disbursements,
+		TotalPaid:     totalPaid,
+	}, nil
 }
 
 // ApplicationsResponse represents a collection of application responses.
 type ApplicationsResponse struct {
-	Applications []ApplicationResponse `json:"applications"`
+	Applications  []ApplicationResponse `json:"applications"`
+	Total         int                   `json:"total" example:"42"`
+	NextPageToken string                `json:"next_page_token,omitempty" example:"MjA"`
 }
 
-func newApplicationsResponse(applications []domain.Application) ApplicationsResponse {
-	var applicationResponses []ApplicationResponse
+func newApplicationsResponse(applications []domain.Application, total int, nextPageToken string) ApplicationsResponse {
+	applicationResponses := make([]ApplicationResponse, 0, len(applications))
 	for _, a := range applications {
 		applicationResponses = append(applicationResponses, newApplicationResponse(a))
 	}
 	return ApplicationsResponse{
-		Applications: applicationResponses,
+		Applications:  applicationResponses,
+		Total:         total,
+		NextPageToken: nextPageToken,
+	}
+}
+
+// UserResponse represents the response structure containing user details. The password hash is never returned.
+type UserResponse struct {
+	ID        string `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Email     string `json:"email" example:"caseworker@example.com"`
+	Name      string `json:"name" example:"Jane Tan"`
+	Role      string `json:"role" example:"caseworker"`
+	CreatedAt string `json:"created_at" example:"2021-01-01T00:00:00Z"`
+	UpdatedAt string `json:"updated_at" example:"2021-01-01T00:00:00Z"`
+}
+
+func newUserResponse(user domain.User) UserResponse {
+	return UserResponse{
+		ID:        user.ID.String(),
+		Email:     *user.Email,
+		Name:      *user.Name,
+		Role:      string(*user.Role),
+		CreatedAt: user.CreatedAt.String(),
+		UpdatedAt: user.UpdatedAt.String(),
+	}
+}
+
+// UsersResponse represents a collection of user responses.
+type UsersResponse struct {
+	Users []UserResponse `json:"users"`
+}
+
+func newUsersResponse(users []domain.User) UsersResponse {
+	userResponses := make([]UserResponse, 0, len(users))
+	for _, u := range users {
+		userResponses = append(userResponses, newUserResponse(u))
+	}
+	return UsersResponse{Users: userResponses}
+}
+
+// LoginResponse represents the access token issued to a user after signing in.
+type LoginResponse struct {
+	AccessToken string `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	ExpiresAt   string `json:"expires_at" example:"2021-01-01T01:00:00Z"`
+	Role        string `json:"role" example:"caseworker"`
+}
+
+func newLoginResponse(accessToken string, payload domain.TokenPayload) LoginResponse {
+	return LoginResponse{
+		AccessToken: accessToken,
+		ExpiresAt:   payload.ExpiresAt.String(),
+		Role:        string(payload.Role),
+	}
+}
+
+// AuditLogResponse represents the response structure of an audit log entry. For updates, old_values and new_values
+// only hold the fields that changed.
+type AuditLogResponse struct {
+	ID         string         `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	ActorID    string         `json:"actor_id,omitempty" example:"00000000-0000-0000-0000-000000000000"`
+	ActorRole  string         `json:"actor_role,omitempty" example:"caseworker"`
+	EntityType string         `json:"entity_type" example:"application"`
+	EntityID   string         `json:"entity_id" example:"00000000-0000-0000-0000-000000000000"`
+	Action     string         `json:"action" example:"update"`
+	OldValues  map[string]any `json:"old_values,omitempty"`
+	NewValues  map[string]any `json:"new_values,omitempty"`
+	CreatedAt  string         `json:"created_at" example:"2021-01-01T00:00:00Z"`
+}
+
+func newAuditLogResponse(auditLog domain.AuditLog) AuditLogResponse {
+	response := AuditLogResponse{
+		ID:         auditLog.ID.String(),
+		EntityType: string(*auditLog.EntityType),
+		EntityID:   auditLog.EntityID.String(),
+		Action:     string(*auditLog.Action),
+		OldValues:  auditLog.OldValues,
+		NewValues:  auditLog.NewValues,
+		CreatedAt:  auditLog.CreatedAt.String(),
+	}
+
+	if auditLog.ActorID != nil {
+		response.ActorID = auditLog.ActorID.String()
+	}
+
+	if auditLog.ActorRole != nil {
+		response.ActorRole = string(*auditLog.ActorRole)
+	}
+
+	return response
+}
+
+// AuditLogsResponse represents a page of audit log entries.
+type AuditLogsResponse struct {
+	AuditLogs     []AuditLogResponse `json:"audit_logs"`
+	Total         int                `json:"total" example:"42"`
+	NextPageToken string             `json:"next_page_token,omitempty" example:"MjA"`
+}
+
+func newAuditLogsResponse(auditLogs []domain.AuditLog, total int, nextPageToken string) AuditLogsResponse {
+	auditLogResponses := make([]AuditLogResponse, 0, len(auditLogs))
+	for _, a := range auditLogs {
+		auditLogResponses = append(auditLogResponses, newAuditLogResponse(a))
+	}
+	return AuditLogsResponse{
+		AuditLogs:     auditLogResponses,
+		Total:         total,
+		NextPageToken: nextPageToken,
 	}
 }
 
@@ -243,3 +707,133 @@ func newValidationErrorResponse(errorMap map[string]string) ErrorResponse {
 		Errors:  errorMap,
 	}
 }
+
+// CriterionResultResponse represents the outcome of a single criterion, comparing the required value with the applicant's actual value.
+type CriterionResultResponse struct {
+	ID       string `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Name     string `json:"name" example:"age"`
+	Required string `json:"required" example:">=65"`
+	Actual   string `json:"actual" example:"42"`
+	Passed   bool   `json:"passed" example:"false"`
+}
+
+func newCriterionResultListResponse(results []domain.CriterionResult) []CriterionResultResponse {
+	criterionResultResponses := make([]CriterionResultResponse, 0, len(results))
+
+	for _, r := range results {
+		criterionResultResponses = append(criterionResultResponses, CriterionResultResponse{
+			ID:       r.CriteriaID.String(),
+			Name:     *r.Name,
+			Required: *r.Required,
+			Actual:   *r.Actual,
+			Passed:   r.Passed,
+		})
+	}
+
+	return criterionResultResponses
+}
+
+// CriteriaGroupResultResponse represents the outcome of a criteria group together with its criteria and nested groups.
+type CriteriaGroupResultResponse struct {
+	ID       string                        `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Operator string                        `json:"operator" example:"or"`
+	Passed   bool                          `json:"passed" example:"true"`
+	Criteria []CriterionResultResponse     `json:"criteria"`
+	Groups   []CriteriaGroupResultResponse `json:"groups"`
+}
+
+func newCriteriaGroupResultListResponse(results []domain.CriteriaGroupResult) []CriteriaGroupResultResponse {
+	criteriaGroupResultResponses := make([]CriteriaGroupResultResponse, 0, len(results))
+
+	for _, r := range results {
+		criteriaGroupResultResponses = append(criteriaGroupResultResponses, CriteriaGroupResultResponse{
+			ID:       r.GroupID.String(),
+			Operator: string(*r.Operator),
+			Passed:   r.Passed,
+			Criteria: newCriterionResultListResponse(r.Criteria),
+			Groups:   newCriteriaGroupResultListResponse(r.Groups),
+		})
+	}
+
+	return criteriaGroupResultResponses
+}
+
+// BenefitEligibilityResponse represents whether an applicant receives a benefit and the outcome of the benefit's criteria.
+type BenefitEligibilityResponse struct {
+	ID       string                    `json:"id" example:"00000000-0000-0000-0000-000000000000"`
+	Name     string                    `json:"name" example:"School Meal Vouchers"`
+	Eligible bool                      `json:"eligible" example:"true"`
+	Criteria []CriterionResultResponse `json:"criteria"`
+}
+
+func newBenefitEligibilityListResponse(results []domain.BenefitEligibilityResult) []BenefitEligibilityResponse {
+	benefitEligibilityResponses := make([]BenefitEligibilityResponse, 0, len(results))
+
+	for _, r := range results {
+		benefitEligibilityResponses = append(benefitEligibilityResponses, BenefitEligibilityResponse{
+			ID:       r.BenefitID.String(),
+			Name:     *r.Name,
+			Eligible: r.Eligible,
+			Criteria: newCriterionResultListResponse(r.Criteria),
+		})
+	}
+
+	return benefitEligibilityResponses
+}
+
+// EligibilityResponse represents the explanation of whether an applicant is eligible for a scheme.
+type EligibilityResponse struct {
+	SchemeID        string                        `json:"scheme_id" example:"00000000-0000-0000-0000-000000000000"`
+	SchemeVersionID string                        `json:"scheme_version_id" example:"00000000-0000-0000-0000-000000000000"`
+	ApplicantID     string                        `json:"applicant_id" example:"00000000-0000-0000-0000-000000000000"`
+	AsOf            string                        `json:"as_of" example:"2025-01-01"`
+	Eligible        bool                          `json:"eligible" example:"false"`
+	Criteria        []CriterionResultResponse     `json:"criteria"`
+	CriteriaGroups  []CriteriaGroupResultResponse `json:"criteria_groups"`
+	Benefits        []BenefitEligibilityResponse  `json:"benefits"`
+}
+
+func newEligibilityResponse(result domain.EligibilityResult) EligibilityResponse {
+	return EligibilityResponse{
+		SchemeID:        result.SchemeID.String(),
+		SchemeVersionID: result.SchemeVersionID.String(),
+		ApplicantID:     result.ApplicantID.String(),
+		AsOf:            result.AsOf.Format(util.DateLayout),
+		Eligible:        result.Eligible,
+		Criteria:        newCriterionResultListResponse(result.Criteria),
+		CriteriaGroups:  newCriteriaGroupResultListResponse(result.CriteriaGroups),
+		Benefits:        newBenefitEligibilityListResponse(result.Benefits),
+	}
+}
+
+// CriterionTypeResponse describes a criterion that schemes and benefits can use and the values it accepts.
+type CriterionTypeResponse struct {
+	Name        string   `json:"name" example:"marital_status"`
+	Description string   `json:"description" example:"Marital status of the applicant."`
+	Format      string   `json:"format" example:"a comma separated list of single, married, widowed or divorce, optionally prefixed with ! to negate"`
+	Values      []string `json:"values,omitempty" example:"single,married,widowed,divorce"`
+	Examples    []string `json:"examples" example:"single,widowed,divorce"`
+}
+
+// CriterionTypesResponse represents a collection of criterion type responses.
+type CriterionTypesResponse struct {
+	CriterionTypes []CriterionTypeResponse `json:"criterion_types"`
+}
+
+func newCriterionTypesResponse(criterionTypes []domain.CriterionType) CriterionTypesResponse {
+	criterionTypeResponses := make([]CriterionTypeResponse, 0, len(criterionTypes))
+
+	for _, criterionType := range criterionTypes {
+		criterionTypeResponses = append(criterionTypeResponses, CriterionTypeResponse{
+			Name:        criterionType.Name,
+			Description: criterionType.Description,
+			Format:      criterionType.Format,
+			Values:      criterionType.Values,
+			Examples:    criterionType.Examples,
+		})
+	}
+
+	return CriterionTypesResponse{
+		CriterionTypes: criterionTypeResponses,
+	}
+}
@@ -1,13 +1,18 @@
 package http
 
 import (
+	"context"
+	"fmt"
 	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"net/http"
 	"strings"
 )
 
@@ -19,9 +24,18 @@ type Router struct {
 // NewRouter creates a new HTTP router
 func NewRouter(
 	config *config.Config,
+	authService port.AuthService,
+	authHandler AuthHandler,
+	userHandler UserHandler,
+	auditHandler AuditHandler,
 	applicantHandler ApplicantHandler,
+	relationshipHandler RelationshipHandler,
 	schemeHandler SchemeHandler,
 	applicationHandler ApplicationHandler,
+	criteriaHandler CriteriaHandler,
+	disbursementHandler DisbursementHandler,
+	healthHandler HealthHandler,
+	metricsHandler MetricsHandler,
 ) (*Router, error) {
 	// CORS
 	ginConfig := cors.DefaultConfig()
@@ -31,27 +45,86 @@ func NewRouter(
 
 	router := gin.New()
 
+	// Give every request an ID, log and measure it once it has been handled, and recover from panics in its handlers
+	router.Use(requestIDMiddleware(), accessLogMiddleware(), metricsMiddleware(metricsHandler.metrics), recoveryMiddleware())
+
+	// Let handlers pass the gin context to services while still exposing the values of the request context,
+	// such as the user making the request
+	router.ContextWithFallback = true
+
 	// Register custom validators
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("marital_status", validateMaritalStatus)
 		v.RegisterValidation("relationship_type", validateRelationshipType)
 		v.RegisterValidation("sex", validateSex)
 		v.RegisterValidation("employment_status", validateEmploymentStatus)
+		v.RegisterValidation("application_status", validateApplicationStatus)
+		v.RegisterValidation("benefit_frequency", validateBenefitFrequency)
+		v.RegisterValidation("currency", validateCurrency)
+		v.RegisterValidation("role", validateRole)
+		v.RegisterValidation("audit_entity_type", validateAuditEntityType)
+		v.RegisterValidation("audit_action", validateAuditAction)
 		v.RegisterValidation("date", validateDate)
 	}
 	// Swagger
 	router.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// Health probes
+	router.GET("/healthz", healthHandler.Liveness)
+	router.GET("/readyz", healthHandler.Readiness)
+
+	// Prometheus metrics
+	router.GET("/metrics", metricsHandler.Metrics)
+
+	// Permissions required by each group of routes
+	canRead := requirePermission(domain.PermissionRead)
+	canManageApplicants := requirePermission(domain.PermissionManageApplicants)
+	canManageApplications := requirePermission(domain.PermissionManageApplications)
+	canDecideApplications := requirePermission(domain.PermissionDecideApplications)
+	canManageSchemes := requirePermission(domain.PermissionManageSchemes)
+	canManageUsers := requirePermission(domain.PermissionManageUsers)
+	canReadAuditLogs := requirePermission(domain.PermissionReadAuditLogs)
+
 	api := router.Group("/api")
 	{
+		// Auth routes
+		api.POST("/auth/login", authHandler.Login)
+
+		// Every route below requires a valid access token
+		api.Use(authMiddleware(authService))
+
+		api.GET("/auth/me", authHandler.Me)
+
+		// User routes
+		users := api.Group("/users", canManageUsers)
+		{
+			users.GET("/", userHandler.ListUsers)
+			users.GET("/:id", userHandler.GetUser)
+			users.POST("/", userHandler.CreateUser)
+			users.PUT("/:id", userHandler.UpdateUser)
+			users.DELETE("/:id", userHandler.DeleteUser)
+		}
+
+		// Audit routes
+		api.GET("/audit", canReadAuditLogs, auditHandler.ListAuditLogs)
+
 		applicants := api.Group("/applicants")
 		{
 			// Applicant routes
-			applicants.GET("/", applicantHandler.ListApplicants)
-			applicants.GET("/:id", applicantHandler.GetApplicant)
-			applicants.POST("/", applicantHandler.CreateApplicant)
-			applicants.PUT("/:id", applicantHandler.UpdateApplicant)
-			applicants.DELETE("/:id", applicantHandler.DeleteApplicant)
+			applicants.GET("/", canRead, applicantHandler.ListApplicants)
+			applicants.GET("/:id", canRead, applicantHandler.GetApplicant)
+			applicants.POST("/", canManageApplicants, applicantHandler.CreateApplicant)
+			applicants.PUT("/:id", canManageApplicants, applicantHandler.UpdateApplicant)
+			applicants.DELETE("/:id", canManageApplicants, applicantHandler.DeleteApplicant)
+
+			// Relationship routes
+			applicants.GET("/:id/relationships", canRead, relationshipHandler.ListApplicantRelationships)
+			applicants.POST("/:id/relationships", canManageApplicants, relationshipHandler.CreateRelationship)
+			applicants.PUT("/:id/relationships/:relationship_id", canManageApplicants, relationshipHandler.UpdateRelationship)
+			applicants.DELETE("/:id/relationships/:relationship_id", canManageApplicants, relationshipHandler.DeleteRelationship)
+
+			// Payout history routes
+			applicants.GET("/:id/disbursements", canRead, disbursementHandler.ListApplicantDisbursements)
 		}
 
 		// Scheme routes
@@ -59,41 +132,81 @@ func NewRouter(
 		{
 			schemeIdRoutes := schemes.Group("/:scheme_id")
 			{
-				schemeIdRoutes.GET("/", schemeHandler.GetScheme)
-				schemeIdRoutes.PUT("/", schemeHandler.UpdateScheme)
-				schemeIdRoutes.DELETE("/", schemeHandler.DeleteScheme)
+				schemeIdRoutes.GET("/", canRead, schemeHandler.GetScheme)
+				schemeIdRoutes.PUT("/", canManageSchemes, schemeHandler.UpdateScheme)
+				schemeIdRoutes.DELETE("/", canManageSchemes, schemeHandler.DeleteScheme)
+
+				schemeIdRoutes.GET("/eligibility", canRead, schemeHandler.CheckApplicantEligibility)
+
+				schemeIdRoutes.POST("/benefits", canManageSchemes, schemeHandler.AddSchemeBenefit)
+
+				schemeIdRoutes.POST("/criteria", canManageSchemes, schemeHandler.AddSchemeCriteria)
 
-				schemeIdRoutes.POST("/benefits", schemeHandler.AddSchemeBenefit)
+				schemeIdRoutes.POST("/criteria-groups", canManageSchemes, schemeHandler.AddSchemeCriteriaGroup)
 
-				schemeIdRoutes.POST("/criteria", schemeHandler.AddSchemeCriteria)
+				schemeIdRoutes.GET("/versions", canRead, schemeHandler.ListSchemeVersions)
+				schemeIdRoutes.POST("/versions", canManageSchemes, schemeHandler.CreateSchemeVersion)
 
 			}
 
 			benefitsRoutes := schemes.Group("/benefits")
 			{
-				benefitsRoutes.PUT("/:benefit_id", schemeHandler.UpdateSchemeBenefit)
-				benefitsRoutes.DELETE("/:benefit_id", schemeHandler.DeleteSchemeBenefit)
+				benefitsRoutes.PUT("/:benefit_id", canManageSchemes, schemeHandler.UpdateSchemeBenefit)
+				benefitsRoutes.DELETE("/:benefit_id", canManageSchemes, schemeHandler.DeleteSchemeBenefit)
+
+				benefitsRoutes.GET("/:benefit_id/criteria", canRead, schemeHandler.ListBenefitCriteria)
+				benefitsRoutes.POST("/:benefit_id/criteria", canManageSchemes, schemeHandler.AddBenefitCriteria)
+				benefitsRoutes.PUT("/:benefit_id/criteria/:benefit_criteria_id", canManageSchemes, schemeHandler.UpdateBenefitCriteria)
+				benefitsRoutes.DELETE("/:benefit_id/criteria/:benefit_criteria_id", canManageSchemes, schemeHandler.DeleteBenefitCriteria)
 			}
 
 			schemeCriteriaRoutes := schemes.Group("/criteria")
 			{
-				schemeCriteriaRoutes.PUT("/:scheme_criteria_id", schemeHandler.UpdateSchemeCriteria)
-				schemeCriteriaRoutes.DELETE("/:scheme_criteria_id", schemeHandler.DeleteSchemeCriteria)
+				schemeCriteriaRoutes.PUT("/:scheme_criteria_id", canManageSchemes, schemeHandler.UpdateSchemeCriteria)
+				schemeCriteriaRoutes.DELETE("/:scheme_criteria_id", canManageSchemes, schemeHandler.DeleteSchemeCriteria)
+			}
+
+			schemeCriteriaGroupRoutes := schemes.Group("/criteria-groups")
+			{
+				schemeCriteriaGroupRoutes.DELETE("/:criteria_group_id", canManageSchemes, schemeHandler.DeleteSchemeCriteriaGroup)
+			}
+
+			schemeVersionRoutes := schemes.Group("/versions")
+			{
+				schemeVersionRoutes.GET("/:version_id", canRead, schemeHandler.GetSchemeVersion)
+				schemeVersionRoutes.DELETE("/:version_id", canManageSchemes, schemeHandler.DeleteSchemeVersion)
+				schemeVersionRoutes.POST("/:version_id/publish", canManageSchemes, schemeHandler.PublishSchemeVersion)
+				schemeVersionRoutes.POST("/:version_id/retire", canManageSchemes, schemeHandler.RetireSchemeVersion)
 			}
 
-			schemes.GET("/", schemeHandler.ListSchemes)
-			schemes.GET("/eligible", schemeHandler.ListApplicantAvailableSchemes)
-			schemes.POST("/", schemeHandler.CreateScheme)
+			schemes.GET("/", canRead, schemeHandler.ListSchemes)
+			schemes.GET("/eligible", canRead, schemeHandler.ListApplicantAvailableSchemes)
+			schemes.POST("/", canManageSchemes, schemeHandler.CreateScheme)
 		}
 
+		// Criteria routes
+		api.GET("/criteria/types", canRead, criteriaHandler.ListCriterionTypes)
+
 		// Application routes
 		applications := api.Group("/applications")
 		{
-			applications.GET("/", applicationHandler.ListApplications)
-			applications.GET("/:id", applicationHandler.GetApplication)
-			applications.POST("/", applicationHandler.CreateApplication)
-			applications.PUT("/:id", applicationHandler.UpdateApplication)
-			applications.DELETE("/:id", applicationHandler.DeleteApplication)
+			applications.GET("/", canRead, applicationHandler.ListApplications)
+			applications.GET("/:id", canRead, applicationHandler.GetApplication)
+			applications.POST("/", canManageApplications, applicationHandler.CreateApplication)
+			applications.PUT("/:id", canManageApplications, applicationHandler.UpdateApplication)
+			applications.POST("/:id/review", canManageApplications, applicationHandler.ReviewApplication)
+			applications.POST("/:id/approve", canDecideApplications, applicationHandler.ApproveApplication)
+			applications.POST("/:id/reject", canDecideApplications, applicationHandler.RejectApplication)
+			applications.POST("/:id/withdraw", canManageApplications, applicationHandler.WithdrawApplication)
+			applications.POST("/:id/disburse", canDecideApplications, applicationHandler.DisburseApplication)
+			applications.DELETE("/:id", canManageApplications, applicationHandler.DeleteApplication)
+
+			// Disbursement routes
+			applications.GET("/:id/disbursements", canRead, disbursementHandler.ListApplicationDisbursements)
+			applications.POST("/:id/disbursements/:disbursement_id/pay", canDecideApplications, disbursementHandler.PayDisbursement)
+			applications.POST("/:id/disbursements/:disbursement_id/fail", canDecideApplications, disbursementHandler.FailDisbursement)
+			applications.POST("/:id/disbursements/:disbursement_id/retry", canDecideApplications, disbursementHandler.RetryDisbursement)
+			applications.POST("/:id/disbursements/:disbursement_id/claw-back", canDecideApplications, disbursementHandler.ClawBackDisbursement)
 		}
 	}
 
@@ -102,7 +215,30 @@ func NewRouter(
 	}, nil
 }
 
-// Serve starts the HTTP server
-func (r *Router) Serve(listenAddr string) error {
-	return r.Run(listenAddr)
+// Serve starts the HTTP server and shuts it down gracefully once ctx is done. The server stops accepting connections and
+// waits up to the shutdown timeout in the config for requests in flight to finish.
+func (r *Router) Serve(ctx context.Context, config *config.Config) error {
+	server := &http.Server{
+		Addr:         fmt.Sprintf("%s:%s", config.ApiUrl, config.ApiPort),
+		Handler:      r,
+		ReadTimeout:  config.ReadTimeout,
+		WriteTimeout: config.WriteTimeout,
+		IdleTimeout:  config.IdleTimeout,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+	defer cancel()
+
+	return server.Shutdown(shutdownCtx)
 }
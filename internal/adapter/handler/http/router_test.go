@@ -0,0 +1,522 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/auth"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/metrics"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/memory"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/service"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testAdminEmail    = "admin@example.com"
+	testAdminPassword = "password123"
+)
+
+// testServer drives a router built on the in-memory storage
+type testServer struct {
+	t      *testing.T
+	router *Router
+	token  string
+}
+
+// newTestServer creates a router with the real services on an empty in-memory store and signs in as a superadmin
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{
+		TokenSecret:    "a-test-secret-that-is-long-enough-to-sign-tokens",
+		TokenDuration:  time.Hour,
+		AllowedOrigins: "*",
+	}
+
+	store := memory.NewStore()
+	userRepo := memory.NewUserRepository(store)
+	auditRepo := memory.NewAuditRepository(store)
+	applicantRepo := memory.NewApplicantRepository(store)
+	relationshipRepo := memory.NewRelationshipRepository(store)
+	schemeRepo := memory.NewSchemeRepository(store)
+	applicationRepo := memory.NewApplicationRepository(store)
+	disbursementRepo := memory.NewDisbursementRepository(store)
+	apiMetrics := metrics.New()
+
+	tokenService, err := auth.NewJWTService(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userService := service.NewUserService(userRepo, store)
+	authService := service.NewAuthService(userRepo, tokenService)
+
+	adminName := "Administrator"
+	adminEmail := testAdminEmail
+	_, err = userService.CreateFirstSuperadmin(context.Background(), &domain.User{Email: &adminEmail, Name: &adminName}, testAdminPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router, err := NewRouter(
+		cfg,
+		authService,
+		*NewAuthHandler(authService, userService),
+		*NewUserHandler(userService),
+		*NewAuditHandler(service.NewAuditService(auditRepo)),
+		*NewApplicantHandler(service.NewApplicantService(applicantRepo)),
+		*NewRelationshipHandler(service.NewRelationshipService(relationshipRepo, applicantRepo, store)),
+		*NewSchemeHandler(service.NewSchemeService(schemeRepo, applicantRepo, store, apiMetrics)),
+		*NewApplicationHandler(service.NewApplicationService(applicationRepo, applicantRepo, schemeRepo, disbursementRepo, store, apiMetrics)),
+		*NewCriteriaHandler(),
+		*NewDisbursementHandler(service.NewDisbursementService(disbursementRepo, applicationRepo, applicantRepo, store)),
+		*NewHealthHandler(store),
+		*NewMetricsHandler(apiMetrics),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &testServer{t: t, router: router}
+	s.token = s.login(testAdminEmail, testAdminPassword)
+	return s
+}
+
+// login signs in and returns the access token
+func (s *testServer) login(email, password string) string {
+	s.t.Helper()
+
+	var rsp LoginResponse
+	s.do(http.MethodPost, "/api/auth/login", "", LoginRequest{Email: email, Password: password}, http.StatusOK, &rsp)
+	return rsp.AccessToken
+}
+
+// request sends a request to the router with the given access token and returns the recorded response
+func (s *testServer) request(method, path, token string, body any) *httptest.ResponseRecorder {
+	s.t.Helper()
+
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			s.t.Fatal(err)
+		}
+	}
+
+	req := httptest.NewRequest(method, path, &payload)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	return w
+}
+
+// do sends a request, checks the status code of the response, and decodes its data into data if it is not nil
+func (s *testServer) do(method, path, token string, body any, wantStatus int, data any) {
+	s.t.Helper()
+
+	w := s.request(method, path, token, body)
+	if w.Code != wantStatus {
+		s.t.Fatalf("%s %s returned %d, want %d: %s", method, path, w.Code, wantStatus, w.Body.String())
+	}
+	if data == nil {
+		return
+	}
+
+	rsp := struct {
+		Data json.RawMessage `json:"data"`
+	}{}
+	if err := json.Unmarshal(w.Body.Bytes(), &rsp); err != nil {
+		s.t.Fatal(err)
+	}
+	if err := json.Unmarshal(rsp.Data, data); err != nil {
+		s.t.Fatalf("decoding %s: %v", rsp.Data, err)
+	}
+}
+
+// createApplicant creates an unemployed single applicant of the given age
+func (s *testServer) createApplicant(name string, age int) ApplicantResponse {
+	s.t.Helper()
+
+	income := json.Number("500.00")
+	var applicant ApplicantResponse
+	s.do(http.MethodPost, "/api/applicants/", s.token, CreateApplicantRequest{
+		Name:             name,
+		EmploymentStatus: domain.EmploymentStatusUnemployed,
+		Sex:              domain.SexFemale,
+		DateOfBirth:      time.Now().AddDate(-age, 0, -1).Format(time.DateOnly),
+		MaritalStatus:    domain.MaritalStatusSingle,
+		MonthlyIncome:    &income,
+	}, http.StatusCreated, &applicant)
+	return applicant
+}
+
+func TestAuthentication(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		token      string
+		body       any
+		wantStatus int
+	}{
+		{name: "wrong password", method: http.MethodPost, path: "/api/auth/login", body: LoginRequest{Email: testAdminEmail, Password: "wrong-password"}, wantStatus: http.StatusUnauthorized},
+		{name: "invalid login request", method: http.MethodPost, path: "/api/auth/login", body: map[string]string{"email": "not-an-email"}, wantStatus: http.StatusBadRequest},
+		{name: "missing token", method: http.MethodGet, path: "/api/applicants/", wantStatus: http.StatusUnauthorized},
+		{name: "invalid token", method: http.MethodGet, path: "/api/applicants/", token: "not-a-token", wantStatus: http.StatusUnauthorized},
+		{name: "valid token", method: http.MethodGet, path: "/api/auth/me", token: s.token, wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := s.request(tt.method, tt.path, tt.token, tt.body)
+			if w.Code != tt.wantStatus {
+				t.Errorf("%s %s returned %d, want %d: %s", tt.method, tt.path, w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestRolePermissions(t *testing.T) {
+	s := newTestServer(t)
+
+	s.do(http.MethodPost, "/api/users/", s.token, CreateUserRequest{
+		Email:    "viewer@example.com",
+		Name:     "Viewer",
+		Password: "password123",
+		Role:     domain.RoleViewer,
+	}, http.StatusCreated, nil)
+	viewer := s.login("viewer@example.com", "password123")
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       any
+		wantStatus int
+	}{
+		{name: "viewers can read applicants", method: http.MethodGet, path: "/api/applicants/", wantStatus: http.StatusOK},
+		{name: "viewers can list criterion types", method: http.MethodGet, path: "/api/criteria/types", wantStatus: http.StatusOK},
+		{name: "viewers cannot create applicants", method: http.MethodPost, path: "/api/applicants/", body: CreateApplicantRequest{Name: "Jane"}, wantStatus: http.StatusForbidden},
+		{name: "viewers cannot create schemes", method: http.MethodPost, path: "/api/schemes/", body: CreateSchemeRequest{Name: "Scheme"}, wantStatus: http.StatusForbidden},
+		{name: "viewers cannot manage users", method: http.MethodGet, path: "/api/users/", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := s.request(tt.method, tt.path, viewer, tt.body)
+			if w.Code != tt.wantStatus {
+				t.Errorf("%s %s returned %d, want %d: %s", tt.method, tt.path, w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestTokensFollowTheirUser(t *testing.T) {
+	s := newTestServer(t)
+
+	var caseworker UserResponse
+	s.do(http.MethodPost, "/api/users/", s.token, CreateUserRequest{
+		Email:    "caseworker@example.com",
+		Name:     "Caseworker",
+		Password: "password123",
+		Role:     domain.RoleCaseworker,
+	}, http.StatusCreated, &caseworker)
+	token := s.login("caseworker@example.com", "password123")
+
+	income := json.Number("500.00")
+	applicant := CreateApplicantRequest{
+		Name:             "Jane",
+		EmploymentStatus: domain.EmploymentStatusUnemployed,
+		Sex:              domain.SexFemale,
+		DateOfBirth:      "1990-01-01",
+		MaritalStatus:    domain.MaritalStatusSingle,
+		MonthlyIncome:    &income,
+	}
+	s.do(http.MethodPost, "/api/applicants/", token, applicant, http.StatusCreated, nil)
+
+	// Demoting the user takes effect on the token that was issued before
+	viewer := domain.RoleViewer
+	s.do(http.MethodPut, "/api/users/"+caseworker.ID, s.token, UpdateUserRequest{Role: &viewer}, http.StatusOK, nil)
+	s.do(http.MethodPost, "/api/applicants/", token, applicant, http.StatusForbidden, nil)
+	s.do(http.MethodGet, "/api/applicants/", token, nil, http.StatusOK, nil)
+
+	// Deleting the user rejects the token
+	s.do(http.MethodDelete, "/api/users/"+caseworker.ID, s.token, nil, http.StatusOK, nil)
+	s.do(http.MethodGet, "/api/applicants/", token, nil, http.StatusUnauthorized, nil)
+}
+
+func TestApplicants(t *testing.T) {
+	s := newTestServer(t)
+
+	jane := s.createApplicant("Jane Tan", 40)
+	s.createApplicant("Mary Lim", 70)
+
+	var got ApplicantResponse
+	s.do(http.MethodGet, "/api/applicants/"+jane.ID, s.token, nil, http.StatusOK, &got)
+	if got.Name != "Jane Tan" {
+		t.Errorf("got applicant %q, want %q", got.Name, "Jane Tan")
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		wantNames []string
+		wantTotal int
+	}{
+		{name: "sorted by name descending", query: "?sort_by=name&sort_order=desc", wantNames: []string{"Mary Lim", "Jane Tan"}, wantTotal: 2},
+		{name: "sorted by name", query: "?sort_by=name", wantNames: []string{"Jane Tan", "Mary Lim"}, wantTotal: 2},
+		{name: "minimum age", query: "?min_age=65", wantNames: []string{"Mary Lim"}, wantTotal: 1},
+		{name: "maximum age", query: "?max_age=64", wantNames: []string{"Jane Tan"}, wantTotal: 1},
+		{name: "page size", query: "?page_size=1&sort_by=name", wantNames: []string{"Jane Tan"}, wantTotal: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var list ApplicantsResponse
+			s.do(http.MethodGet, "/api/applicants/"+tt.query, s.token, nil, http.StatusOK, &list)
+
+			if list.Total != tt.wantTotal {
+				t.Errorf("got a total of %d applicants, want %d", list.Total, tt.wantTotal)
+			}
+			if len(list.Applicants) != len(tt.wantNames) {
+				t.Fatalf("got %d applicants, want %d", len(list.Applicants), len(tt.wantNames))
+			}
+			for i, name := range tt.wantNames {
+				if list.Applicants[i].Name != name {
+					t.Errorf("applicant %d is %q, want %q", i, list.Applicants[i].Name, name)
+				}
+			}
+		})
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		s.do(http.MethodPost, "/api/applicants/", s.token, CreateApplicantRequest{Name: "Missing Details"}, http.StatusBadRequest, nil)
+	})
+
+	t.Run("invalid sort field", func(t *testing.T) {
+		s.do(http.MethodGet, "/api/applicants/?sort_by=height", s.token, nil, http.StatusBadRequest, nil)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		s.do(http.MethodDelete, "/api/applicants/"+jane.ID, s.token, nil, http.StatusOK, nil)
+		s.do(http.MethodGet, "/api/applicants/"+jane.ID, s.token, nil, http.StatusNotFound, nil)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s.do(http.MethodGet, "/api/applicants/00000000-0000-0000-0000-000000000000", s.token, nil, http.StatusNotFound, nil)
+	})
+}
+
+func TestApplicationLifecycle(t *testing.T) {
+	s := newTestServer(t)
+
+	senior := s.createApplicant("Mary Lim", 70)
+	young := s.createApplicant("Jane Tan", 30)
+
+	var scheme SchemeResponse
+	s.do(http.MethodPost, "/api/schemes/", s.token, CreateSchemeRequest{
+		Name:     "Senior Support Scheme",
+		Criteria: []AddSchemeCriteriaRequest{{Name: "age", Value: ">=65"}},
+		Benefits: []SchemeBenefitRequest{{Name: "Grocery vouchers", Amount: "100.00"}},
+	}, http.StatusCreated, &scheme)
+
+	if len(scheme.Criteria) != 1 || len(scheme.Benefits) != 1 {
+		t.Fatalf("got scheme with %d criteria and %d benefits, want 1 of each", len(scheme.Criteria), len(scheme.Benefits))
+	}
+
+	t.Run("invalid criteria are rejected", func(t *testing.T) {
+		s.do(http.MethodPost, "/api/schemes/", s.token, CreateSchemeRequest{
+			Name:     "Invalid Scheme",
+			Criteria: []AddSchemeCriteriaRequest{{Name: "age", Value: "sixty-five"}},
+		}, http.StatusBadRequest, nil)
+	})
+
+	t.Run("ineligible applicants cannot apply", func(t *testing.T) {
+		w := s.request(http.MethodPost, "/api/applications/", s.token, CreateApplicationRequest{ApplicantID: young.ID, SchemeID: scheme.ID})
+		if w.Code < http.StatusBadRequest || w.Code >= http.StatusInternalServerError {
+			t.Errorf("POST /api/applications/ returned %d, want a client error: %s", w.Code, w.Body.String())
+		}
+	})
+
+	var application ApplicationResponse
+	s.do(http.MethodPost, "/api/applications/", s.token, CreateApplicationRequest{ApplicantID: senior.ID, SchemeID: scheme.ID}, http.StatusCreated, &application)
+	if application.Status != string(domain.ApplicationStatusSubmitted) {
+		t.Errorf("got application status %q, want %q", application.Status, domain.ApplicationStatusSubmitted)
+	}
+
+	t.Run("only one active application per scheme", func(t *testing.T) {
+		s.do(http.MethodPost, "/api/applications/", s.token, CreateApplicationRequest{ApplicantID: senior.ID, SchemeID: scheme.ID}, http.StatusConflict, nil)
+	})
+
+	t.Run("submitted applications cannot be approved", func(t *testing.T) {
+		s.do(http.MethodPost, "/api/applications/"+application.ID+"/approve", s.token, nil, http.StatusConflict, nil)
+	})
+
+	s.do(http.MethodPost, "/api/applications/"+application.ID+"/review", s.token, nil, http.StatusOK, &application)
+	s.do(http.MethodPost, "/api/applications/"+application.ID+"/approve", s.token, nil, http.StatusOK, &application)
+	if application.Status != string(domain.ApplicationStatusApproved) {
+		t.Errorf("got application status %q, want %q", application.Status, domain.ApplicationStatusApproved)
+	}
+
+	var ledger DisbursementsResponse
+	s.do(http.MethodGet, "/api/applications/"+application.ID+"/disbursements", s.token, nil, http.StatusOK, &ledger)
+	if len(ledger.Disbursements) != 1 {
+		t.Fatalf("got %d disbursements, want 1", len(ledger.Disbursements))
+	}
+	if d := ledger.Disbursements[0]; d.Amount != "100.00" || d.Status != string(domain.DisbursementStatusScheduled) {
+		t.Errorf("got disbursement of %s that is %s, want 100.00 that is scheduled", d.Amount, d.Status)
+	}
+}
+
+// readinessFunc is a port.HealthChecker that returns the error of a function
+type readinessFunc func() error
+
+func (f readinessFunc) CheckReadiness(ctx context.Context) error {
+	return f()
+}
+
+func TestHealthProbes(t *testing.T) {
+	s := newTestServer(t)
+
+	t.Run("in-memory storage is ready", func(t *testing.T) {
+		s.do(http.MethodGet, "/healthz", "", nil, http.StatusOK, nil)
+		s.do(http.MethodGet, "/readyz", "", nil, http.StatusOK, nil)
+	})
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "ready", err: nil, wantStatus: http.StatusOK},
+		{name: "database unavailable", err: fmt.Errorf("%w: connection refused", domain.DatabaseUnavailableError), wantStatus: http.StatusServiceUnavailable},
+		{name: "outdated schema", err: fmt.Errorf("%w: at version 20, latest is 21", domain.OutdatedSchemaError), wantStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHealthHandler(readinessFunc(func() error { return tt.err }))
+			router := gin.New()
+			router.GET("/healthz", handler.Liveness)
+			router.GET("/readyz", handler.Readiness)
+
+			for path, wantStatus := range map[string]int{"/healthz": http.StatusOK, "/readyz": tt.wantStatus} {
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+				if w.Code != wantStatus {
+					t.Errorf("GET %s returned %d, want %d: %s", path, w.Code, wantStatus, w.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestServeShutsDownWhenContextIsDone(t *testing.T) {
+	s := newTestServer(t)
+
+	cfg := &config.Config{ApiUrl: "127.0.0.1", ApiPort: "0", ShutdownTimeout: time.Second}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	served := make(chan error, 1)
+	go func() {
+		served <- s.router.Serve(ctx, cfg)
+	}()
+
+	cancel()
+
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("Serve() = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve() did not return after its context was done")
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	s := newTestServer(t)
+
+	senior := s.createApplicant("Mary Lim", 70)
+	young := s.createApplicant("Jane Tan", 30)
+
+	var scheme SchemeResponse
+	s.do(http.MethodPost, "/api/schemes/", s.token, CreateSchemeRequest{
+		Name:     "Senior Support Scheme",
+		Criteria: []AddSchemeCriteriaRequest{{Name: "age", Value: ">=65"}},
+	}, http.StatusCreated, &scheme)
+
+	s.request(http.MethodPost, "/api/applications/", s.token, CreateApplicationRequest{ApplicantID: young.ID, SchemeID: scheme.ID})
+	s.do(http.MethodPost, "/api/applications/", s.token, CreateApplicationRequest{ApplicantID: senior.ID, SchemeID: scheme.ID}, http.StatusCreated, nil)
+	s.request(http.MethodGet, "/unknown", "", nil)
+
+	w := s.request(http.MethodGet, "/metrics", "", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /metrics returned %d, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	for _, want := range []string{
+		`fas_applications_created_total 1`,
+		`fas_eligibility_checks_total 2`,
+		fmt.Sprintf(`fas_eligibility_ineligible_total{scheme_id=%q} 1`, scheme.ID),
+		`fas_criteria_evaluation_duration_seconds_count 2`,
+		`fas_http_request_duration_seconds_count{method="POST",route="/api/applications/",status="201"} 1`,
+		`fas_http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`,
+	} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Errorf("metrics do not contain %s", want)
+		}
+	}
+}
+
+func TestSchemeVersionCorrectedOnTheSameDay(t *testing.T) {
+	s := newTestServer(t)
+
+	young := s.createApplicant("Jane Tan", 30)
+
+	var scheme SchemeResponse
+	s.do(http.MethodPost, "/api/schemes/", s.token, CreateSchemeRequest{
+		Name:     "Senior Support Scheme",
+		Criteria: []AddSchemeCriteriaRequest{{Name: "age", Value: ">=65"}},
+	}, http.StatusCreated, &scheme)
+
+	// Correct the criteria of the first version, which came into effect today when the scheme was created
+	s.do(http.MethodPut, "/api/schemes/"+scheme.ID+"/", s.token, UpdateSchemeRequest{
+		Criteria: []AddSchemeCriteriaRequest{{Name: "age", Value: ">=21"}},
+	}, http.StatusOK, nil)
+
+	var draft SchemeVersionResponse
+	s.do(http.MethodPost, "/api/schemes/"+scheme.ID+"/versions", s.token, CreateSchemeVersionRequest{
+		EffectiveFrom: time.Now().Format(time.DateOnly),
+	}, http.StatusCreated, &draft)
+	s.do(http.MethodPost, "/api/schemes/versions/"+draft.ID+"/publish", s.token, nil, http.StatusOK, nil)
+
+	var versions SchemeVersionsResponse
+	s.do(http.MethodGet, "/api/schemes/"+scheme.ID+"/versions", s.token, nil, http.StatusOK, &versions)
+
+	statuses := map[int]string{}
+	for _, v := range versions.Versions {
+		statuses[v.Version] = v.Status
+	}
+	want := map[int]string{1: string(domain.SchemeVersionStatusRetired), 2: string(domain.SchemeVersionStatusPublished)}
+	if fmt.Sprint(statuses) != fmt.Sprint(want) {
+		t.Errorf("got version statuses %v, want %v", statuses, want)
+	}
+
+	// The corrected version is in effect
+	s.do(http.MethodPost, "/api/applications/", s.token, CreateApplicationRequest{ApplicantID: young.ID, SchemeID: scheme.ID}, http.StatusCreated, nil)
+}
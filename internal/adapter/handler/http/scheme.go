@@ -1,11 +1,14 @@
 package http
 
 import (
+	"encoding/json"
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
 	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/cxnub/fas-mgmt-system/internal/core/util"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"net/http"
+	"time"
 )
 
 type SchemeHandler struct {
@@ -22,6 +25,7 @@ func NewSchemeHandler(s port.SchemeService) *SchemeHandler {
 // @Tags		 schemes
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param	  scheme_id   path	  string  true  "Scheme ID" format(uuid)
 // @Success	  200  {object}  SchemeResponse  "Successfully retrieved scheme"
 // @Failure	  400  {object}  ErrorResponse		  "Validation error occurred"
@@ -59,21 +63,47 @@ func (h *SchemeHandler) GetScheme(ctx *gin.Context) {
 
 // ListSchemes godoc
 // @Summary	  List all schemes
-// @Description  Retrieve a comprehensive list of all available schemes.
+// @Description  Retrieve a page of available schemes, optionally sorted and filtered by name.
 // @Tags		 schemes
 // @Accept	   json
 // @Produce	  json
-// @Success	  200  {array}   SchemeResponse  "Successfully retrieved list of schemes"
+// @Security	 BearerAuth
+// @Param		page_size   query   int	 false  "Number of schemes per page (1-100, default 20)"
+// @Param		page_token  query   string  false  "Token of the page to retrieve, from the next_page_token of the previous page"
+// @Param		sort_by	 query   string  false  "Field to sort by" Enums(name, created_at)
+// @Param		sort_order  query   string  false  "Sort order" Enums(asc, desc)
+// @Param		name		query   string  false  "Only include schemes whose name contains this text"
+// @Success	  200  {object}  SchemesResponse  "Successfully retrieved list of schemes"
+// @Failure	  400  {object}  ErrorResponse			"Validation error occurred"
 // @Failure	  500  {object}  ErrorResponse			"Internal server error"
 // @Router	   /schemes [get]
 func (h *SchemeHandler) ListSchemes(ctx *gin.Context) {
-	result, err := h.s.ListSchemes(ctx)
+	var req ListSchemesRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	opts, err := newListOptions(req.PageSize, req.PageToken, req.SortBy, req.SortOrder)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	filter := domain.SchemeFilter{
+		ListOptions: opts,
+		Name:        req.Name,
+	}
+
+	result, total, err := h.s.ListSchemes(ctx, filter)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	rsp := newSchemesResponse(result)
+	rsp := newSchemesResponse(result, total, nextPageToken(opts, len(result), total))
 
 	handleSuccess(ctx, http.StatusOK, "", rsp)
 }
@@ -84,7 +114,9 @@ func (h *SchemeHandler) ListSchemes(ctx *gin.Context) {
 // @Tags		 schemes
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		applicant query   string  true  "Applicant ID" format(uuid)
+// @Param		as_of	 query   string  false "Evaluate eligibility as of this date instead of today" format(date)
 // @Success	  200	   {array}  SchemeResponse  "Successfully retrieved available schemes"
 // @Failure	  400	   {object} ErrorResponse		   "Validation error occurred"
 // @Failure	  404	   {object} ErrorResponse		   "Applicant not found"
@@ -98,25 +130,99 @@ func (h *SchemeHandler) ListApplicantAvailableSchemes(ctx *gin.Context) {
 		return
 	}
 
-	result, err := h.s.ListApplicantAvailableSchemes(ctx, id)
+	asOf, ok := parseAsOf(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := h.s.ListApplicantAvailableSchemes(ctx, id, asOf)
 
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	rsp := newSchemesResponse(result)
+	rsp := newSchemesResponse(result, len(result), "")
 
 	handleSuccess(ctx, http.StatusOK, "", rsp)
 	return
 }
 
+// CheckApplicantEligibility godoc
+// @Summary	  Explain Applicant Eligibility
+// @Description  Explain whether an applicant is eligible for a scheme by listing every criterion with the required value, the applicant's actual value and whether it passed.
+// @Tags		 schemes
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		scheme_id  path	  string  true  "Scheme ID" format(uuid)
+// @Param		applicant  query   string  true  "Applicant ID" format(uuid)
+// @Param		as_of	  query   string  false "Evaluate eligibility as of this date instead of today" format(date)
+// @Success	  200	   {object}  EligibilityResponse  "Successfully checked eligibility"
+// @Failure	  400	   {object}  ErrorResponse		"Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse		"Scheme or applicant not found"
+// @Failure	  500	   {object}  ErrorResponse		"Internal server error"
+// @Router	   /schemes/{scheme_id}/eligibility [get]
+func (h *SchemeHandler) CheckApplicantEligibility(ctx *gin.Context) {
+	var req SchemeRequestUri
+
+	err := ctx.ShouldBindUri(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	schemeID, err := uuid.Parse(req.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidSchemeError)
+		return
+	}
+
+	applicantID, err := uuid.Parse(ctx.Query("applicant"))
+	if err != nil {
+		handleError(ctx, domain.InvalidApplicantError)
+		return
+	}
+
+	asOf, ok := parseAsOf(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := h.s.CheckApplicantEligibility(ctx, schemeID, applicantID, asOf)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newEligibilityResponse(*result)
+	handleSuccess(ctx, http.StatusOK, "Successfully checked eligibility.", rsp)
+}
+
+// parseAsOf parses the optional as_of query parameter, defaulting to the current time if it is not provided.
+// An error response is sent and false is returned if the date is invalid.
+func parseAsOf(ctx *gin.Context) (time.Time, bool) {
+	asOfStr, exists := ctx.GetQuery("as_of")
+	if !exists {
+		return time.Now(), true
+	}
+
+	asOf, err := util.ParseDate(asOfStr)
+	if err != nil {
+		handleError(ctx, domain.InvalidAsOfDateError)
+		return time.Time{}, false
+	}
+
+	return asOf, true
+}
+
 // CreateScheme godoc
 // @Summary	  Create a new scheme
-// @Description  Add a new scheme with the provided details.
+// @Description  Add a new scheme with the provided details. Benefits, criteria and criteria groups given with the scheme are created with it in one transaction.
 // @Tags		 schemes
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		CreateSchemeRequest  body	  CreateSchemeRequest  true  "JSON object containing new scheme details"
 // @Success	  201  {object}  SchemeResponse  "Successfully created scheme"
 // @Failure	  400  {object}  ErrorResponse		  "Validation error occurred"
@@ -133,8 +239,18 @@ func (h *SchemeHandler) CreateScheme(ctx *gin.Context) {
 		return
 	}
 
+	benefits, err := newSchemeBenefits(req.Benefits)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	scheme := domain.Scheme{
-		Name: &req.Name,
+		Name:                &req.Name,
+		ReapplyCooldownDays: req.ReapplyCooldownDays,
+		Benefits:            benefits,
+		Criteria:            newSchemeCriteriaList(nil, req.Criteria),
+		CriteriaGroups:      newSchemeCriteriaGroups(nil, req.CriteriaGroups),
 	}
 
 	newScheme, err = h.s.CreateScheme(ctx, &scheme)
@@ -152,10 +268,11 @@ func (h *SchemeHandler) CreateScheme(ctx *gin.Context) {
 
 // UpdateScheme godoc
 // @Summary	  Update an existing scheme
-// @Description  Modify the details of an existing scheme using its unique identifier.
+// @Description  Modify the details of an existing scheme using its unique identifier. Benefits, criteria and criteria groups replace those of the scheme in one transaction if they are given: benefits with an ID are updated, benefits without one are added and the others are deleted.
 // @Tags		  schemes
 // @Accept		  json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		  scheme_id	   path	string				   true  "Scheme ID" format(uuid)
 // @Param		  body	 body	UpdateSchemeRequest	  true  "JSON object with updates to the scheme"
 // @Success	  200	  {object} SchemeResponse	"Successfully updated scheme"
@@ -191,9 +308,19 @@ func (h *SchemeHandler) UpdateScheme(ctx *gin.Context) {
 		return
 	}
 
+	benefits, err := newUpdatedSchemeBenefits(req.Benefits)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	newSchemeValues := domain.Scheme{
-		ID:   &id,
-		Name: req.Name,
+		ID:                  &id,
+		Name:                req.Name,
+		ReapplyCooldownDays: req.ReapplyCooldownDays,
+		Benefits:            benefits,
+		Criteria:            newSchemeCriteriaList(&id, req.Criteria),
+		CriteriaGroups:      newSchemeCriteriaGroups(&id, req.CriteriaGroups),
 	}
 
 	updatedScheme, err := h.s.UpdateScheme(ctx, &newSchemeValues)
@@ -214,6 +341,7 @@ func (h *SchemeHandler) UpdateScheme(ctx *gin.Context) {
 // @Tags		 schemes
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		scheme_id  path  string  true  "Scheme ID" format(uuid)
 // @Success	  200  {object}  Response  "Successfully deleted scheme"
 // @Failure	  400  {object}  ErrorResponse	"Validation error occurred"
@@ -252,6 +380,7 @@ func (h *SchemeHandler) DeleteScheme(ctx *gin.Context) {
 // @Tags		  schemes
 // @Accept		  json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		  scheme_id  path	string					true  "Scheme ID" format(uuid)
 // @Param		  AddSchemeBenefitRequest	   body	AddSchemeBenefitRequest  true  "JSON object with benefit details"
 // @Success	  201	   {object}  SchemeBenefitResponse  "Successfully added benefit to scheme"
@@ -281,10 +410,25 @@ func (h *SchemeHandler) AddSchemeBenefit(ctx *gin.Context) {
 		handleError(ctx, domain.InvalidSchemeError)
 	}
 
+	amount, err := parseAmount(&req.Amount, req.Currency)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	benefitCap, err := parseAmount(req.Cap, req.Currency)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	newBenefit := domain.Benefit{
-		Name:     &req.Name,
-		Amount:   &req.Amount,
-		SchemeID: &schemeID,
+		Name:           &req.Name,
+		Amount:         amount,
+		Frequency:      req.Frequency,
+		DurationMonths: req.DurationMonths,
+		Cap:            benefitCap,
+		SchemeID:       &schemeID,
 	}
 
 	benefit, err := h.s.AddSchemeBenefit(ctx, &newBenefit)
@@ -303,6 +447,7 @@ func (h *SchemeHandler) AddSchemeBenefit(ctx *gin.Context) {
 // @Tags		 schemes
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		benefit_id		 path	  string				  true  "Benefit ID" format(uuid)
 // @Param		UpdateSchemeBenefitRequest body UpdateSchemeBenefitRequest true "JSON object with updated benefit details"
 // @Success	  200		{object}  SchemeBenefitResponse   "Successfully updated benefit"
@@ -340,11 +485,26 @@ func (h *SchemeHandler) UpdateSchemeBenefit(ctx *gin.Context) {
 		handleError(ctx, domain.InvalidSchemeError)
 	}
 
+	amount, err := parseAmount(req.Amount, req.Currency)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	benefitCap, err := parseAmount(req.Cap, req.Currency)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	newBenefit := domain.Benefit{
-		ID:       &id,
-		Name:     req.Name,
-		Amount:   req.Amount,
-		SchemeID: &schemeID,
+		ID:             &id,
+		Name:           req.Name,
+		Amount:         amount,
+		Frequency:      req.Frequency,
+		DurationMonths: req.DurationMonths,
+		Cap:            benefitCap,
+		SchemeID:       &schemeID,
 	}
 
 	benefit, err = h.s.UpdateSchemeBenefit(ctx, &newBenefit)
@@ -364,6 +524,7 @@ func (h *SchemeHandler) UpdateSchemeBenefit(ctx *gin.Context) {
 // @Tags		 schemes
 // @Accept	   json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		benefit_id  path  string  true  "Benefit ID" format(uuid)
 // @Success	  200  {object}  Response  "Successfully deleted benefit"
 // @Failure	  400  {object}  ErrorResponse "Validation error occurred"
@@ -401,6 +562,7 @@ func (h *SchemeHandler) DeleteSchemeBenefit(ctx *gin.Context) {
 // @Tags		  schemes
 // @Accept		  json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		  scheme_id  path	string					true  "Scheme ID" format(uuid)
 // @Param		  AddSchemeCriteriaRequest	   body	AddSchemeCriteriaRequest  true  "JSON object with criteria details"
 // @Success	  201	   {object}  SchemeCriteriaResponse  "Successfully added criteria to scheme"
@@ -452,6 +614,7 @@ func (h *SchemeHandler) AddSchemeCriteria(ctx *gin.Context) {
 // @Tags		  schemes
 // @Accept		  json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		  scheme_criteria_id			path	string					true  "Scheme Criteria ID" format(uuid)
 // @Param		  UpdateSchemeCriteriaRequest	body	UpdateSchemeCriteriaRequest	true	"JSON object with updated criteria details"
 // @Success	  200		{object}  SchemeCriteriaResponse  "Successfully updated criteria"
@@ -509,6 +672,7 @@ func (h *SchemeHandler) UpdateSchemeCriteria(ctx *gin.Context) {
 // @Tags		  schemes
 // @Accept		  json
 // @Produce	  json
+// @Security	 BearerAuth
 // @Param		  scheme_criteria_id  path  string  true  "Criteria ID" format(uuid)
 // @Success	  200  {object}  Response  "Successfully deleted criteria"
 // @Failure	  400  {object}  ErrorResponse "Validation error occurred"
@@ -538,3 +702,454 @@ func (h *SchemeHandler) DeleteSchemeCriteria(ctx *gin.Context) {
 
 	handleSuccess(ctx, http.StatusOK, "Successfully deleted criteria.", nil)
 }
+
+// ListBenefitCriteria godoc
+// @Summary	  List the criteria of a benefit
+// @Description  Retrieve all criteria of a benefit by specifying the benefit ID.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  benefit_id  path	string  true  "Benefit ID" format(uuid)
+// @Success	  200	   {object}  BenefitCriteriaListResponses  "Successfully retrieved benefit criteria"
+// @Failure	  400	   {object}  ErrorResponse				 "Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse				 "Benefit not found"
+// @Failure	  500	   {object}  ErrorResponse				 "Internal server error"
+// @Router		  /schemes/benefits/{benefit_id}/criteria [get]
+func (h *SchemeHandler) ListBenefitCriteria(ctx *gin.Context) {
+	var req BenefitRequestUri
+
+	err := ctx.ShouldBindUri(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	benefitID, err := uuid.Parse(req.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidBenefitError)
+		return
+	}
+
+	criteria, err := h.s.ListBenefitCriteria(ctx, benefitID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newBenefitCriteriaListResponses(criteria)
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved benefit criteria.", rsp)
+}
+
+// AddBenefitCriteria godoc
+// @Summary	  Add a criteria to a benefit
+// @Description  Add a new criteria to an existing benefit by specifying the benefit ID.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  benefit_id  path	string					 true  "Benefit ID" format(uuid)
+// @Param		  AddBenefitCriteriaRequest	   body	AddBenefitCriteriaRequest  true  "JSON object with criteria details"
+// @Success	  201	   {object}  BenefitCriteriaResponse  "Successfully added criteria to benefit"
+// @Failure	  400	   {object}  ErrorResponse			   "Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse			   "Benefit not found"
+// @Failure	  500	   {object}  ErrorResponse			   "Internal server error"
+// @Router		  /schemes/benefits/{benefit_id}/criteria [post]
+func (h *SchemeHandler) AddBenefitCriteria(ctx *gin.Context) {
+	var reqUri BenefitRequestUri
+	var req AddBenefitCriteriaRequest
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	benefitID, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidBenefitError)
+		return
+	}
+
+	newCriteria := domain.BenefitCriteria{
+		Name:      &req.Name,
+		Value:     &req.Value,
+		BenefitID: &benefitID,
+	}
+
+	criteria, err := h.s.AddBenefitCriteria(ctx, &newCriteria)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newBenefitCriteriaResponse(*criteria)
+	handleSuccess(ctx, http.StatusCreated, "Successfully added criteria to benefit.", rsp)
+}
+
+// UpdateBenefitCriteria godoc
+// @Summary	  Update a criteria of a benefit
+// @Description  Modify an existing criteria of a benefit by specifying the benefit ID and criteria ID.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  benefit_id					 path	string						 true  "Benefit ID" format(uuid)
+// @Param		  benefit_criteria_id			path	string						 true  "Benefit Criteria ID" format(uuid)
+// @Param		  UpdateBenefitCriteriaRequest	body	UpdateBenefitCriteriaRequest	true	"JSON object with updated criteria details"
+// @Success	  200		{object}  BenefitCriteriaResponse  "Successfully updated criteria"
+// @Failure	  400		{object}  ErrorResponse			"Validation error occurred"
+// @Failure	  404		{object}  ErrorResponse			"Criteria or Benefit not found"
+// @Failure	  500		{object}  ErrorResponse			"Internal server error"
+// @Router		  /schemes/benefits/{benefit_id}/criteria/{benefit_criteria_id} [put]
+func (h *SchemeHandler) UpdateBenefitCriteria(ctx *gin.Context) {
+	var reqUri BenefitCriteriaRequestUri
+	var req UpdateBenefitCriteriaRequest
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	benefitID, err := uuid.Parse(reqUri.BenefitID)
+	if err != nil {
+		handleError(ctx, domain.InvalidBenefitError)
+		return
+	}
+
+	id, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidBenefitCriteriaError)
+		return
+	}
+
+	newCriteria := domain.BenefitCriteria{
+		ID:        &id,
+		Name:      req.Name,
+		Value:     req.Value,
+		BenefitID: &benefitID,
+	}
+
+	updatedCriteria, err := h.s.UpdateBenefitCriteria(ctx, &newCriteria)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newBenefitCriteriaResponse(*updatedCriteria)
+	handleSuccess(ctx, http.StatusOK, "Successfully updated criteria.", rsp)
+}
+
+// DeleteBenefitCriteria godoc
+// @Summary	  Delete a criteria from a benefit
+// @Description  Remove a criteria from a benefit using its unique identifier.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  benefit_id		   path  string  true  "Benefit ID" format(uuid)
+// @Param		  benefit_criteria_id  path  string  true  "Benefit Criteria ID" format(uuid)
+// @Success	  200  {object}  Response  "Successfully deleted criteria"
+// @Failure	  400  {object}  ErrorResponse "Validation error occurred"
+// @Failure	  404  {object}  ErrorResponse "Criteria not found"
+// @Failure	  500  {object}  ErrorResponse "Internal server error"
+// @Router		  /schemes/benefits/{benefit_id}/criteria/{benefit_criteria_id} [delete]
+func (h *SchemeHandler) DeleteBenefitCriteria(ctx *gin.Context) {
+	var req BenefitCriteriaRequestUri
+
+	err := ctx.ShouldBindUri(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	benefitID, err := uuid.Parse(req.BenefitID)
+	if err != nil {
+		handleError(ctx, domain.InvalidBenefitError)
+		return
+	}
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidBenefitCriteriaError)
+		return
+	}
+
+	err = h.s.DeleteBenefitCriteria(ctx, benefitID, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, http.StatusOK, "Successfully deleted criteria.", nil)
+}
+
+// AddSchemeCriteriaGroup godoc
+// @Summary	  Add a criteria group to a scheme
+// @Description  Add a criteria group, together with its criteria and nested groups, to an existing scheme.
+// @Description  An applicant must meet every criteria and every criteria group of a scheme to be eligible.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  scheme_id  path	string						 true  "Scheme ID" format(uuid)
+// @Param		  AddSchemeCriteriaGroupRequest	   body	AddSchemeCriteriaGroupRequest  true  "JSON object with the criteria group tree"
+// @Success	  201	   {object}  SchemeCriteriaGroupResponse  "Successfully added criteria group to scheme"
+// @Failure	  400	   {object}  ErrorResponse				   "Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse				   "Scheme not found"
+// @Failure	  500	   {object}  ErrorResponse				   "Internal server error"
+// @Router		  /schemes/{scheme_id}/criteria-groups [post]
+func (h *SchemeHandler) AddSchemeCriteriaGroup(ctx *gin.Context) {
+	var reqUri SchemeRequestUri
+	var req AddSchemeCriteriaGroupRequest
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	schemeID, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidSchemeError)
+		return
+	}
+
+	newGroup := newSchemeCriteriaGroup(&schemeID, req)
+
+	group, err := h.s.AddSchemeCriteriaGroup(ctx, &newGroup)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newSchemeCriteriaGroupResponse(*group)
+	handleSuccess(ctx, http.StatusCreated, "Successfully added criteria group to scheme.", rsp)
+}
+
+// DeleteSchemeCriteriaGroup godoc
+// @Summary	  Delete a criteria group from a scheme
+// @Description  Remove a top-level criteria group, together with its criteria and nested groups, from a scheme.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  criteria_group_id  path  string  true  "Criteria Group ID" format(uuid)
+// @Success	  200  {object}  Response  "Successfully deleted criteria group"
+// @Failure	  400  {object}  ErrorResponse "Validation error occurred"
+// @Failure	  404  {object}  ErrorResponse "Criteria group not found"
+// @Failure	  500  {object}  ErrorResponse "Internal server error"
+// @Router		  /schemes/criteria-groups/{criteria_group_id} [delete]
+func (h *SchemeHandler) DeleteSchemeCriteriaGroup(ctx *gin.Context) {
+	var req SchemeCriteriaGroupRequestUri
+
+	err := ctx.ShouldBindUri(&req)
+	if err != nil {
+		handleError(ctx, domain.InvalidCriteriaGroupError)
+		return
+	}
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidCriteriaGroupError)
+		return
+	}
+
+	err = h.s.DeleteSchemeCriteriaGroup(ctx, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, http.StatusOK, "Successfully deleted criteria group.", nil)
+}
+
+// newSchemeCriteriaGroup converts a criteria group request, including its nested groups, to a scheme criteria group.
+// The scheme ID is nil if the scheme has not been created yet.
+func newSchemeCriteriaGroup(schemeID *uuid.UUID, req AddSchemeCriteriaGroupRequest) domain.SchemeCriteriaGroup {
+	operator := domain.CriteriaGroupOperator(req.Operator)
+	criteria := make([]domain.SchemeCriteria, len(req.Criteria))
+	groups := make([]domain.SchemeCriteriaGroup, len(req.Groups))
+
+	for i, c := range req.Criteria {
+		criteria[i] = domain.SchemeCriteria{
+			Name:     &c.Name,
+			Value:    &c.Value,
+			SchemeID: schemeID,
+		}
+	}
+
+	for i, g := range req.Groups {
+		groups[i] = newSchemeCriteriaGroup(schemeID, g)
+	}
+
+	return domain.SchemeCriteriaGroup{
+		SchemeID: schemeID,
+		Operator: &operator,
+		Criteria: &criteria,
+		Groups:   &groups,
+	}
+}
+
+// newSchemeCriteriaGroups converts the criteria group requests given with a scheme, or returns nil if none were given.
+func newSchemeCriteriaGroups(schemeID *uuid.UUID, reqs []AddSchemeCriteriaGroupRequest) *[]domain.SchemeCriteriaGroup {
+	if reqs == nil {
+		return nil
+	}
+
+	groups := make([]domain.SchemeCriteriaGroup, len(reqs))
+	for i, g := range reqs {
+		groups[i] = newSchemeCriteriaGroup(schemeID, g)
+	}
+
+	return &groups
+}
+
+// newSchemeCriteriaList converts the criteria requests given with a scheme, or returns nil if none were given.
+func newSchemeCriteriaList(schemeID *uuid.UUID, reqs []AddSchemeCriteriaRequest) *[]domain.SchemeCriteria {
+	if reqs == nil {
+		return nil
+	}
+
+	criteria := make([]domain.SchemeCriteria, len(reqs))
+	for i, c := range reqs {
+		criteria[i] = domain.SchemeCriteria{
+			Name:     &c.Name,
+			Value:    &c.Value,
+			SchemeID: schemeID,
+		}
+	}
+
+	return &criteria
+}
+
+// newBenefitCriteriaList converts the criteria requests given with a benefit, or returns nil if none were given.
+func newBenefitCriteriaList(reqs []AddBenefitCriteriaRequest) *[]domain.BenefitCriteria {
+	if reqs == nil {
+		return nil
+	}
+
+	criteria := make([]domain.BenefitCriteria, len(reqs))
+	for i, c := range reqs {
+		criteria[i] = domain.BenefitCriteria{
+			Name:  &c.Name,
+			Value: &c.Value,
+		}
+	}
+
+	return &criteria
+}
+
+// parseAmount converts an amount given in a request into money in the given currency, or returns nil if none was given.
+// Amounts without a currency take the currency of their benefit. Returns domain.InvalidAmountError if the amount has
+// more than two decimal places or is negative.
+func parseAmount(amount *json.Number, currency *domain.Currency) (*domain.Money, error) {
+	if amount == nil {
+		return nil, nil
+	}
+
+	var c domain.Currency
+	if currency != nil {
+		c = *currency
+	}
+
+	money, err := domain.ParseMoney(amount.String(), c)
+	if err != nil {
+		return nil, err
+	}
+
+	if money.IsNegative() {
+		return nil, domain.InvalidAmountError
+	}
+
+	return &money, nil
+}
+
+// newSchemeBenefits converts the benefit requests given when creating a scheme, or returns nil if none were given.
+func newSchemeBenefits(reqs []SchemeBenefitRequest) (*[]domain.Benefit, error) {
+	if reqs == nil {
+		return nil, nil
+	}
+
+	benefits := make([]domain.Benefit, len(reqs))
+	for i, b := range reqs {
+		amount, err := parseAmount(&b.Amount, b.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		benefitCap, err := parseAmount(b.Cap, b.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		benefits[i] = domain.Benefit{
+			Name:           &b.Name,
+			Amount:         amount,
+			Frequency:      b.Frequency,
+			DurationMonths: b.DurationMonths,
+			Cap:            benefitCap,
+			Criteria:       newBenefitCriteriaList(b.Criteria),
+		}
+	}
+
+	return &benefits, nil
+}
+
+// newUpdatedSchemeBenefits converts the benefit requests given when updating a scheme, or returns nil if none were given.
+func newUpdatedSchemeBenefits(reqs []UpdateSchemeBenefitsRequest) (*[]domain.Benefit, error) {
+	if reqs == nil {
+		return nil, nil
+	}
+
+	benefits := make([]domain.Benefit, len(reqs))
+	for i, b := range reqs {
+		amount, err := parseAmount(&b.Amount, b.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		benefitCap, err := parseAmount(b.Cap, b.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		benefits[i] = domain.Benefit{
+			Name:           &b.Name,
+			Amount:         amount,
+			Frequency:      b.Frequency,
+			DurationMonths: b.DurationMonths,
+			Cap:            benefitCap,
+			Criteria:       newBenefitCriteriaList(b.Criteria),
+		}
+
+		if b.ID != nil {
+			id, err := uuid.Parse(*b.ID)
+			if err != nil {
+				return nil, domain.InvalidBenefitError
+			}
+			benefits[i].ID = &id
+		}
+	}
+
+	return &benefits, nil
+}
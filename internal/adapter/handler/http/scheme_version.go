@@ -0,0 +1,252 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+// ListSchemeVersions godoc
+// @Summary	  List the versions of a scheme
+// @Description  Retrieve every version of a scheme, latest first. Published and retired versions include the benefits and criteria they were published with.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  scheme_id  path	string  true  "Scheme ID" format(uuid)
+// @Success	  200	   {object}  SchemeVersionsResponse  "Successfully retrieved scheme versions"
+// @Failure	  400	   {object}  ErrorResponse		   "Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse		   "Scheme not found"
+// @Failure	  500	   {object}  ErrorResponse		   "Internal server error"
+// @Router		  /schemes/{scheme_id}/versions [get]
+func (h *SchemeHandler) ListSchemeVersions(ctx *gin.Context) {
+	var req SchemeRequestUri
+
+	err := ctx.ShouldBindUri(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	schemeID, err := uuid.Parse(req.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidSchemeError)
+		return
+	}
+
+	versions, err := h.s.ListSchemeVersions(ctx, schemeID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newSchemeVersionsResponse(versions)
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved scheme versions.", rsp)
+}
+
+// GetSchemeVersion godoc
+// @Summary	  Get a scheme version
+// @Description  Retrieve a version of a scheme by its ID.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  version_id  path	string  true  "Scheme Version ID" format(uuid)
+// @Success	  200	   {object}  SchemeVersionResponse  "Successfully retrieved scheme version"
+// @Failure	  400	   {object}  ErrorResponse		  "Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse		  "Scheme version not found"
+// @Failure	  500	   {object}  ErrorResponse		  "Internal server error"
+// @Router		  /schemes/versions/{version_id} [get]
+func (h *SchemeHandler) GetSchemeVersion(ctx *gin.Context) {
+	id, ok := parseSchemeVersionID(ctx)
+	if !ok {
+		return
+	}
+
+	version, err := h.s.GetSchemeVersionByID(ctx, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newSchemeVersionResponse(*version)
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved scheme version.", rsp)
+}
+
+// CreateSchemeVersion godoc
+// @Summary	  Create a draft version of a scheme
+// @Description  Create a draft version of a scheme, in effect from effective_from until the day before effective_to. The benefits and criteria of the scheme are copied into the version when it is published. A scheme can only have one draft at a time.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  scheme_id  path	string					  true  "Scheme ID" format(uuid)
+// @Param		  CreateSchemeVersionRequest	   body	CreateSchemeVersionRequest  true  "JSON object with the effective dates of the version"
+// @Success	  201	   {object}  SchemeVersionResponse  "Successfully created scheme version"
+// @Failure	  400	   {object}  ErrorResponse		  "Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse		  "Scheme not found"
+// @Failure	  409	   {object}  ErrorResponse		  "Scheme already has a draft version"
+// @Failure	  500	   {object}  ErrorResponse		  "Internal server error"
+// @Router		  /schemes/{scheme_id}/versions [post]
+func (h *SchemeHandler) CreateSchemeVersion(ctx *gin.Context) {
+	var reqUri SchemeRequestUri
+	var req CreateSchemeVersionRequest
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	schemeID, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidSchemeError)
+		return
+	}
+
+	effectiveFrom, err := util.ParseDate(req.EffectiveFrom)
+	if err != nil {
+		handleError(ctx, domain.InvalidEffectiveDatesError)
+		return
+	}
+
+	newVersion := domain.SchemeVersion{
+		SchemeID:      &schemeID,
+		EffectiveFrom: &effectiveFrom,
+	}
+
+	if req.EffectiveTo != nil {
+		effectiveTo, err := util.ParseDate(*req.EffectiveTo)
+		if err != nil {
+			handleError(ctx, domain.InvalidEffectiveDatesError)
+			return
+		}
+		newVersion.EffectiveTo = &effectiveTo
+	}
+
+	version, err := h.s.CreateSchemeVersion(ctx, &newVersion)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newSchemeVersionResponse(*version)
+	handleSuccess(ctx, http.StatusCreated, "Successfully created scheme version.", rsp)
+}
+
+// PublishSchemeVersion godoc
+// @Summary	  Publish a scheme version
+// @Description  Publish a draft version, freezing the current benefits and criteria of the scheme into it. Published versions cannot be changed. A published version of the scheme that is in effect indefinitely from an earlier date ends when the new version comes into effect.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  version_id  path	string  true  "Scheme Version ID" format(uuid)
+// @Success	  200	   {object}  SchemeVersionResponse  "Successfully published scheme version"
+// @Failure	  400	   {object}  ErrorResponse		  "Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse		  "Scheme version not found"
+// @Failure	  409	   {object}  ErrorResponse		  "Scheme version is not a draft or overlaps a published version"
+// @Failure	  500	   {object}  ErrorResponse		  "Internal server error"
+// @Router		  /schemes/versions/{version_id}/publish [post]
+func (h *SchemeHandler) PublishSchemeVersion(ctx *gin.Context) {
+	id, ok := parseSchemeVersionID(ctx)
+	if !ok {
+		return
+	}
+
+	version, err := h.s.PublishSchemeVersion(ctx, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newSchemeVersionResponse(*version)
+	handleSuccess(ctx, http.StatusOK, "Successfully published scheme version.", rsp)
+}
+
+// RetireSchemeVersion godoc
+// @Summary	  Retire a scheme version
+// @Description  Retire a published version so that it is no longer in effect. Applications keep the version they were assessed against.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  version_id  path	string  true  "Scheme Version ID" format(uuid)
+// @Success	  200	   {object}  SchemeVersionResponse  "Successfully retired scheme version"
+// @Failure	  400	   {object}  ErrorResponse		  "Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse		  "Scheme version not found"
+// @Failure	  409	   {object}  ErrorResponse		  "Scheme version is not published"
+// @Failure	  500	   {object}  ErrorResponse		  "Internal server error"
+// @Router		  /schemes/versions/{version_id}/retire [post]
+func (h *SchemeHandler) RetireSchemeVersion(ctx *gin.Context) {
+	id, ok := parseSchemeVersionID(ctx)
+	if !ok {
+		return
+	}
+
+	version, err := h.s.RetireSchemeVersion(ctx, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newSchemeVersionResponse(*version)
+	handleSuccess(ctx, http.StatusOK, "Successfully retired scheme version.", rsp)
+}
+
+// DeleteSchemeVersion godoc
+// @Summary	  Delete a draft scheme version
+// @Description  Delete a draft version of a scheme. Published and retired versions cannot be deleted.
+// @Tags		  schemes
+// @Accept		  json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		  version_id  path	string  true  "Scheme Version ID" format(uuid)
+// @Success	  200	   {object}  Response	   "Successfully deleted scheme version"
+// @Failure	  400	   {object}  ErrorResponse  "Validation error occurred"
+// @Failure	  404	   {object}  ErrorResponse  "Scheme version not found"
+// @Failure	  409	   {object}  ErrorResponse  "Scheme version is not a draft"
+// @Failure	  500	   {object}  ErrorResponse  "Internal server error"
+// @Router		  /schemes/versions/{version_id} [delete]
+func (h *SchemeHandler) DeleteSchemeVersion(ctx *gin.Context) {
+	id, ok := parseSchemeVersionID(ctx)
+	if !ok {
+		return
+	}
+
+	err := h.s.DeleteSchemeVersion(ctx, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, http.StatusOK, "Successfully deleted scheme version.", nil)
+}
+
+// parseSchemeVersionID binds and parses the scheme version ID in the request URI.
+// An error response is sent and false is returned if the ID is invalid.
+func parseSchemeVersionID(ctx *gin.Context) (uuid.UUID, bool) {
+	var req SchemeVersionRequestUri
+
+	err := ctx.ShouldBindUri(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidSchemeVersionError)
+		return uuid.UUID{}, false
+	}
+
+	return id, true
+}
@@ -0,0 +1,217 @@
+package http
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+// UserHandler provides HTTP handler methods for managing users and their roles using a UserService.
+type UserHandler struct {
+	s port.UserService
+}
+
+// NewUserHandler initializes a new UserHandler with the provided UserService.
+func NewUserHandler(s port.UserService) *UserHandler {
+	return &UserHandler{s: s}
+}
+
+// ListUsers godoc
+// @Summary	  List All Users
+// @Description  Retrieves every user of the system and their roles.
+// @Tags		 Users
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Success	  200  {object}  UsersResponse  "Successfully retrieved users."
+// @Failure	  401  {object}  ErrorResponse  "Unauthorized"
+// @Failure	  403  {object}  ErrorResponse  "Forbidden"
+// @Failure	  500  {object}  ErrorResponse  "Internal Server Error"
+// @Router	   /users [get]
+func (h *UserHandler) ListUsers(ctx *gin.Context) {
+	users, err := h.s.ListUsers(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newUsersResponse(users)
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved users.", rsp)
+}
+
+// GetUser godoc
+// @Summary	  Retrieve User by ID
+// @Description  Retrieves the details of a single user using their unique identifier.
+// @Tags		 Users
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		id   path	  string  true  "User ID"
+// @Success	  200  {object}  UserResponse   "Successfully retrieved user."
+// @Failure	  400  {object}  ErrorResponse  "Bad Request"
+// @Failure	  401  {object}  ErrorResponse  "Unauthorized"
+// @Failure	  403  {object}  ErrorResponse  "Forbidden"
+// @Failure	  404  {object}  ErrorResponse  "User Not Found"
+// @Failure	  500  {object}  ErrorResponse  "Internal Server Error"
+// @Router	   /users/{id} [get]
+func (h *UserHandler) GetUser(ctx *gin.Context) {
+	var req UserRequestUri
+
+	err := ctx.ShouldBindUri(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidUserError)
+		return
+	}
+
+	user, err := h.s.GetUserByID(ctx, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newUserResponse(*user)
+	handleSuccess(ctx, http.StatusOK, "Successfully retrieved user.", rsp)
+}
+
+// CreateUser godoc
+// @Summary	  Create a new User
+// @Description  Creates a user who can sign in with the given email and password. The role decides which routes the user may call.
+// @Tags		 Users
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		CreateUserRequest  body	  CreateUserRequest  true  "Payload for creating a user"
+// @Success	  201  {object}  UserResponse   "Successfully created user."
+// @Failure	  400  {object}  ErrorResponse  "Bad Request"
+// @Failure	  401  {object}  ErrorResponse  "Unauthorized"
+// @Failure	  403  {object}  ErrorResponse  "Forbidden"
+// @Failure	  409  {object}  ErrorResponse  "Email Already Used"
+// @Failure	  500  {object}  ErrorResponse  "Internal Server Error"
+// @Router	   /users [post]
+func (h *UserHandler) CreateUser(ctx *gin.Context) {
+	var req CreateUserRequest
+
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	user := domain.User{
+		Email: &req.Email,
+		Name:  &req.Name,
+		Role:  &req.Role,
+	}
+
+	newUser, err := h.s.CreateUser(ctx, &user, req.Password)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newUserResponse(*newUser)
+	handleSuccess(ctx, http.StatusCreated, "Successfully created user.", rsp)
+}
+
+// UpdateUser godoc
+// @Summary	  Update a User
+// @Description  Updates the details, role or password of a user. Only the fields given are changed.
+// @Tags		 Users
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		id				 path	  string			 true  "User ID"
+// @Param		UpdateUserRequest  body	  UpdateUserRequest  true  "Payload for updating a user"
+// @Success	  200  {object}  UserResponse   "Successfully updated user."
+// @Failure	  400  {object}  ErrorResponse  "Bad Request"
+// @Failure	  401  {object}  ErrorResponse  "Unauthorized"
+// @Failure	  403  {object}  ErrorResponse  "Forbidden"
+// @Failure	  404  {object}  ErrorResponse  "User Not Found"
+// @Failure	  409  {object}  ErrorResponse  "Email Already Used"
+// @Failure	  500  {object}  ErrorResponse  "Internal Server Error"
+// @Router	   /users/{id} [put]
+func (h *UserHandler) UpdateUser(ctx *gin.Context) {
+	var reqUri UserRequestUri
+	var req UpdateUserRequest
+
+	err := ctx.ShouldBindUri(&reqUri)
+	if err != nil {
+		validationError(ctx, err, reqUri)
+		return
+	}
+
+	err = ctx.ShouldBindJSON(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	id, err := uuid.Parse(reqUri.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidUserError)
+		return
+	}
+
+	user := domain.User{
+		ID:    &id,
+		Email: req.Email,
+		Name:  req.Name,
+		Role:  req.Role,
+	}
+
+	updatedUser, err := h.s.UpdateUser(ctx, &user, req.Password)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newUserResponse(*updatedUser)
+	handleSuccess(ctx, http.StatusOK, "Successfully updated user.", rsp)
+}
+
+// DeleteUser godoc
+// @Summary	  Delete a User
+// @Description  Deletes a user so that they can no longer sign in.
+// @Tags		 Users
+// @Accept	   json
+// @Produce	  json
+// @Security	 BearerAuth
+// @Param		id   path	  string  true  "User ID"
+// @Success	  200  {object}  Response	   "Successfully deleted user."
+// @Failure	  400  {object}  ErrorResponse  "Bad Request"
+// @Failure	  401  {object}  ErrorResponse  "Unauthorized"
+// @Failure	  403  {object}  ErrorResponse  "Forbidden"
+// @Failure	  404  {object}  ErrorResponse  "User Not Found"
+// @Failure	  500  {object}  ErrorResponse  "Internal Server Error"
+// @Router	   /users/{id} [delete]
+func (h *UserHandler) DeleteUser(ctx *gin.Context) {
+	var req UserRequestUri
+
+	err := ctx.ShouldBindUri(&req)
+	if err != nil {
+		validationError(ctx, err, req)
+		return
+	}
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		handleError(ctx, domain.InvalidUserError)
+		return
+	}
+
+	err = h.s.DeleteUser(ctx, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, http.StatusOK, "Successfully deleted user.", nil)
+}
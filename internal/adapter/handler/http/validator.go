@@ -20,6 +20,12 @@ func msgForTag(tag string) string {
 		return "Invalid relationship type, must be either spouse, child, parent or sibling."
 	case "employment_status":
 		return "Invalid employment status, must be either employed or unemployed."
+	case "application_status":
+		return "Invalid application status, must be either submitted, under_review, approved, rejected, withdrawn or disbursed."
+	case "benefit_frequency":
+		return "Invalid benefit frequency, must be either one_off, monthly or quarterly."
+	case "currency":
+		return "Invalid currency, must be either SGD, USD, EUR, GBP or MYR."
 	default:
 		return "Invalid field input."
 	}
@@ -45,6 +51,36 @@ func validateEmploymentStatus(f1 validator.FieldLevel) bool {
 	return ok && status.IsValid()
 }
 
+func validateApplicationStatus(f1 validator.FieldLevel) bool {
+	status, ok := f1.Field().Interface().(domain.ApplicationStatus)
+	return ok && status.IsValid()
+}
+
+func validateBenefitFrequency(f1 validator.FieldLevel) bool {
+	frequency, ok := f1.Field().Interface().(domain.BenefitFrequency)
+	return ok && frequency.IsValid()
+}
+
+func validateCurrency(f1 validator.FieldLevel) bool {
+	currency, ok := f1.Field().Interface().(domain.Currency)
+	return ok && currency.IsValid()
+}
+
+func validateRole(f1 validator.FieldLevel) bool {
+	role, ok := f1.Field().Interface().(domain.Role)
+	return ok && role.IsValid()
+}
+
+func validateAuditEntityType(f1 validator.FieldLevel) bool {
+	entityType, ok := f1.Field().Interface().(domain.AuditEntityType)
+	return ok && entityType.IsValid()
+}
+
+func validateAuditAction(f1 validator.FieldLevel) bool {
+	action, ok := f1.Field().Interface().(domain.AuditAction)
+	return ok && action.IsValid()
+}
+
 func validateDate(f1 validator.FieldLevel) bool {
 	dateStr, ok := f1.Field().Interface().(string)
 	if !ok {
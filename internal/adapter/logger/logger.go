@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// New creates a structured logger that writes to stderr at the level and in the format in the config.
+// Records logged with a context include the ID of the request and the user making it.
+func New(config *config.Config) (*slog.Logger, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(config.LogLevel)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q, must be one of debug, info, warn or error", config.LogLevel)
+	}
+
+	options := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch strings.ToLower(config.LogFormat) {
+	case "json":
+		handler = slog.NewJSONHandler(os.Stderr, options)
+	case "text":
+		handler = slog.NewTextHandler(os.Stderr, options)
+	default:
+		return nil, fmt.Errorf("invalid log format %q, must be either json or text", config.LogFormat)
+	}
+
+	return slog.New(contextHandler{handler}), nil
+}
+
+// contextHandler is a slog.Handler that adds the request ID and the user carried by the context of a record to it
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID := domain.RequestIDFromContext(ctx); requestID != "" {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+
+	if actor := domain.ActorFromContext(ctx); actor != nil {
+		record.AddAttrs(slog.String("user_id", actor.UserID.String()))
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// namespace prefixes the name of every metric of the API
+const namespace = "fas"
+
+// Metrics collects the metrics of the API in a Prometheus registry, and records the domain events of the services.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequestDuration       *prometheus.HistogramVec
+	applicationsCreated       prometheus.Counter
+	eligibilityChecks         prometheus.Counter
+	ineligibleResults         *prometheus.CounterVec
+	criteriaEvaluationSeconds prometheus.Histogram
+}
+
+// New creates the metrics of the API in a new registry, together with the metrics of the Go runtime and the process.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Time taken to handle HTTP requests, by method, route and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+
+		applicationsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "applications_created_total",
+			Help:      "Number of applications created.",
+		}),
+
+		eligibilityChecks: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "eligibility_checks_total",
+			Help:      "Number of times an applicant was checked against the criteria of a scheme.",
+		}),
+
+		ineligibleResults: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "eligibility_ineligible_total",
+			Help:      "Number of eligibility checks that found the applicant ineligible for a scheme, by scheme.",
+		}, []string{"scheme_id"}),
+
+		// Criteria are evaluated in memory, so their buckets range from 10µs to about 160ms
+		criteriaEvaluationSeconds: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "criteria_evaluation_duration_seconds",
+			Help:      "Time taken to evaluate the criteria of a scheme for an applicant.",
+			Buckets:   prometheus.ExponentialBuckets(0.00001, 4, 8),
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequestDuration,
+		m.applicationsCreated,
+		m.eligibilityChecks,
+		m.ineligibleResults,
+		m.criteriaEvaluationSeconds,
+	)
+
+	return m
+}
+
+// Register adds a collector to the metrics, such as the statistics of the database connection pool.
+func (m *Metrics) Register(collector prometheus.Collector) error {
+	return m.registry.Register(collector)
+}
+
+// Handler returns an HTTP handler that serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveHTTPRequest records how long an HTTP request to the given route took to handle.
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	m.httpRequestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+// ApplicationCreated records that an application was created.
+func (m *Metrics) ApplicationCreated() {
+	m.applicationsCreated.Inc()
+}
+
+// EligibilityChecked records the outcome of checking an applicant against the criteria of a scheme,
+// and how long the criteria took to evaluate.
+func (m *Metrics) EligibilityChecked(schemeID uuid.UUID, eligible bool, duration time.Duration) {
+	m.eligibilityChecks.Inc()
+	if !eligible {
+		m.ineligibleResults.WithLabelValues(schemeID.String()).Inc()
+	}
+
+	m.criteriaEvaluationSeconds.Observe(duration.Seconds())
+}
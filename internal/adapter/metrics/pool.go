@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PoolStater is a connection pool that reports its statistics, such as postgres.DB
+type PoolStater interface {
+	Stat() *pgxpool.Stat
+}
+
+// poolCollector is a prometheus.Collector that exposes the statistics of a pgx connection pool when the metrics are scraped
+type poolCollector struct {
+	pool PoolStater
+
+	acquiredConns           *prometheus.Desc
+	idleConns               *prometheus.Desc
+	constructingConns       *prometheus.Desc
+	totalConns              *prometheus.Desc
+	maxConns                *prometheus.Desc
+	acquires                *prometheus.Desc
+	acquireDuration         *prometheus.Desc
+	emptyAcquires           *prometheus.Desc
+	canceledAcquires        *prometheus.Desc
+	newConns                *prometheus.Desc
+	maxLifetimeDestroyConns *prometheus.Desc
+	maxIdleDestroyConns     *prometheus.Desc
+}
+
+// NewPoolCollector creates a collector of the statistics of a pgx connection pool.
+func NewPoolCollector(pool PoolStater) prometheus.Collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	return &poolCollector{
+		pool: pool,
+
+		acquiredConns:           desc("acquired_connections", "Number of connections currently acquired from the pool."),
+		idleConns:               desc("idle_connections", "Number of idle connections in the pool."),
+		constructingConns:       desc("constructing_connections", "Number of connections being opened by the pool."),
+		totalConns:              desc("total_connections", "Number of connections in the pool."),
+		maxConns:                desc("max_connections", "Maximum number of connections in the pool."),
+		acquires:                desc("acquires_total", "Number of connections acquired from the pool."),
+		acquireDuration:         desc("acquire_duration_seconds_total", "Total time spent acquiring connections from the pool."),
+		emptyAcquires:           desc("empty_acquires_total", "Number of acquires that waited for a connection because the pool had none idle."),
+		canceledAcquires:        desc("canceled_acquires_total", "Number of acquires canceled by their context."),
+		newConns:                desc("new_connections_total", "Number of connections opened by the pool."),
+		maxLifetimeDestroyConns: desc("max_lifetime_destroyed_connections_total", "Number of connections closed for exceeding their maximum lifetime."),
+		maxIdleDestroyConns:     desc("max_idle_destroyed_connections_total", "Number of connections closed for being idle too long."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.constructingConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquires
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquires
+	ch <- c.canceledAcquires
+	ch <- c.newConns
+	ch <- c.maxLifetimeDestroyConns
+	ch <- c.maxIdleDestroyConns
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	gauge := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value)
+	}
+	counter := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value)
+	}
+
+	gauge(c.acquiredConns, float64(stat.AcquiredConns()))
+	gauge(c.idleConns, float64(stat.IdleConns()))
+	gauge(c.constructingConns, float64(stat.ConstructingConns()))
+	gauge(c.totalConns, float64(stat.TotalConns()))
+	gauge(c.maxConns, float64(stat.MaxConns()))
+	counter(c.acquires, float64(stat.AcquireCount()))
+	counter(c.acquireDuration, stat.AcquireDuration().Seconds())
+	counter(c.emptyAcquires, float64(stat.EmptyAcquireCount()))
+	counter(c.canceledAcquires, float64(stat.CanceledAcquireCount()))
+	counter(c.newConns, float64(stat.NewConnsCount()))
+	counter(c.maxLifetimeDestroyConns, float64(stat.MaxLifetimeDestroyCount()))
+	counter(c.maxIdleDestroyConns, float64(stat.MaxIdleDestroyCount()))
+}
@@ -0,0 +1,182 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"time"
+)
+
+// ApplicantRepository provides methods for interacting with the applicants kept in a Store.
+type ApplicantRepository struct {
+	store *Store
+}
+
+// NewApplicantRepository creates a new instance of ApplicantRepository using the provided store.
+func NewApplicantRepository(store *Store) *ApplicantRepository {
+	return &ApplicantRepository{store: store}
+}
+
+// applicantSortKeys maps the fields applicants can be sorted by to their values
+var applicantSortKeys = sortKeys[domain.Applicant]{
+	"name":          func(a domain.Applicant) any { return a.Name },
+	"date_of_birth": func(a domain.Applicant) any { return a.DateOfBirth },
+	"created_at":    func(a domain.Applicant) any { return a.CreatedAt },
+}
+
+// GetApplicantById retrieves an applicant by their unique identifier.
+func (r *ApplicantRepository) GetApplicantById(ctx context.Context, id uuid.UUID) (*domain.Applicant, error) {
+	defer r.store.lock(ctx)()
+
+	applicant, ok := r.store.data.Applicants[id]
+	if !ok {
+		return nil, domain.ApplicantNotFoundError
+	}
+
+	return ptr(clone(applicant)), nil
+}
+
+// GetApplicantFamily retrieves an applicant's family members by the applicant's ID.
+// Family members are grouped by relationship type, so an applicant can have several children, siblings, etc.
+func (r *ApplicantRepository) GetApplicantFamily(ctx context.Context, id uuid.UUID) (domain.Family, error) {
+	defer r.store.lock(ctx)()
+
+	family := make(domain.Family)
+	for _, relationship := range r.store.data.Relationships {
+		if *relationship.ApplicantAID != id {
+			continue
+		}
+
+		familyMember, ok := r.store.data.Applicants[*relationship.ApplicantBID]
+		if !ok {
+			continue
+		}
+
+		// Family members are returned without their own timestamps, as they are read through the relationship
+		familyMember = clone(familyMember)
+		familyMember.CreatedAt, familyMember.UpdatedAt = nil, nil
+
+		rt := *relationship.RelationshipType
+		family[rt] = append(family[rt], familyMember)
+	}
+
+	return family, nil
+}
+
+// ListApplicants retrieves a page of applicants matching the filter, along with the total number of matching applicants.
+func (r *ApplicantRepository) ListApplicants(ctx context.Context, filter domain.ApplicantFilter) ([]domain.Applicant, int, error) {
+	defer r.store.lock(ctx)()
+
+	// An applicant is at least N years old if they were born on or before today N years ago
+	today := time.Now()
+	var bornBy, bornAfter *time.Time
+	if filter.MinAge != nil {
+		bornBy = date(ptr(today.AddDate(-*filter.MinAge, 0, 0)))
+	}
+	if filter.MaxAge != nil {
+		bornAfter = date(ptr(today.AddDate(-*filter.MaxAge-1, 0, 0)))
+	}
+
+	applicants := make([]domain.Applicant, 0)
+	for _, a := range r.store.data.Applicants {
+		if filter.EmploymentStatus != nil && (a.EmploymentStatus == nil || *a.EmploymentStatus != *filter.EmploymentStatus) {
+			continue
+		}
+		if filter.MaritalStatus != nil && (a.MaritalStatus == nil || *a.MaritalStatus != *filter.MaritalStatus) {
+			continue
+		}
+		if bornBy != nil && (a.DateOfBirth == nil || a.DateOfBirth.After(*bornBy)) {
+			continue
+		}
+		if bornAfter != nil && (a.DateOfBirth == nil || !a.DateOfBirth.After(*bornAfter)) {
+			continue
+		}
+		applicants = append(applicants, clone(a))
+	}
+
+	return listPage(applicants, filter.ListOptions, applicantSortKeys, func(a domain.Applicant) uuid.UUID { return *a.ID })
+}
+
+// CreateApplicant adds a new applicant to the store and returns the created applicant.
+func (r *ApplicantRepository) CreateApplicant(ctx context.Context, applicant *domain.Applicant) (*domain.Applicant, error) {
+	defer r.store.lock(ctx)()
+
+	a := clone(*applicant)
+	a.ID, a.CreatedAt = newID()
+	a.UpdatedAt = a.CreatedAt
+	a.DateOfBirth = date(a.DateOfBirth)
+	a.Family = nil
+
+	r.store.data.Applicants[*a.ID] = a
+	r.store.record(ctx, domain.AuditEntityApplicant, *a.ID, domain.AuditActionCreate, nil, a)
+
+	return ptr(clone(a)), nil
+}
+
+// UpdateApplicant updates an existing applicant's details and returns the updated applicant or an error.
+func (r *ApplicantRepository) UpdateApplicant(ctx context.Context, applicant *domain.Applicant) (*domain.Applicant, error) {
+	if applicant == nil || applicant.ID == nil {
+		return nil, fmt.Errorf("applicant id cannot be nil")
+	}
+
+	if applicant.Name == nil && applicant.EmploymentStatus == nil && applicant.MaritalStatus == nil && applicant.Sex == nil &&
+		applicant.DateOfBirth == nil && applicant.MonthlyIncome == nil && applicant.Assets == nil && applicant.HasDisability == nil {
+		return nil, domain.NoUpdateFieldsError
+	}
+
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.Applicants[*applicant.ID]
+	if !ok {
+		return nil, domain.ApplicantNotFoundError
+	}
+
+	a := clone(before)
+	update := clone(*applicant)
+	if update.Name != nil {
+		a.Name = update.Name
+	}
+	if update.EmploymentStatus != nil {
+		a.EmploymentStatus = update.EmploymentStatus
+	}
+	if update.MaritalStatus != nil {
+		a.MaritalStatus = update.MaritalStatus
+	}
+	if update.Sex != nil {
+		a.Sex = update.Sex
+	}
+	if update.DateOfBirth != nil {
+		a.DateOfBirth = date(update.DateOfBirth)
+	}
+	if update.MonthlyIncome != nil {
+		a.MonthlyIncome = update.MonthlyIncome
+	}
+	if update.Assets != nil {
+		a.Assets = update.Assets
+	}
+	if update.HasDisability != nil {
+		a.HasDisability = update.HasDisability
+	}
+	a.UpdatedAt = now()
+
+	r.store.data.Applicants[*a.ID] = a
+	r.store.record(ctx, domain.AuditEntityApplicant, *a.ID, domain.AuditActionUpdate, before, a)
+
+	return ptr(clone(a)), nil
+}
+
+// DeleteApplicant deletes an applicant by their unique identifier.
+func (r *ApplicantRepository) DeleteApplicant(ctx context.Context, id uuid.UUID) error {
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.Applicants[id]
+	if !ok {
+		return nil
+	}
+
+	delete(r.store.data.Applicants, id)
+	r.store.record(ctx, domain.AuditEntityApplicant, id, domain.AuditActionDelete, before, nil)
+
+	return nil
+}
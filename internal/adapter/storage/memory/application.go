@@ -0,0 +1,193 @@
+package memory
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+// ApplicationRepository provides methods for interacting with the applications kept in a Store.
+type ApplicationRepository struct {
+	store *Store
+}
+
+// NewApplicationRepository creates a new instance of ApplicationRepository using the provided store.
+func NewApplicationRepository(store *Store) *ApplicationRepository {
+	return &ApplicationRepository{store: store}
+}
+
+// applicationSortKeys maps the fields applications can be sorted by to their values
+var applicationSortKeys = sortKeys[domain.Application]{
+	"created_at": func(a domain.Application) any { return a.CreatedAt },
+	"updated_at": func(a domain.Application) any { return a.UpdatedAt },
+	"status":     func(a domain.Application) any { return a.Status },
+}
+
+// ListApplications retrieves a page of applications matching the filter, along with the total number of matching applications.
+func (r *ApplicationRepository) ListApplications(ctx context.Context, filter domain.ApplicationFilter) ([]domain.Application, int, error) {
+	defer r.store.lock(ctx)()
+
+	applications := make([]domain.Application, 0)
+	for _, a := range r.store.data.Applications {
+		if filter.ApplicantID != nil && *a.ApplicantID != *filter.ApplicantID {
+			continue
+		}
+		if filter.SchemeID != nil && *a.SchemeID != *filter.SchemeID {
+			continue
+		}
+		if filter.Status != nil && *a.Status != *filter.Status {
+			continue
+		}
+		if filter.CreatedFrom != nil && a.CreatedAt.Before(*filter.CreatedFrom) {
+			continue
+		}
+		if filter.CreatedBefore != nil && !a.CreatedAt.Before(*filter.CreatedBefore) {
+			continue
+		}
+		applications = append(applications, clone(a))
+	}
+
+	return listPage(applications, filter.ListOptions, applicationSortKeys, func(a domain.Application) uuid.UUID { return *a.ID })
+}
+
+// GetApplicationById retrieves an application by its unique identifier.
+// Returns domain.ApplicationNotFoundError if no matching application is found.
+func (r *ApplicationRepository) GetApplicationById(ctx context.Context, id uuid.UUID) (*domain.Application, error) {
+	defer r.store.lock(ctx)()
+
+	application, ok := r.store.data.Applications[id]
+	if !ok {
+		return nil, domain.ApplicationNotFoundError
+	}
+
+	return ptr(clone(application)), nil
+}
+
+// ListApplicationsByApplicantAndScheme retrieves all applications an applicant has made to a scheme, latest first.
+func (r *ApplicationRepository) ListApplicationsByApplicantAndScheme(ctx context.Context, applicantID, schemeID uuid.UUID) ([]domain.Application, error) {
+	defer r.store.lock(ctx)()
+
+	return r.listApplicationsByApplicantAndScheme(applicantID, schemeID), nil
+}
+
+// CreateApplication adds a new submitted application to the store and returns the created application.
+// Returns a domain.ActiveApplicationError if the applicant already has an active application for the scheme.
+func (r *ApplicationRepository) CreateApplication(ctx context.Context, application *domain.Application) (*domain.Application, error) {
+	defer r.store.lock(ctx)()
+
+	if err := r.checkActiveApplication(nil, *application.ApplicantID, *application.SchemeID); err != nil {
+		return nil, err
+	}
+
+	a := domain.Application{
+		ApplicantID:     application.ApplicantID,
+		SchemeID:        application.SchemeID,
+		SchemeVersionID: application.SchemeVersionID,
+		Status:          ptr(domain.ApplicationStatusSubmitted),
+	}
+	a = clone(a)
+	a.ID, a.CreatedAt = newID()
+	a.UpdatedAt = a.CreatedAt
+
+	r.store.data.Applications[*a.ID] = a
+	r.store.record(ctx, domain.AuditEntityApplication, *a.ID, domain.AuditActionCreate, nil, a)
+
+	return ptr(clone(a)), nil
+}
+
+// UpdateApplication updates an existing application with the provided fields and returns the updated application.
+func (r *ApplicationRepository) UpdateApplication(ctx context.Context, application *domain.Application) (*domain.Application, error) {
+	if application.ApplicantID == nil && application.SchemeID == nil && application.SchemeVersionID == nil {
+		return nil, domain.NoUpdateFieldsError
+	}
+
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.Applications[*application.ID]
+	if !ok {
+		return nil, domain.ApplicationNotFoundError
+	}
+
+	a := clone(before)
+	update := clone(*application)
+	if update.ApplicantID != nil {
+		a.ApplicantID = update.ApplicantID
+	}
+	if update.SchemeID != nil {
+		a.SchemeID = update.SchemeID
+	}
+	if update.SchemeVersionID != nil {
+		a.SchemeVersionID = update.SchemeVersionID
+	}
+
+	if !a.Status.IsFinal() {
+		if err := r.checkActiveApplication(a.ID, *a.ApplicantID, *a.SchemeID); err != nil {
+			return nil, err
+		}
+	}
+	a.UpdatedAt = now()
+
+	r.store.data.Applications[*a.ID] = a
+	r.store.record(ctx, domain.AuditEntityApplication, *a.ID, domain.AuditActionUpdate, before, a)
+
+	return ptr(clone(a)), nil
+}
+
+// UpdateApplicationStatus moves an application from its current status to a new status.
+// Returns domain.InvalidApplicationStatusTransitionError if the application is no longer in the current status.
+func (r *ApplicationRepository) UpdateApplicationStatus(ctx context.Context, id uuid.UUID, currentStatus, newStatus domain.ApplicationStatus) (*domain.Application, error) {
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.Applications[id]
+	if !ok || *before.Status != currentStatus {
+		return nil, domain.InvalidApplicationStatusTransitionError
+	}
+
+	a := clone(before)
+	a.Status = ptr(newStatus)
+	a.UpdatedAt = now()
+
+	r.store.data.Applications[id] = a
+	r.store.record(ctx, domain.AuditEntityApplication, id, domain.AuditActionUpdate, before, a)
+
+	return ptr(clone(a)), nil
+}
+
+// DeleteApplication removes an application by its unique identifier.
+func (r *ApplicationRepository) DeleteApplication(ctx context.Context, id uuid.UUID) error {
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.Applications[id]
+	if !ok {
+		return nil
+	}
+
+	delete(r.store.data.Applications, id)
+	r.store.record(ctx, domain.AuditEntityApplication, id, domain.AuditActionDelete, before, nil)
+
+	return nil
+}
+
+// listApplicationsByApplicantAndScheme returns the applications an applicant has made to a scheme, latest first.
+func (r *ApplicationRepository) listApplicationsByApplicantAndScheme(applicantID, schemeID uuid.UUID) []domain.Application {
+	applications := make([]domain.Application, 0)
+	for _, a := range r.store.data.Applications {
+		if *a.ApplicantID == applicantID && *a.SchemeID == schemeID {
+			applications = append(applications, clone(a))
+		}
+	}
+
+	applications, _, _ = listPage(applications, domain.ListOptions{}, applicationSortKeys, func(a domain.Application) uuid.UUID { return *a.ID })
+	return applications
+}
+
+// checkActiveApplication returns a domain.ActiveApplicationError naming the active application an applicant already has
+// for a scheme, other than the application with the given ID.
+func (r *ApplicationRepository) checkActiveApplication(id *uuid.UUID, applicantID, schemeID uuid.UUID) error {
+	for _, a := range r.listApplicationsByApplicantAndScheme(applicantID, schemeID) {
+		if !a.Status.IsFinal() && (id == nil || *a.ID != *id) {
+			return &domain.ActiveApplicationError{ApplicationID: *a.ID}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"testing"
+)
+
+// applicationStatusPaths lists the transitions that take a submitted application to each status
+var applicationStatusPaths = map[domain.ApplicationStatus][]domain.ApplicationStatus{
+	domain.ApplicationStatusSubmitted:   {},
+	domain.ApplicationStatusUnderReview: {domain.ApplicationStatusUnderReview},
+	domain.ApplicationStatusApproved:    {domain.ApplicationStatusUnderReview, domain.ApplicationStatusApproved},
+	domain.ApplicationStatusRejected:    {domain.ApplicationStatusUnderReview, domain.ApplicationStatusRejected},
+	domain.ApplicationStatusWithdrawn:   {domain.ApplicationStatusWithdrawn},
+	domain.ApplicationStatusDisbursed:   {domain.ApplicationStatusUnderReview, domain.ApplicationStatusApproved, domain.ApplicationStatusDisbursed},
+}
+
+// createApplication creates an application and moves it to the given status
+func createApplication(t *testing.T, repo *ApplicationRepository, applicantID, schemeID uuid.UUID, status domain.ApplicationStatus) *domain.Application {
+	t.Helper()
+	ctx := context.Background()
+
+	application, err := repo.CreateApplication(ctx, &domain.Application{ApplicantID: ptr(applicantID), SchemeID: ptr(schemeID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, next := range applicationStatusPaths[status] {
+		application, err = repo.UpdateApplicationStatus(ctx, *application.ID, *application.Status, next)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return application
+}
+
+func TestActiveApplicationIsUnique(t *testing.T) {
+	applicantID, schemeID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		status     domain.ApplicationStatus
+		schemeID   uuid.UUID
+		wantActive bool
+	}{
+		{name: "submitted", status: domain.ApplicationStatusSubmitted, schemeID: schemeID, wantActive: true},
+		{name: "under review", status: domain.ApplicationStatusUnderReview, schemeID: schemeID, wantActive: true},
+		{name: "approved", status: domain.ApplicationStatusApproved, schemeID: schemeID, wantActive: true},
+		{name: "rejected", status: domain.ApplicationStatusRejected, schemeID: schemeID},
+		{name: "withdrawn", status: domain.ApplicationStatusWithdrawn, schemeID: schemeID},
+		{name: "disbursed", status: domain.ApplicationStatusDisbursed, schemeID: schemeID},
+		{name: "other scheme", status: domain.ApplicationStatusSubmitted, schemeID: uuid.New()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewApplicationRepository(NewStore())
+			existing := createApplication(t, repo, applicantID, tt.schemeID, tt.status)
+
+			_, err := repo.CreateApplication(context.Background(), &domain.Application{ApplicantID: ptr(applicantID), SchemeID: ptr(schemeID)})
+
+			var activeErr *domain.ActiveApplicationError
+			if !tt.wantActive {
+				if err != nil {
+					t.Fatalf("CreateApplication() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.As(err, &activeErr) {
+				t.Fatalf("CreateApplication() error = %v, want an ActiveApplicationError", err)
+			}
+			if activeErr.ApplicationID != *existing.ID {
+				t.Errorf("ActiveApplicationError names %s, want %s", activeErr.ApplicationID, *existing.ID)
+			}
+		})
+	}
+}
+
+func TestUpdateApplicationKeepsActiveApplicationUnique(t *testing.T) {
+	ctx := context.Background()
+	repo := NewApplicationRepository(NewStore())
+	applicantID, schemeID, otherSchemeID := uuid.New(), uuid.New(), uuid.New()
+
+	active := createApplication(t, repo, applicantID, schemeID, domain.ApplicationStatusSubmitted)
+	other := createApplication(t, repo, applicantID, otherSchemeID, domain.ApplicationStatusSubmitted)
+	withdrawn := createApplication(t, repo, applicantID, uuid.New(), domain.ApplicationStatusWithdrawn)
+
+	tests := []struct {
+		name        string
+		application *domain.Application
+		wantErr     bool
+	}{
+		{name: "move onto a scheme with an active application", application: &domain.Application{ID: other.ID, SchemeID: ptr(schemeID)}, wantErr: true},
+		{name: "keep its own scheme", application: &domain.Application{ID: active.ID, SchemeID: ptr(schemeID)}},
+		{name: "move a final application", application: &domain.Application{ID: withdrawn.ID, SchemeID: ptr(schemeID)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := repo.UpdateApplication(ctx, tt.application)
+
+			var activeErr *domain.ActiveApplicationError
+			if tt.wantErr && !errors.As(err, &activeErr) {
+				t.Fatalf("UpdateApplication() error = %v, want an ActiveApplicationError", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("UpdateApplication() error = %v, want nil", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// auditIgnoredFields are never copied into audit log entries. Password hashes are secret,
+// and UpdatedAt changes on every update so it is left out of the changed fields.
+var auditIgnoredFields = []string{"PasswordHash", "UpdatedAt"}
+
+// auditColumnNames names the fields whose column cannot be derived from the field name
+var auditColumnNames = map[string]string{
+	"ApplicantAID": "applicant_a_id",
+	"ApplicantBID": "applicant_b_id",
+}
+
+// auditLogSortKeys maps the fields audit log entries can be sorted by to their values
+var auditLogSortKeys = sortKeys[domain.AuditLog]{
+	"created_at": func(a domain.AuditLog) any { return a.CreatedAt },
+}
+
+// AuditRepository provides methods for reading the audit log kept in a Store.
+type AuditRepository struct {
+	store *Store
+}
+
+// NewAuditRepository creates a new instance of AuditRepository using the provided store.
+func NewAuditRepository(store *Store) *AuditRepository {
+	return &AuditRepository{store: store}
+}
+
+// ListAuditLogs retrieves a page of audit log entries matching the filter, along with the total number of matching entries.
+func (r *AuditRepository) ListAuditLogs(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditLog, int, error) {
+	defer r.store.lock(ctx)()
+
+	auditLogs := make([]domain.AuditLog, 0)
+	for _, a := range r.store.data.AuditLogs {
+		if filter.EntityType != nil && *a.EntityType != *filter.EntityType {
+			continue
+		}
+		if filter.EntityID != nil && *a.EntityID != *filter.EntityID {
+			continue
+		}
+		if filter.ActorID != nil && (a.ActorID == nil || *a.ActorID != *filter.ActorID) {
+			continue
+		}
+		if filter.Action != nil && *a.Action != *filter.Action {
+			continue
+		}
+		auditLogs = append(auditLogs, clone(a))
+	}
+
+	return listPage(auditLogs, filter.ListOptions, auditLogSortKeys, func(a domain.AuditLog) uuid.UUID { return *a.ID })
+}
+
+// record adds an audit log entry comparing the snapshots of an entity taken before and after it was changed.
+// Updates only record the fields that changed, and nothing is recorded if none did. Deletes of entities that
+// did not exist, which have no snapshot before the change, are not recorded either.
+func (s *Store) record(ctx context.Context, entityType domain.AuditEntityType, id uuid.UUID, action domain.AuditAction, before, after any) {
+	var oldValues, newValues map[string]any
+
+	switch action {
+	case domain.AuditActionCreate:
+		newValues = snapshot(after)
+
+	case domain.AuditActionUpdate:
+		oldValues, newValues = changedValues(snapshot(before), snapshot(after))
+		if len(newValues) == 0 && len(oldValues) == 0 {
+			return
+		}
+
+	case domain.AuditActionDelete:
+		if before == nil {
+			return
+		}
+		oldValues = snapshot(before)
+	}
+
+	auditLog := domain.AuditLog{
+		EntityType: &entityType,
+		EntityID:   &id,
+		Action:     &action,
+		OldValues:  oldValues,
+		NewValues:  newValues,
+	}
+	auditLog.ID, auditLog.CreatedAt = newID()
+
+	if actor := domain.ActorFromContext(ctx); actor != nil {
+		actorID, actorRole := actor.UserID, actor.Role
+		auditLog.ActorID = &actorID
+		auditLog.ActorRole = &actorRole
+	}
+
+	s.data.AuditLogs = append(s.data.AuditLogs, auditLog)
+}
+
+// snapshot returns the values of an entity keyed by column, the way they are stored in the database.
+// Collections and other entities the entity refers to are left out.
+func snapshot(entity any) map[string]any {
+	v := reflect.Indirect(reflect.ValueOf(entity))
+	values := map[string]any{}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if isIgnoredAuditField(field.Name) {
+			continue
+		}
+
+		value := v.Field(i).Interface()
+		switch value := value.(type) {
+		case *domain.Money:
+			if value != nil {
+				values[auditColumnName(field.Name)] = value.String()
+				continue
+			}
+		case *time.Time, *uuid.UUID:
+		default:
+			t := field.Type
+			if t.Kind() == reflect.Pointer {
+				t = t.Elem()
+			}
+			if t.Kind() == reflect.Struct || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+				continue
+			}
+		}
+
+		values[auditColumnName(field.Name)] = jsonValue(value)
+	}
+
+	return values
+}
+
+// isIgnoredAuditField reports whether a field is never copied into audit log entries
+func isIgnoredAuditField(name string) bool {
+	for _, ignored := range auditIgnoredFields {
+		if name == ignored {
+			return true
+		}
+	}
+	return false
+}
+
+// auditColumnName returns the column a field is stored in, by converting its name to snake case
+func auditColumnName(name string) string {
+	if column, ok := auditColumnNames[name]; ok {
+		return column
+	}
+
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// jsonValue converts a value to the value it has once encoded as JSON and decoded again, as audit log values are
+func jsonValue(value any) any {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil
+	}
+	return decoded
+}
+
+// changedValues returns the old and new values of the fields that differ between two snapshots of an entity
+func changedValues(before, after map[string]any) (map[string]any, map[string]any) {
+	oldValues := map[string]any{}
+	newValues := map[string]any{}
+
+	for column, value := range after {
+		if !reflect.DeepEqual(before[column], value) {
+			oldValues[column] = before[column]
+			newValues[column] = value
+		}
+	}
+
+	return oldValues, newValues
+}
@@ -0,0 +1,265 @@
+package memory
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+// auditFixture holds a store with one of each entity, and the repositories to change them with
+type auditFixture struct {
+	store         *Store
+	applicants    *ApplicantRepository
+	relationships *RelationshipRepository
+	schemes       *SchemeRepository
+	applications  *ApplicationRepository
+	disbursements *DisbursementRepository
+	users         *UserRepository
+
+	applicantID, relationshipID, schemeID, benefitID, applicationID, disbursementID, userID uuid.UUID
+}
+
+// newAuditFixture creates a store with one of each entity, without an actor
+func newAuditFixture(t *testing.T) *auditFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	store := NewStore()
+	f := &auditFixture{
+		store:         store,
+		applicants:    NewApplicantRepository(store),
+		relationships: NewRelationshipRepository(store),
+		schemes:       NewSchemeRepository(store),
+		applications:  NewApplicationRepository(store),
+		disbursements: NewDisbursementRepository(store),
+		users:         NewUserRepository(store),
+	}
+
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	amount := &domain.Money{Cents: 10000, Currency: domain.CurrencySGD}
+
+	applicant, err := f.applicants.CreateApplicant(ctx, &domain.Applicant{Name: ptr("Jane")})
+	must(err)
+	spouse, err := f.applicants.CreateApplicant(ctx, &domain.Applicant{Name: ptr("John")})
+	must(err)
+	relationship, err := f.relationships.CreateRelationship(ctx, relationship(*applicant.ID, *spouse.ID, domain.RelationshipTypeSpouse))
+	must(err)
+	scheme, err := f.schemes.CreateScheme(ctx, &domain.Scheme{Name: ptr("Scheme")})
+	must(err)
+	benefit, err := f.schemes.AddSchemeBenefit(ctx, &domain.Benefit{SchemeID: scheme.ID, Name: ptr("Benefit"), Amount: amount})
+	must(err)
+	application, err := f.applications.CreateApplication(ctx, &domain.Application{ApplicantID: applicant.ID, SchemeID: scheme.ID})
+	must(err)
+	disbursement, err := f.disbursements.CreateDisbursement(ctx, &domain.Disbursement{
+		ApplicationID: application.ID,
+		BenefitID:     benefit.ID,
+		BenefitName:   benefit.Name,
+		Installment:   ptr(1),
+		Amount:        amount,
+		DueDate:       ptr(time.Now()),
+	})
+	must(err)
+	user, err := f.users.CreateUser(ctx, &domain.User{Email: ptr("user@example.com"), Name: ptr("User"), PasswordHash: ptr("hash"), Role: ptr(domain.RoleViewer)})
+	must(err)
+
+	f.applicantID, f.relationshipID, f.schemeID, f.benefitID = *applicant.ID, *relationship.ID, *scheme.ID, *benefit.ID
+	f.applicationID, f.disbursementID, f.userID = *application.ID, *disbursement.ID, *user.ID
+
+	return f
+}
+
+func TestMutationsAreAudited(t *testing.T) {
+	tests := []struct {
+		name       string
+		entityType domain.AuditEntityType
+		action     domain.AuditAction
+		// wantEntries is the number of entries written, as relationships are changed together with their reverse
+		wantEntries int
+		// mutate makes the change and returns the ID of the entity it changed
+		mutate func(ctx context.Context, f *auditFixture) (uuid.UUID, error)
+	}{
+		{
+			name: "create applicant", entityType: domain.AuditEntityApplicant, action: domain.AuditActionCreate, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				a, err := f.applicants.CreateApplicant(ctx, &domain.Applicant{Name: ptr("Mary")})
+				if err != nil {
+					return uuid.Nil, err
+				}
+				return *a.ID, nil
+			},
+		},
+		{
+			name: "update applicant", entityType: domain.AuditEntityApplicant, action: domain.AuditActionUpdate, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				_, err := f.applicants.UpdateApplicant(ctx, &domain.Applicant{ID: ptr(f.applicantID), Name: ptr("Jane Tan")})
+				return f.applicantID, err
+			},
+		},
+		{
+			name: "delete applicant", entityType: domain.AuditEntityApplicant, action: domain.AuditActionDelete, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				return f.applicantID, f.applicants.DeleteApplicant(ctx, f.applicantID)
+			},
+		},
+		{
+			name: "create relationship", entityType: domain.AuditEntityRelationship, action: domain.AuditActionCreate, wantEntries: 2,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				r, err := f.relationships.CreateRelationship(ctx, relationship(f.applicantID, uuid.New(), domain.RelationshipTypeParent))
+				if err != nil {
+					return uuid.Nil, err
+				}
+				return *r.ID, nil
+			},
+		},
+		{
+			name: "update relationship", entityType: domain.AuditEntityRelationship, action: domain.AuditActionUpdate, wantEntries: 2,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				_, err := f.relationships.UpdateRelationship(ctx, &domain.Relationship{ID: ptr(f.relationshipID), RelationshipType: ptr(domain.RelationshipTypeSibling)})
+				return f.relationshipID, err
+			},
+		},
+		{
+			name: "delete relationship", entityType: domain.AuditEntityRelationship, action: domain.AuditActionDelete, wantEntries: 2,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				return f.relationshipID, f.relationships.DeleteRelationship(ctx, f.relationshipID)
+			},
+		},
+		{
+			name: "create scheme", entityType: domain.AuditEntityScheme, action: domain.AuditActionCreate, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				s, err := f.schemes.CreateScheme(ctx, &domain.Scheme{Name: ptr("Other Scheme")})
+				if err != nil {
+					return uuid.Nil, err
+				}
+				return *s.ID, nil
+			},
+		},
+		{
+			name: "update scheme", entityType: domain.AuditEntityScheme, action: domain.AuditActionUpdate, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				_, err := f.schemes.UpdateScheme(ctx, &domain.Scheme{ID: ptr(f.schemeID), Name: ptr("Renamed Scheme")})
+				return f.schemeID, err
+			},
+		},
+		{
+			name: "delete scheme", entityType: domain.AuditEntityScheme, action: domain.AuditActionDelete, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				return f.schemeID, f.schemes.DeleteScheme(ctx, f.schemeID)
+			},
+		},
+		{
+			name: "update benefit", entityType: domain.AuditEntityBenefit, action: domain.AuditActionUpdate, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				_, err := f.schemes.UpdateSchemeBenefit(ctx, &domain.Benefit{ID: ptr(f.benefitID), Name: ptr("Renamed Benefit")})
+				return f.benefitID, err
+			},
+		},
+		{
+			name: "delete benefit", entityType: domain.AuditEntityBenefit, action: domain.AuditActionDelete, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				return f.benefitID, f.schemes.DeleteSchemeBenefit(ctx, f.benefitID)
+			},
+		},
+		{
+			name: "update application status", entityType: domain.AuditEntityApplication, action: domain.AuditActionUpdate, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				_, err := f.applications.UpdateApplicationStatus(ctx, f.applicationID, domain.ApplicationStatusSubmitted, domain.ApplicationStatusWithdrawn)
+				return f.applicationID, err
+			},
+		},
+		{
+			name: "delete application", entityType: domain.AuditEntityApplication, action: domain.AuditActionDelete, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				return f.applicationID, f.applications.DeleteApplication(ctx, f.applicationID)
+			},
+		},
+		{
+			name: "update disbursement status", entityType: domain.AuditEntityDisbursement, action: domain.AuditActionUpdate, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				_, err := f.disbursements.UpdateDisbursementStatus(ctx, f.disbursementID, domain.DisbursementStatusScheduled, domain.DisbursementStatusPaid)
+				return f.disbursementID, err
+			},
+		},
+		{
+			name: "create user", entityType: domain.AuditEntityUser, action: domain.AuditActionCreate, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				u, err := f.users.CreateUser(ctx, &domain.User{Email: ptr("other@example.com"), Name: ptr("Other"), PasswordHash: ptr("hash"), Role: ptr(domain.RoleViewer)})
+				if err != nil {
+					return uuid.Nil, err
+				}
+				return *u.ID, nil
+			},
+		},
+		{
+			name: "update user", entityType: domain.AuditEntityUser, action: domain.AuditActionUpdate, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				_, err := f.users.UpdateUser(ctx, &domain.User{ID: ptr(f.userID), Role: ptr(domain.RoleCaseworker)})
+				return f.userID, err
+			},
+		},
+		{
+			name: "delete user", entityType: domain.AuditEntityUser, action: domain.AuditActionDelete, wantEntries: 1,
+			mutate: func(ctx context.Context, f *auditFixture) (uuid.UUID, error) {
+				return f.userID, f.users.DeleteUser(ctx, f.userID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuditFixture(t)
+			actor := &domain.TokenPayload{UserID: uuid.New(), Role: domain.RoleSuperadmin}
+			ctx := domain.ContextWithActor(context.Background(), actor)
+
+			written := len(f.store.data.AuditLogs)
+			id, err := tt.mutate(ctx, f)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			entries := f.store.data.AuditLogs[written:]
+			if len(entries) != tt.wantEntries {
+				t.Fatalf("wrote %d audit log entries, want %d", len(entries), tt.wantEntries)
+			}
+			recorded := false
+			for _, entry := range entries {
+				recorded = recorded || *entry.EntityID == id
+				if *entry.EntityType != tt.entityType || *entry.Action != tt.action {
+					t.Errorf("entry = %s %s, want %s %s", *entry.Action, *entry.EntityType, tt.action, tt.entityType)
+				}
+				if entry.ActorID == nil || *entry.ActorID != actor.UserID || *entry.ActorRole != actor.Role {
+					t.Errorf("entry was not attributed to the actor %s", actor.UserID)
+				}
+			}
+			if !recorded {
+				t.Errorf("no audit log entry was written for %s", id)
+			}
+		})
+	}
+}
+
+func TestRolledBackMutationsAreNotAudited(t *testing.T) {
+	f := newAuditFixture(t)
+	written := len(f.store.data.AuditLogs)
+
+	err := f.store.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if _, err := f.applicants.UpdateApplicant(ctx, &domain.Applicant{ID: ptr(f.applicantID), Name: ptr("Jane Tan")}); err != nil {
+			return err
+		}
+		return domain.NoUpdateFieldsError
+	})
+	if err == nil {
+		t.Fatal("WithinTransaction() error = nil, want the error of the transaction")
+	}
+
+	if got := len(f.store.data.AuditLogs); got != written {
+		t.Errorf("%d audit log entries after rollback, want %d", got, written)
+	}
+}
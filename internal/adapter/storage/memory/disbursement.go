@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"slices"
+	"strings"
+)
+
+// DisbursementRepository provides methods for managing the ledger of disbursements kept in a Store.
+type DisbursementRepository struct {
+	store *Store
+}
+
+// NewDisbursementRepository creates a new instance of DisbursementRepository using the provided store.
+func NewDisbursementRepository(store *Store) *DisbursementRepository {
+	return &DisbursementRepository{store: store}
+}
+
+// GetDisbursementByID retrieves a disbursement by its unique identifier.
+// Returns domain.DisbursementNotFoundError if no matching disbursement is found.
+func (r *DisbursementRepository) GetDisbursementByID(ctx context.Context, id uuid.UUID) (*domain.Disbursement, error) {
+	defer r.store.lock(ctx)()
+
+	disbursement, ok := r.store.data.Disbursements[id]
+	if !ok {
+		return nil, domain.DisbursementNotFoundError
+	}
+
+	return ptr(clone(disbursement)), nil
+}
+
+// ListApplicationDisbursements retrieves the disbursements of an application, earliest due first.
+func (r *DisbursementRepository) ListApplicationDisbursements(ctx context.Context, applicationID uuid.UUID) ([]domain.Disbursement, error) {
+	defer r.store.lock(ctx)()
+
+	disbursements := r.listDisbursements(func(d domain.Disbursement) bool {
+		return *d.ApplicationID == applicationID
+	})
+	slices.SortFunc(disbursements, compareDisbursements)
+
+	return disbursements, nil
+}
+
+// ListApplicantDisbursements retrieves the disbursements of every application of an applicant, latest due first.
+func (r *DisbursementRepository) ListApplicantDisbursements(ctx context.Context, applicantID uuid.UUID) ([]domain.Disbursement, error) {
+	defer r.store.lock(ctx)()
+
+	disbursements := r.listDisbursements(func(d domain.Disbursement) bool {
+		application, ok := r.store.data.Applications[*d.ApplicationID]
+		return ok && *application.ApplicantID == applicantID
+	})
+	slices.SortFunc(disbursements, func(a, b domain.Disbursement) int {
+		if c := a.DueDate.Compare(*b.DueDate); c != 0 {
+			return -c
+		}
+		if c := *a.Installment - *b.Installment; c != 0 {
+			return -c
+		}
+		return strings.Compare(*a.BenefitName, *b.BenefitName)
+	})
+
+	return disbursements, nil
+}
+
+// CreateDisbursement adds a scheduled disbursement to the ledger and returns it.
+func (r *DisbursementRepository) CreateDisbursement(ctx context.Context, disbursement *domain.Disbursement) (*domain.Disbursement, error) {
+	defer r.store.lock(ctx)()
+
+	d := domain.Disbursement{
+		ApplicationID: disbursement.ApplicationID,
+		BenefitID:     disbursement.BenefitID,
+		BenefitName:   disbursement.BenefitName,
+		Installment:   disbursement.Installment,
+		Amount:        disbursement.Amount,
+		DueDate:       date(disbursement.DueDate),
+		Status:        ptr(domain.DisbursementStatusScheduled),
+	}
+	d = clone(d)
+	d.ID, d.CreatedAt = newID()
+	d.UpdatedAt = d.CreatedAt
+
+	r.store.data.Disbursements[*d.ID] = d
+	r.store.record(ctx, domain.AuditEntityDisbursement, *d.ID, domain.AuditActionCreate, nil, d)
+
+	return ptr(clone(d)), nil
+}
+
+// UpdateDisbursementStatus moves a disbursement from its current status to a new status, recording when it is paid.
+// Returns domain.InvalidDisbursementTransitionError if the disbursement is no longer in the current status.
+func (r *DisbursementRepository) UpdateDisbursementStatus(ctx context.Context, id uuid.UUID, currentStatus, newStatus domain.DisbursementStatus) (*domain.Disbursement, error) {
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.Disbursements[id]
+	if !ok || *before.Status != currentStatus {
+		return nil, domain.InvalidDisbursementTransitionError
+	}
+
+	d := clone(before)
+	d.Status = ptr(newStatus)
+	if newStatus == domain.DisbursementStatusPaid {
+		d.PaidAt = now()
+	}
+	d.UpdatedAt = now()
+
+	r.store.data.Disbursements[id] = d
+	r.store.record(ctx, domain.AuditEntityDisbursement, id, domain.AuditActionUpdate, before, d)
+
+	return ptr(clone(d)), nil
+}
+
+// listDisbursements returns the disbursements that match a condition.
+func (r *DisbursementRepository) listDisbursements(match func(d domain.Disbursement) bool) []domain.Disbursement {
+	disbursements := make([]domain.Disbursement, 0)
+	for _, d := range r.store.data.Disbursements {
+		if match(d) {
+			disbursements = append(disbursements, clone(d))
+		}
+	}
+	return disbursements
+}
+
+// compareDisbursements orders disbursements by due date, then installment, then benefit name
+func compareDisbursements(a, b domain.Disbursement) int {
+	if c := a.DueDate.Compare(*b.DueDate); c != 0 {
+		return c
+	}
+	if c := *a.Installment - *b.Installment; c != 0 {
+		return c
+	}
+	return strings.Compare(*a.BenefitName, *b.BenefitName)
+}
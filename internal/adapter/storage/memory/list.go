@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"bytes"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// sortKeys maps the fields a list of entities can be sorted by to the value each entity is sorted on
+type sortKeys[T any] map[string]func(T) any
+
+// containsFold reports whether s contains substr, ignoring case, the way ILIKE matches a contains pattern
+func containsFold(s *string, substr string) bool {
+	return s != nil && strings.Contains(strings.ToLower(*s), strings.ToLower(substr))
+}
+
+// listPage orders and pages a list of entities, and returns the page along with the number of entities in the list.
+// Entities are sorted by created_at, newest first, when no sort field is given, and id breaks ties so pages are stable.
+func listPage[T any](items []T, opts domain.ListOptions, keys sortKeys[T], id func(T) uuid.UUID) ([]T, int, error) {
+	sortBy := "created_at"
+	if opts.SortBy != "" {
+		sortBy = opts.SortBy
+	}
+
+	key, ok := keys[sortBy]
+	if !ok {
+		return nil, 0, domain.InvalidSortFieldError
+	}
+
+	desc := opts.SortOrder == domain.SortOrderDesc || (opts.SortOrder == "" && opts.SortBy == "")
+
+	slices.SortStableFunc(items, func(a, b T) int {
+		c := compareValues(key(a), key(b))
+		if c == 0 {
+			aID, bID := id(a), id(b)
+			c = bytes.Compare(aID[:], bID[:])
+		}
+		if desc {
+			return -c
+		}
+		return c
+	})
+
+	total := len(items)
+
+	start := min(max(opts.Offset, 0), total)
+	end := total
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, total)
+	}
+
+	return items[start:end], total, nil
+}
+
+// compareValues compares two sort values. Missing values sort after every other value, as NULLs do in postgres.
+func compareValues(a, b any) int {
+	a, b = derefValue(a), derefValue(b)
+
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	switch a := a.(type) {
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	case int:
+		return a - b.(int)
+	default:
+		return strings.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+	}
+}
+
+// derefValue returns the value a pointer points to, or nil if it is a nil pointer
+func derefValue(value any) any {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Pointer {
+		return value
+	}
+	if v.IsNil() {
+		return nil
+	}
+	return v.Elem().Interface()
+}
+
+// sortByCreatedAt sorts entities in the order they were created
+func sortByCreatedAt[T any](items []T, createdAt func(T) *time.Time) {
+	slices.SortStableFunc(items, func(a, b T) int { return createdAt(a).Compare(*createdAt(b)) })
+}
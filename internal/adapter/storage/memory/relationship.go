@@ -0,0 +1,162 @@
+package memory
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+// RelationshipRepository provides methods for interacting with the relationships kept in a Store.
+type RelationshipRepository struct {
+	store *Store
+}
+
+// NewRelationshipRepository creates a new instance of RelationshipRepository using the provided store.
+func NewRelationshipRepository(store *Store) *RelationshipRepository {
+	return &RelationshipRepository{store: store}
+}
+
+// GetRelationshipByID retrieves a relationship by its ID, or returns domain.RelationshipNotFoundError if not found.
+func (r *RelationshipRepository) GetRelationshipByID(ctx context.Context, id uuid.UUID) (*domain.Relationship, error) {
+	defer r.store.lock(ctx)()
+
+	relationship, ok := r.store.data.Relationships[id]
+	if !ok {
+		return nil, domain.RelationshipNotFoundError
+	}
+
+	return ptr(clone(relationship)), nil
+}
+
+// GetRelationshipBetweenApplicants retrieves the relationship between two applicants in either direction,
+// or returns domain.RelationshipNotFoundError if they are not related.
+func (r *RelationshipRepository) GetRelationshipBetweenApplicants(ctx context.Context, applicantAID uuid.UUID, applicantBID uuid.UUID) (*domain.Relationship, error) {
+	defer r.store.lock(ctx)()
+
+	for _, relationship := range r.store.data.Relationships {
+		a, b := *relationship.ApplicantAID, *relationship.ApplicantBID
+		if (a == applicantAID && b == applicantBID) || (a == applicantBID && b == applicantAID) {
+			return ptr(clone(relationship)), nil
+		}
+	}
+
+	return nil, domain.RelationshipNotFoundError
+}
+
+// ListApplicantRelationships retrieves every relationship of an applicant, latest first.
+func (r *RelationshipRepository) ListApplicantRelationships(ctx context.Context, applicantID uuid.UUID) ([]domain.Relationship, error) {
+	defer r.store.lock(ctx)()
+
+	relationships := make([]domain.Relationship, 0)
+	for _, relationship := range r.store.data.Relationships {
+		if *relationship.ApplicantAID == applicantID {
+			relationships = append(relationships, clone(relationship))
+		}
+	}
+
+	relationships, _, err := listPage(relationships, domain.ListOptions{}, relationshipSortKeys, func(r domain.Relationship) uuid.UUID { return *r.ID })
+	return relationships, err
+}
+
+// relationshipSortKeys maps the fields relationships can be sorted by to their values
+var relationshipSortKeys = sortKeys[domain.Relationship]{
+	"created_at": func(r domain.Relationship) any { return r.CreatedAt },
+}
+
+// CreateRelationship adds a new relationship together with its reverse relationship and returns the created relationship.
+// Returns domain.DuplicateRelationshipError if either applicant is already linked to the other.
+func (r *RelationshipRepository) CreateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error) {
+	defer r.store.lock(ctx)()
+
+	a, b := *relationship.ApplicantAID, *relationship.ApplicantBID
+	if r.findRelationship(a, b) != nil || r.findRelationship(b, a) != nil {
+		return nil, domain.DuplicateRelationshipError
+	}
+
+	created := clone(*relationship)
+	created.ID, created.CreatedAt = newID()
+	created.UpdatedAt = created.CreatedAt
+
+	reverse := domain.Relationship{
+		ApplicantAID:     ptr(b),
+		ApplicantBID:     ptr(a),
+		RelationshipType: ptr(relationship.RelationshipType.Inverse()),
+	}
+	reverse.ID, reverse.CreatedAt = newID()
+	reverse.UpdatedAt = reverse.CreatedAt
+
+	for _, rel := range []domain.Relationship{created, reverse} {
+		r.store.data.Relationships[*rel.ID] = rel
+		r.store.record(ctx, domain.AuditEntityRelationship, *rel.ID, domain.AuditActionCreate, nil, rel)
+	}
+
+	return ptr(clone(created)), nil
+}
+
+// UpdateRelationship updates the type of an existing relationship and its reverse relationship
+// and returns the updated relationship.
+func (r *RelationshipRepository) UpdateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error) {
+	if relationship.ID == nil {
+		return nil, domain.InvalidRelationshipError
+	}
+
+	if relationship.RelationshipType == nil {
+		return nil, domain.NoUpdateFieldsError
+	}
+
+	defer r.store.lock(ctx)()
+
+	existing, ok := r.store.data.Relationships[*relationship.ID]
+	if !ok {
+		return nil, domain.RelationshipNotFoundError
+	}
+
+	types := map[uuid.UUID]domain.RelationshipType{*existing.ID: *relationship.RelationshipType}
+	if reverse := r.findRelationship(*existing.ApplicantBID, *existing.ApplicantAID); reverse != nil {
+		types[*reverse.ID] = relationship.RelationshipType.Inverse()
+	}
+
+	for id, rt := range types {
+		before := r.store.data.Relationships[id]
+		updated := clone(before)
+		updated.RelationshipType = ptr(rt)
+		updated.UpdatedAt = now()
+
+		r.store.data.Relationships[id] = updated
+		r.store.record(ctx, domain.AuditEntityRelationship, id, domain.AuditActionUpdate, before, updated)
+	}
+
+	return ptr(clone(r.store.data.Relationships[*existing.ID])), nil
+}
+
+// DeleteRelationship deletes a relationship and its reverse relationship.
+func (r *RelationshipRepository) DeleteRelationship(ctx context.Context, id uuid.UUID) error {
+	defer r.store.lock(ctx)()
+
+	existing, ok := r.store.data.Relationships[id]
+	if !ok {
+		return domain.RelationshipNotFoundError
+	}
+
+	deleted := []domain.Relationship{existing}
+	if reverse := r.findRelationship(*existing.ApplicantBID, *existing.ApplicantAID); reverse != nil {
+		deleted = append(deleted, *reverse)
+	}
+
+	for _, rel := range deleted {
+		delete(r.store.data.Relationships, *rel.ID)
+		r.store.record(ctx, domain.AuditEntityRelationship, *rel.ID, domain.AuditActionDelete, rel, nil)
+	}
+
+	return nil
+}
+
+// findRelationship returns the relationship linking one applicant to another, or nil if there is none.
+func (r *RelationshipRepository) findRelationship(applicantAID, applicantBID uuid.UUID) *domain.Relationship {
+	for _, relationship := range r.store.data.Relationships {
+		if *relationship.ApplicantAID == applicantAID && *relationship.ApplicantBID == applicantBID {
+			return &relationship
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"testing"
+)
+
+// relationship returns a relationship of the given type from one applicant to another
+func relationship(a, b uuid.UUID, relationshipType domain.RelationshipType) *domain.Relationship {
+	return &domain.Relationship{ApplicantAID: ptr(a), ApplicantBID: ptr(b), RelationshipType: ptr(relationshipType)}
+}
+
+func TestCreateRelationshipIsUnique(t *testing.T) {
+	parent, child, other := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		create  *domain.Relationship
+		wantErr error
+	}{
+		{name: "same direction", create: relationship(parent, child, domain.RelationshipTypeParent), wantErr: domain.DuplicateRelationshipError},
+		{name: "same direction, other type", create: relationship(parent, child, domain.RelationshipTypeSibling), wantErr: domain.DuplicateRelationshipError},
+		{name: "reverse direction", create: relationship(child, parent, domain.RelationshipTypeChild), wantErr: domain.DuplicateRelationshipError},
+		{name: "other applicant", create: relationship(parent, other, domain.RelationshipTypeParent)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := NewRelationshipRepository(NewStore())
+
+			if _, err := repo.CreateRelationship(ctx, relationship(parent, child, domain.RelationshipTypeParent)); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := repo.CreateRelationship(ctx, tt.create)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateRelationship() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRelationshipReverseLink(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRelationshipRepository(NewStore())
+	a, b := uuid.New(), uuid.New()
+
+	// wantTypes checks the type of the relationship from a to b and of its reverse, or that neither exists
+	wantTypes := func(t *testing.T, forward, reverse domain.RelationshipType) {
+		t.Helper()
+		for _, link := range []struct {
+			from, to uuid.UUID
+			want     domain.RelationshipType
+		}{{a, b, forward}, {b, a, reverse}} {
+			got := repo.findRelationship(link.from, link.to)
+			switch {
+			case link.want == "" && got != nil:
+				t.Errorf("relationship from %s to %s = %s, want none", link.from, link.to, *got.RelationshipType)
+			case link.want != "" && got == nil:
+				t.Errorf("relationship from %s to %s is missing, want %s", link.from, link.to, link.want)
+			case link.want != "" && *got.RelationshipType != link.want:
+				t.Errorf("relationship from %s to %s = %s, want %s", link.from, link.to, *got.RelationshipType, link.want)
+			}
+		}
+	}
+
+	created, err := repo.CreateRelationship(ctx, relationship(a, b, domain.RelationshipTypeParent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTypes(t, domain.RelationshipTypeParent, domain.RelationshipTypeChild)
+
+	_, err = repo.UpdateRelationship(ctx, &domain.Relationship{ID: created.ID, RelationshipType: ptr(domain.RelationshipTypeSpouse)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTypes(t, domain.RelationshipTypeSpouse, domain.RelationshipTypeSpouse)
+
+	if err = repo.DeleteRelationship(ctx, *created.ID); err != nil {
+		t.Fatal(err)
+	}
+	wantTypes(t, "", "")
+
+	// The applicants can be linked again once they are no longer related
+	if _, err = repo.CreateRelationship(ctx, relationship(b, a, domain.RelationshipTypeSibling)); err != nil {
+		t.Fatalf("CreateRelationship() after delete error = %v, want nil", err)
+	}
+	wantTypes(t, domain.RelationshipTypeSibling, domain.RelationshipTypeSibling)
+}
@@ -0,0 +1,197 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+// =======================================================
+// =============== Scheme Version Functions ==============
+// =======================================================
+
+// ListSchemeVersions retrieves every version of a scheme, latest first.
+func (r *SchemeRepository) ListSchemeVersions(ctx context.Context, schemeID uuid.UUID) ([]domain.SchemeVersion, error) {
+	defer r.store.lock(ctx)()
+
+	versions := r.listSchemeVersions(func(v domain.SchemeVersion) bool { return *v.SchemeID == schemeID })
+	slices.SortFunc(versions, func(a, b domain.SchemeVersion) int { return *b.Version - *a.Version })
+
+	return versions, nil
+}
+
+// GetSchemeVersionByID retrieves a scheme version by its ID, or returns domain.SchemeVersionNotFoundError if not found.
+func (r *SchemeRepository) GetSchemeVersionByID(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error) {
+	defer r.store.lock(ctx)()
+
+	version, ok := r.store.data.SchemeVersions[id]
+	if !ok {
+		return nil, domain.SchemeVersionNotFoundError
+	}
+
+	return ptr(clone(version)), nil
+}
+
+// GetEffectiveSchemeVersion retrieves the published version of a scheme in effect on the given date.
+// Returns domain.SchemeNotEffectiveError if no version is in effect on that date.
+func (r *SchemeRepository) GetEffectiveSchemeVersion(ctx context.Context, schemeID uuid.UUID, asOf time.Time) (*domain.SchemeVersion, error) {
+	defer r.store.lock(ctx)()
+
+	versions := r.listSchemeVersions(func(v domain.SchemeVersion) bool {
+		return *v.SchemeID == schemeID && r.isEffective(v, asOf)
+	})
+	if len(versions) == 0 {
+		return nil, domain.SchemeNotEffectiveError
+	}
+
+	return &versions[0], nil
+}
+
+// ListEffectiveSchemeVersions retrieves the published version of every scheme that has one in effect on the given date,
+// newest scheme first.
+func (r *SchemeRepository) ListEffectiveSchemeVersions(ctx context.Context, asOf time.Time) ([]domain.SchemeVersion, error) {
+	defer r.store.lock(ctx)()
+
+	versions := r.listSchemeVersions(func(v domain.SchemeVersion) bool { return r.isEffective(v, asOf) })
+	slices.SortFunc(versions, func(a, b domain.SchemeVersion) int {
+		return r.store.data.Schemes[*b.SchemeID].CreatedAt.Compare(*r.store.data.Schemes[*a.SchemeID].CreatedAt)
+	})
+
+	return versions, nil
+}
+
+// CreateSchemeVersion creates a new draft version of a scheme with the given effective dates.
+// Returns domain.DuplicateSchemeVersionDraftError if the scheme already has a draft.
+func (r *SchemeRepository) CreateSchemeVersion(ctx context.Context, version *domain.SchemeVersion) (*domain.SchemeVersion, error) {
+	if version == nil || version.SchemeID == nil || version.EffectiveFrom == nil {
+		return nil, fmt.Errorf("scheme version must have a scheme and an effective from date")
+	}
+
+	defer r.store.lock(ctx)()
+
+	for _, v := range r.store.data.SchemeVersions {
+		if *v.SchemeID == *version.SchemeID && *v.Status == domain.SchemeVersionStatusDraft {
+			return nil, domain.DuplicateSchemeVersionDraftError
+		}
+	}
+
+	// Versions are numbered in the order they are created for each scheme
+	number := r.store.data.SchemeVersionNumbers[*version.SchemeID]
+
+	v := domain.SchemeVersion{
+		SchemeID:      version.SchemeID,
+		Version:       ptr(number + 1),
+		Status:        ptr(domain.SchemeVersionStatusDraft),
+		EffectiveFrom: date(version.EffectiveFrom),
+		EffectiveTo:   date(version.EffectiveTo),
+	}
+	v = clone(v)
+	v.ID, v.CreatedAt = newID()
+	v.UpdatedAt = v.CreatedAt
+
+	r.store.data.SchemeVersions[*v.ID] = v
+	r.store.data.SchemeVersionNumbers[*v.SchemeID] = *v.Version
+	r.store.record(ctx, domain.AuditEntitySchemeVersion, *v.ID, domain.AuditActionCreate, nil, v)
+
+	return ptr(clone(v)), nil
+}
+
+// PublishSchemeVersion publishes a draft version, copying the current benefits and criteria of its scheme into it.
+// Returns domain.InvalidSchemeVersionTransitionError if the version is not a draft.
+func (r *SchemeRepository) PublishSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error) {
+	return r.transitionSchemeVersion(ctx, id, domain.SchemeVersionStatusDraft, func(v *domain.SchemeVersion) {
+		definition := r.assembleScheme(r.store.data.Schemes[*v.SchemeID])
+		definition.VersionID, definition.Version = v.ID, v.Version
+		definition.CreatedAt, definition.UpdatedAt = nil, nil
+
+		v.Status = ptr(domain.SchemeVersionStatusPublished)
+		v.Definition = &definition
+		v.PublishedAt = now()
+	})
+}
+
+// RetireSchemeVersion retires a published version so that it is no longer in effect.
+// Returns domain.InvalidSchemeVersionTransitionError if the version is not published.
+func (r *SchemeRepository) RetireSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error) {
+	return r.transitionSchemeVersion(ctx, id, domain.SchemeVersionStatusPublished, func(v *domain.SchemeVersion) {
+		v.Status = ptr(domain.SchemeVersionStatusRetired)
+		v.RetiredAt = now()
+	})
+}
+
+// transitionSchemeVersion changes a scheme version that is still in the given status and records it in the audit log
+func (r *SchemeRepository) transitionSchemeVersion(ctx context.Context, id uuid.UUID, status domain.SchemeVersionStatus, transition func(v *domain.SchemeVersion)) (*domain.SchemeVersion, error) {
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.SchemeVersions[id]
+	if !ok || *before.Status != status {
+		return nil, domain.InvalidSchemeVersionTransitionError
+	}
+
+	v := clone(before)
+	transition(&v)
+	v.UpdatedAt = now()
+
+	r.store.data.SchemeVersions[id] = v
+	r.store.record(ctx, domain.AuditEntitySchemeVersion, id, domain.AuditActionUpdate, before, v)
+
+	return ptr(clone(v)), nil
+}
+
+// EndSchemeVersion sets the date a scheme version stops being in effect.
+func (r *SchemeRepository) EndSchemeVersion(ctx context.Context, id uuid.UUID, effectiveTo time.Time) error {
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.SchemeVersions[id]
+	if !ok {
+		return nil
+	}
+
+	v := clone(before)
+	v.EffectiveTo = date(&effectiveTo)
+	v.UpdatedAt = now()
+
+	r.store.data.SchemeVersions[id] = v
+	r.store.record(ctx, domain.AuditEntitySchemeVersion, id, domain.AuditActionUpdate, before, v)
+
+	return nil
+}
+
+// DeleteSchemeVersion deletes a draft scheme version. Published and retired versions are kept.
+func (r *SchemeRepository) DeleteSchemeVersion(ctx context.Context, id uuid.UUID) error {
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.SchemeVersions[id]
+	if !ok || *before.Status != domain.SchemeVersionStatusDraft {
+		return nil
+	}
+
+	delete(r.store.data.SchemeVersions, id)
+	r.store.record(ctx, domain.AuditEntitySchemeVersion, id, domain.AuditActionDelete, before, nil)
+
+	return nil
+}
+
+// listSchemeVersions returns the versions that match a condition.
+func (r *SchemeRepository) listSchemeVersions(match func(v domain.SchemeVersion) bool) []domain.SchemeVersion {
+	versions := make([]domain.SchemeVersion, 0)
+	for _, v := range r.store.data.SchemeVersions {
+		if match(v) {
+			versions = append(versions, clone(v))
+		}
+	}
+	return versions
+}
+
+// isEffective reports whether a version of a scheme that has not been deleted is published and in effect on a date.
+func (r *SchemeRepository) isEffective(v domain.SchemeVersion, asOf time.Time) bool {
+	if _, ok := r.store.data.Schemes[*v.SchemeID]; !ok || *v.Status != domain.SchemeVersionStatusPublished {
+		return false
+	}
+
+	day := *date(&asOf)
+	return !v.EffectiveFrom.After(day) && (v.EffectiveTo == nil || v.EffectiveTo.After(day))
+}
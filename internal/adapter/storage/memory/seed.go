@@ -0,0 +1,239 @@
+package memory
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"time"
+)
+
+// sampleApplicants are the applicants inserted by the seeder migration of the postgres database
+var sampleApplicants = []struct {
+	id               string
+	name             string
+	employmentStatus domain.EmploymentStatus
+	maritalStatus    domain.MaritalStatus
+	sex              domain.Sex
+	dateOfBirth      string
+}{
+	{"b6c29c96-024b-4e70-834b-8e0dd2c66645", "Alice Cooper", "employed", "single", "female", "1995-03-12"},
+	{"c85062f2-e306-4ecd-b586-a3dcbb03deaf", "Bradley Cooper", "unemployed", "single", "male", "1990-06-08"},
+	{"2b73b011-9e5a-4d62-a645-2eccd739a75f", "Emma Watson", "employed", "married", "female", "1987-04-15"},
+	{"735b72e4-a0e8-4784-b3d3-b2caa90d6ac5", "Tom Hardy", "employed", "divorce", "male", "1980-11-10"},
+	{"6f47b906-bfe9-4c57-ad06-63e6e8871fcc", "Isabella Evans", "unemployed", "widowed", "female", "1972-02-20"},
+	{"55e83c42-97cf-461d-8565-5c4c742db5ee", "Chris Hemsworth", "employed", "married", "male", "1983-08-11"},
+	{"87992362-44ef-42e6-8a4c-d75c6080b71a", "Sophia Martinez", "unemployed", "single", "female", "2001-07-18"},
+	{"7ae93b68-62ff-4d76-8510-714ae8e2c257", "Liam Nelson", "unemployed", "single", "male", "1992-12-05"},
+	{"2b167178-46fc-494b-b17c-511c1ed385aa", "Grace White", "employed", "single", "female", "1999-09-29"},
+}
+
+// sampleRelationships are the relationships inserted by the seeder migration. They were inserted one way only.
+var sampleRelationships = []struct {
+	id               string
+	applicantAID     string
+	applicantBID     string
+	relationshipType domain.RelationshipType
+}{
+	{"a12cf984-78f3-4eef-bb3c-66dae34a2fa1", "b6c29c96-024b-4e70-834b-8e0dd2c66645", "c85062f2-e306-4ecd-b586-a3dcbb03deaf", "sibling"},
+	{"ba76f9ac-b54a-4b27-9a73-3b9d6595c5ac", "2b73b011-9e5a-4d62-a645-2eccd739a75f", "735b72e4-a0e8-4784-b3d3-b2caa90d6ac5", "spouse"},
+	{"3c98f267-dc08-43ee-bf55-90ee9d03ad26", "6f47b906-bfe9-4c57-ad06-63e6e8871fcc", "c85062f2-e306-4ecd-b586-a3dcbb03deaf", "parent"},
+	{"4f83da47-cccd-4e81-8995-5e8d13e6f743", "55e83c42-97cf-461d-8565-5c4c742db5ee", "87992362-44ef-42e6-8a4c-d75c6080b71a", "parent"},
+	{"0b9af7f5-98a0-4d52-bcf1-3b2fa2e6052e", "7ae93b68-62ff-4d76-8510-714ae8e2c257", "2b167178-46fc-494b-b17c-511c1ed385aa", "spouse"},
+	{"a2436d69-fd62-47e5-bad3-a2c6148dfabb", "c85062f2-e306-4ecd-b586-a3dcbb03deaf", "b6c29c96-024b-4e70-834b-8e0dd2c66645", "child"},
+	{"e42935ec-ce5c-4f77-861d-1aebe3d91d42", "2b167178-46fc-494b-b17c-511c1ed385aa", "6f47b906-bfe9-4c57-ad06-63e6e8871fcc", "child"},
+}
+
+// sampleSchemes are the schemes inserted by the seeder migration
+var sampleSchemes = []struct {
+	id   string
+	name string
+}{
+	{"7b555aaf-e824-4f75-a2dd-1ea59ec92310", "Elderly Support Scheme"},
+	{"812b056b-8d12-472f-ac9b-419715a55b94", "Retrenchment Assistance Scheme"},
+	{"c8296def-bd88-40d5-8071-b13ff147fb64", "Single Parent Support Scheme"},
+	{"c8c699a7-8d59-40d7-8f9f-7f361804be40", "Retrenchment Assistance Scheme (families)"},
+}
+
+// sampleSchemeCriteria are the scheme criteria inserted by the seeder migration
+var sampleSchemeCriteria = []struct {
+	id       string
+	name     string
+	value    string
+	schemeID string
+}{
+	{"1f399c51-335a-43c2-810f-9ee1f34e35b9", "age", ">=65", "7b555aaf-e824-4f75-a2dd-1ea59ec92310"},
+	{"6579e01b-87ab-4e08-b4c5-86ecd50204fc", "employment_status", "unemployed", "812b056b-8d12-472f-ac9b-419715a55b94"},
+	{"6a04a785-71df-4fb5-9a65-ae0d7b007601", "has_children", "true", "c8296def-bd88-40d5-8071-b13ff147fb64"},
+	{"c391c72e-3bb7-4198-a436-039295b14468", "marital_status", "single,widowed,divorce", "c8296def-bd88-40d5-8071-b13ff147fb64"},
+	{"d87e9eae-b1a8-4cdc-8b96-5e0fa8c7297f", "employment_status", "unemployed", "c8c699a7-8d59-40d7-8f9f-7f361804be40"},
+	{"f257adf4-38ba-4fd3-a275-2ed4cc36ec7e", "has_children", "true", "c8c699a7-8d59-40d7-8f9f-7f361804be40"},
+}
+
+// sampleBenefits are the benefits inserted by the seeder migration, with their amounts in whole dollars
+var sampleBenefits = []struct {
+	id       string
+	schemeID string
+	name     string
+	amount   int64
+}{
+	{"0c4d2f12-fb86-4d8b-a165-4c6590e3decb", "7b555aaf-e824-4f75-a2dd-1ea59ec92310", "Transport Allowance", 150},
+	{"3352879d-1f0b-40f2-8611-c3985e750220", "7b555aaf-e824-4f75-a2dd-1ea59ec92310", "Medical Subsidy", 300},
+	{"39720afc-8f3a-46a7-9685-4be03cdaee9b", "c8c699a7-8d59-40d7-8f9f-7f361804be40", "CDC Vouchers", 300},
+	{"585937e1-011a-42e6-b829-4c257a748874", "c8c699a7-8d59-40d7-8f9f-7f361804be40", "SkillsFuture Credits", 500},
+	{"83886c2c-eab9-4abb-b313-02be0e4cd21e", "c8296def-bd88-40d5-8071-b13ff147fb64", "Housing Allowance", 600},
+	{"91dd4122-6252-4db6-8430-582498ffe770", "812b056b-8d12-472f-ac9b-419715a55b94", "SkillsFuture Credits", 500},
+	{"ae72694b-d5a2-41de-b5f6-f2085ceaffce", "c8296def-bd88-40d5-8071-b13ff147fb64", "Childcare Subsidy", 400},
+	{"c1643b56-a639-48dd-8743-e245141e179d", "c8c699a7-8d59-40d7-8f9f-7f361804be40", "School Meal Vouchers", 200},
+	{"dfe6bef1-2d86-4b62-812b-72c09df1bbc3", "812b056b-8d12-472f-ac9b-419715a55b94", "CDC Vouchers", 300},
+}
+
+// sampleBenefitCriteria are the benefit criteria inserted by the seeder migration
+var sampleBenefitCriteria = []struct {
+	id        string
+	name      string
+	value     string
+	benefitID string
+}{
+	{"1ec87355-dc32-4496-b902-4de5b5114a96", "has_primary_school_children", "true", "c1643b56-a639-48dd-8743-e245141e179d"},
+}
+
+// sampleApplications are the applications inserted by the seeder migration
+var sampleApplications = []struct {
+	id          string
+	applicantID string
+	schemeID    string
+}{
+	{"fe897b4f-568b-4ea1-8d95-99a91c97faf2", "c85062f2-e306-4ecd-b586-a3dcbb03deaf", "812b056b-8d12-472f-ac9b-419715a55b94"},
+}
+
+// SeedSampleData adds the rows the seeder migration inserts into the postgres database, with the same IDs, unless they
+// are already in the store, and reports whether they were added. Like the migrations, it publishes the first version of
+// each sample scheme and assesses the sample application against it, and records nothing in the audit log.
+func (s *Store) SeedSampleData(ctx context.Context) (bool, error) {
+	seeded := false
+
+	err := s.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, exists := s.data.Applicants[uuid.MustParse(sampleApplicants[0].id)]; exists {
+			return nil
+		}
+
+		createdAt := now()
+
+		for _, a := range sampleApplicants {
+			dateOfBirth, err := time.Parse(time.DateOnly, a.dateOfBirth)
+			if err != nil {
+				return err
+			}
+
+			s.data.Applicants[uuid.MustParse(a.id)] = domain.Applicant{
+				ID:               ptr(uuid.MustParse(a.id)),
+				Name:             ptr(a.name),
+				EmploymentStatus: ptr(a.employmentStatus),
+				MaritalStatus:    ptr(a.maritalStatus),
+				Sex:              ptr(a.sex),
+				DateOfBirth:      &dateOfBirth,
+				HasDisability:    ptr(false),
+				CreatedAt:        createdAt,
+				UpdatedAt:        createdAt,
+			}
+		}
+
+		for _, r := range sampleRelationships {
+			s.data.Relationships[uuid.MustParse(r.id)] = domain.Relationship{
+				ID:               ptr(uuid.MustParse(r.id)),
+				ApplicantAID:     ptr(uuid.MustParse(r.applicantAID)),
+				ApplicantBID:     ptr(uuid.MustParse(r.applicantBID)),
+				RelationshipType: ptr(r.relationshipType),
+				CreatedAt:        createdAt,
+				UpdatedAt:        createdAt,
+			}
+		}
+
+		for _, sc := range sampleSchemes {
+			s.data.Schemes[uuid.MustParse(sc.id)] = domain.Scheme{
+				ID:                  ptr(uuid.MustParse(sc.id)),
+				Name:                ptr(sc.name),
+				ReapplyCooldownDays: ptr(0),
+				CreatedAt:           createdAt,
+				UpdatedAt:           createdAt,
+			}
+		}
+
+		for _, c := range sampleSchemeCriteria {
+			s.data.SchemeCriteria[uuid.MustParse(c.id)] = domain.SchemeCriteria{
+				ID:        ptr(uuid.MustParse(c.id)),
+				SchemeID:  ptr(uuid.MustParse(c.schemeID)),
+				Name:      ptr(c.name),
+				Value:     ptr(c.value),
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+			}
+		}
+
+		for _, b := range sampleBenefits {
+			s.data.Benefits[uuid.MustParse(b.id)] = domain.Benefit{
+				ID:        ptr(uuid.MustParse(b.id)),
+				SchemeID:  ptr(uuid.MustParse(b.schemeID)),
+				Name:      ptr(b.name),
+				Amount:    &domain.Money{Cents: b.amount * 100, Currency: domain.DefaultCurrency},
+				Frequency: ptr(domain.BenefitFrequencyOneOff),
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+			}
+		}
+
+		for _, c := range sampleBenefitCriteria {
+			s.data.BenefitCriteria[uuid.MustParse(c.id)] = domain.BenefitCriteria{
+				ID:        ptr(uuid.MustParse(c.id)),
+				BenefitID: ptr(uuid.MustParse(c.benefitID)),
+				Name:      ptr(c.name),
+				Value:     ptr(c.value),
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+			}
+		}
+
+		schemes := SchemeRepository{store: s}
+		versions := make(map[uuid.UUID]uuid.UUID, len(sampleSchemes))
+		for _, sc := range sampleSchemes {
+			schemeID := uuid.MustParse(sc.id)
+			id, _ := newID()
+
+			definition := schemes.assembleScheme(s.data.Schemes[schemeID])
+			definition.VersionID, definition.Version = id, ptr(1)
+			definition.CreatedAt, definition.UpdatedAt = nil, nil
+
+			s.data.SchemeVersions[*id] = domain.SchemeVersion{
+				ID:            id,
+				SchemeID:      &schemeID,
+				Version:       ptr(1),
+				Status:        ptr(domain.SchemeVersionStatusPublished),
+				EffectiveFrom: date(createdAt),
+				Definition:    &definition,
+				PublishedAt:   createdAt,
+				CreatedAt:     createdAt,
+				UpdatedAt:     createdAt,
+			}
+			s.data.SchemeVersionNumbers[schemeID] = 1
+			versions[schemeID] = *id
+		}
+
+		for _, a := range sampleApplications {
+			schemeID := uuid.MustParse(a.schemeID)
+			s.data.Applications[uuid.MustParse(a.id)] = domain.Application{
+				ID:              ptr(uuid.MustParse(a.id)),
+				ApplicantID:     ptr(uuid.MustParse(a.applicantID)),
+				SchemeID:        &schemeID,
+				SchemeVersionID: ptr(versions[schemeID]),
+				Status:          ptr(domain.ApplicationStatusSubmitted),
+				CreatedAt:       createdAt,
+				UpdatedAt:       createdAt,
+			}
+		}
+
+		seeded = true
+		return nil
+	})
+
+	return seeded, err
+}
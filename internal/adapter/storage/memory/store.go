@@ -0,0 +1,197 @@
+package memory
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// tables holds every entity kept by a Store, keyed by ID. Soft deleted entities are removed from their table.
+type tables struct {
+	Applicants      map[uuid.UUID]domain.Applicant
+	Relationships   map[uuid.UUID]domain.Relationship
+	Schemes         map[uuid.UUID]domain.Scheme
+	Benefits        map[uuid.UUID]domain.Benefit
+	BenefitCriteria map[uuid.UUID]domain.BenefitCriteria
+	SchemeCriteria  map[uuid.UUID]domain.SchemeCriteria
+	CriteriaGroups  map[uuid.UUID]domain.SchemeCriteriaGroup
+	SchemeVersions  map[uuid.UUID]domain.SchemeVersion
+	Applications    map[uuid.UUID]domain.Application
+	Disbursements   map[uuid.UUID]domain.Disbursement
+	Users           map[uuid.UUID]domain.User
+	AuditLogs       []domain.AuditLog
+
+	// SchemeVersionNumbers is the last version number given to each scheme, so that the number of a deleted draft
+	// is not given to another version
+	SchemeVersionNumbers map[uuid.UUID]int
+}
+
+// Store keeps the data of the in-memory repositories. It implements port.Transactor, so the services can group
+// repository calls into transactions just as they do with the postgres database.
+//
+// Every exported repository method holds the store for the length of the call, or joins the transaction of its context,
+// and records the changes it makes in the audit log. The unexported helpers of the repositories must be called while
+// holding the store.
+type Store struct {
+	mu   sync.Mutex
+	data *tables
+}
+
+// NewStore creates an empty Store.
+func NewStore() *Store {
+	return &Store{
+		data: &tables{
+			Applicants:      map[uuid.UUID]domain.Applicant{},
+			Relationships:   map[uuid.UUID]domain.Relationship{},
+			Schemes:         map[uuid.UUID]domain.Scheme{},
+			Benefits:        map[uuid.UUID]domain.Benefit{},
+			BenefitCriteria: map[uuid.UUID]domain.BenefitCriteria{},
+			SchemeCriteria:  map[uuid.UUID]domain.SchemeCriteria{},
+			CriteriaGroups:  map[uuid.UUID]domain.SchemeCriteriaGroup{},
+			SchemeVersions:  map[uuid.UUID]domain.SchemeVersion{},
+			Applications:    map[uuid.UUID]domain.Application{},
+			Disbursements:   map[uuid.UUID]domain.Disbursement{},
+			Users:           map[uuid.UUID]domain.User{},
+			AuditLogs:       []domain.AuditLog{},
+
+			SchemeVersionNumbers: map[uuid.UUID]int{},
+		},
+	}
+}
+
+// txContextKey is the context key of the store a context holds a transaction on
+type txContextKey struct{}
+
+// WithinTransaction runs fn while holding the store, so that no other call can see or change its data until fn returns.
+// The data is restored to what it was before fn if fn returns an error. Calls made with a context that is already
+// in a transaction join that transaction.
+func (s *Store) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.inTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := clone(*s.data)
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, s)); err != nil {
+		*s.data = saved
+		return err
+	}
+
+	return nil
+}
+
+// CheckReadiness always succeeds, as the store is kept in memory.
+func (s *Store) CheckReadiness(ctx context.Context) error {
+	return nil
+}
+
+// lock holds the store for a single repository call and returns the function that releases it.
+// Calls made inside a transaction already hold the store.
+func (s *Store) lock(ctx context.Context) func() {
+	if s.inTransaction(ctx) {
+		return func() {}
+	}
+
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// inTransaction reports whether ctx is in a transaction on this store
+func (s *Store) inTransaction(ctx context.Context) bool {
+	store, _ := ctx.Value(txContextKey{}).(*Store)
+	return store == s
+}
+
+// newID returns a new ID and the current time, for a created entity
+func newID() (*uuid.UUID, *time.Time) {
+	id := uuid.New()
+	return &id, now()
+}
+
+// now returns a pointer to the current time in UTC to the millisecond, the way timestamps are read back from the database
+func now() *time.Time {
+	return ptr(time.Now().UTC().Round(time.Millisecond))
+}
+
+// ptr returns a pointer to v
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// date returns the date of t at midnight UTC, the way dates are read back from the database
+func date(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	y, m, d := t.Date()
+	dt := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return &dt
+}
+
+// clone returns a deep copy of v, so that entities handed to and returned from the repositories never share memory
+// with the entities kept in the store.
+func clone[T any](v T) T {
+	return deepCopy(reflect.ValueOf(&v).Elem()).Interface().(T)
+}
+
+// deepCopy copies a value together with everything its pointers, slices and maps refer to
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem()))
+		return c
+
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return c
+
+	default:
+		return v
+	}
+}
@@ -0,0 +1,150 @@
+package memory
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// UserRepository provides methods for interacting with the users kept in a Store.
+type UserRepository struct {
+	store *Store
+}
+
+// NewUserRepository creates a new instance of UserRepository using the provided store.
+func NewUserRepository(store *Store) *UserRepository {
+	return &UserRepository{store: store}
+}
+
+// userSortKeys maps the fields users can be sorted by to their values
+var userSortKeys = sortKeys[domain.User]{
+	"created_at": func(u domain.User) any { return u.CreatedAt },
+}
+
+// GetUserByID retrieves a user by their ID, or returns domain.UserNotFoundError if not found.
+func (r *UserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	defer r.store.lock(ctx)()
+
+	user, ok := r.store.data.Users[id]
+	if !ok {
+		return nil, domain.UserNotFoundError
+	}
+
+	return ptr(clone(user)), nil
+}
+
+// GetUserByEmail retrieves a user by their email address, ignoring case, or returns domain.UserNotFoundError if not found.
+func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	defer r.store.lock(ctx)()
+
+	user := r.findUserByEmail(email)
+	if user == nil {
+		return nil, domain.UserNotFoundError
+	}
+
+	return ptr(clone(*user)), nil
+}
+
+// ListUsers retrieves all users, newest first.
+func (r *UserRepository) ListUsers(ctx context.Context) ([]domain.User, error) {
+	defer r.store.lock(ctx)()
+
+	users := make([]domain.User, 0, len(r.store.data.Users))
+	for _, user := range r.store.data.Users {
+		users = append(users, clone(user))
+	}
+
+	users, _, err := listPage(users, domain.ListOptions{}, userSortKeys, func(u domain.User) uuid.UUID { return *u.ID })
+	return users, err
+}
+
+// CountUsers returns the number of users.
+func (r *UserRepository) CountUsers(ctx context.Context) (int, error) {
+	defer r.store.lock(ctx)()
+
+	return len(r.store.data.Users), nil
+}
+
+// CreateUser adds a new user to the store and returns the created user.
+// Returns domain.DuplicateUserEmailError if another user has the same email address.
+func (r *UserRepository) CreateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+	defer r.store.lock(ctx)()
+
+	if r.findUserByEmail(*user.Email) != nil {
+		return nil, domain.DuplicateUserEmailError
+	}
+
+	u := clone(*user)
+	u.ID, u.CreatedAt = newID()
+	u.UpdatedAt = u.CreatedAt
+
+	r.store.data.Users[*u.ID] = u
+	r.store.record(ctx, domain.AuditEntityUser, *u.ID, domain.AuditActionCreate, nil, u)
+
+	return ptr(clone(u)), nil
+}
+
+// UpdateUser updates the given fields of an existing user and returns the updated user.
+// Password changes are recorded in the audit log without the password hash.
+func (r *UserRepository) UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+	if user.Email == nil && user.Name == nil && user.PasswordHash == nil && user.Role == nil {
+		return nil, domain.NoUpdateFieldsError
+	}
+
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.Users[*user.ID]
+	if !ok {
+		return nil, domain.UserNotFoundError
+	}
+
+	u := clone(before)
+	update := clone(*user)
+	if update.Email != nil {
+		if other := r.findUserByEmail(*update.Email); other != nil && *other.ID != *u.ID {
+			return nil, domain.DuplicateUserEmailError
+		}
+		u.Email = update.Email
+	}
+	if update.Name != nil {
+		u.Name = update.Name
+	}
+	if update.PasswordHash != nil {
+		u.PasswordHash = update.PasswordHash
+	}
+	if update.Role != nil {
+		u.Role = update.Role
+	}
+	u.UpdatedAt = now()
+
+	r.store.data.Users[*u.ID] = u
+	r.store.record(ctx, domain.AuditEntityUser, *u.ID, domain.AuditActionUpdate, before, u)
+
+	return ptr(clone(u)), nil
+}
+
+// DeleteUser deletes a user by their ID.
+func (r *UserRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	defer r.store.lock(ctx)()
+
+	before, ok := r.store.data.Users[id]
+	if !ok {
+		return nil
+	}
+
+	delete(r.store.data.Users, id)
+	r.store.record(ctx, domain.AuditEntityUser, id, domain.AuditActionDelete, before, nil)
+
+	return nil
+}
+
+// findUserByEmail returns the user with an email address, ignoring case, or nil if there is none.
+func (r *UserRepository) findUserByEmail(email string) *domain.User {
+	for _, user := range r.store.data.Users {
+		if strings.EqualFold(*user.Email, email) {
+			return &user
+		}
+	}
+	return nil
+}
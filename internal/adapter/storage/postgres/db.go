@@ -6,8 +6,21 @@ import (
 	"fmt"
 	"github.com/Masterminds/squirrel"
 	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/tracelog"
+	"log/slog"
+	"net"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// UniqueViolationErrorCode is the error code returned by postgres when a unique constraint is violated
+	UniqueViolationErrorCode = "23505"
+	// UndefinedTableErrorCode is the error code returned by postgres when a query refers to a table that does not exist
+	UndefinedTableErrorCode = "42P01"
 )
 
 // DB represents a database abstraction layer combining a connection pool and query builder utilities.
@@ -18,15 +31,15 @@ type DB struct {
 }
 
 func New(ctx context.Context, config *config.Config) (*DB, error) {
-	url := fmt.Sprintf("postgres://%s:%s@%s:%d/%s",
-		config.DBUser,
-		config.DBPassword,
-		config.DBHost,
-		config.DBPort,
-		config.DBName,
-	)
+	dbURL := databaseURL("postgres", config)
 
-	db, err := pgxpool.New(ctx, url)
+	poolConfig, err := pgxpool.ParseConfig(dbURL)
+	if err != nil {
+		return nil, err
+	}
+	poolConfig.ConnConfig.Tracer = newQueryTracer()
+
+	db, err := pgxpool.NewWithConfig(ctx, poolConfig)
 
 	if err != nil {
 		return nil, err
@@ -42,17 +55,70 @@ func New(ctx context.Context, config *config.Config) (*DB, error) {
 	return &DB{
 		db,
 		&psql,
-		url,
+		dbURL,
 	}, nil
 }
 
+// newQueryTracer returns a tracer that logs the queries made to the database at the debug level.
+// The query arguments are left out, since they include password hashes and personal details of applicants.
+func newQueryTracer() *tracelog.TraceLog {
+	logLevel := tracelog.LogLevelNone
+	if slog.Default().Enabled(context.Background(), slog.LevelDebug) {
+		logLevel = tracelog.LogLevelInfo
+	}
+
+	logger := tracelog.LoggerFunc(func(ctx context.Context, level tracelog.LogLevel, msg string, data map[string]any) {
+		attrs := make([]slog.Attr, 0, len(data))
+		for key, value := range data {
+			if key != "args" {
+				attrs = append(attrs, slog.Any(key, value))
+			}
+		}
+
+		slog.LogAttrs(ctx, slog.LevelDebug, msg, attrs...)
+	})
+
+	return &tracelog.TraceLog{Logger: logger, LogLevel: logLevel}
+}
+
+// databaseURL returns the URL of the database in the config with the given scheme
+func databaseURL(scheme string, config *config.Config) string {
+	u := url.URL{
+		Scheme: scheme,
+		User:   url.UserPassword(config.DBUser, config.DBPassword),
+		Host:   net.JoinHostPort(config.DBHost, strconv.Itoa(int(config.DBPort))),
+		Path:   config.DBName,
+	}
+	return u.String()
+}
+
 // ErrorCode returns the error code of the given error
 func (db *DB) ErrorCode(err error) string {
 	var pgErr *pgconn.PgError
-	errors.As(err, &pgErr)
+	if !errors.As(err, &pgErr) {
+		return ""
+	}
 	return pgErr.Code
 }
 
+// CheckReadiness checks that the database can be reached and that every migration in the binary has been applied to it.
+func (db *DB) CheckReadiness(ctx context.Context) error {
+	if err := db.Ping(ctx); err != nil {
+		return fmt.Errorf("%w: %w", domain.DatabaseUnavailableError, err)
+	}
+
+	status, err := db.MigrationStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain.DatabaseUnavailableError, err)
+	}
+
+	if !status.UpToDate() {
+		return fmt.Errorf("%w: at version %d (dirty: %t), latest is %d", domain.OutdatedSchemaError, status.Version, status.Dirty, status.Latest)
+	}
+
+	return nil
+}
+
 // Close closes the database connection
 func (db *DB) Close() {
 	db.Pool.Close()
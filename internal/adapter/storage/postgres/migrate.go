@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/config"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5" // Registers the pgx5 database driver
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5"
+	"io/fs"
+)
+
+// migrations holds the SQL migrations of the database, so that the binary can migrate the database without the source tree
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// MigrationStatus describes the version of the database schema compared to the latest migration in the binary.
+type MigrationStatus struct {
+	// Version is the version of the last migration applied to the database, or 0 if none have been applied
+	Version uint
+	// Dirty is true if the last migration failed part way and the database must be fixed by hand
+	Dirty bool
+	// Latest is the version of the latest migration in the binary
+	Latest uint
+}
+
+// UpToDate reports whether every migration in the binary has been applied to the database.
+func (s MigrationStatus) UpToDate() bool {
+	return !s.Dirty && s.Version >= s.Latest
+}
+
+// Migrator applies the migrations embedded in the binary to the database.
+type Migrator struct {
+	m      *migrate.Migrate
+	source source.Driver
+}
+
+// NewMigrator connects to the database in the config to migrate it.
+// The migrations applied are recorded in the schema_migrations table, the same way the golang-migrate CLI records them.
+func NewMigrator(config *config.Config) (*Migrator, error) {
+	src, err := iofs.New(migrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := migrate.NewWithSourceInstance("iofs", src, databaseURL("pgx5", config))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{m: m, source: src}, nil
+}
+
+// Up applies every migration that has not been applied yet.
+func (m *Migrator) Up() error {
+	return ignoreNoChange(m.m.Up())
+}
+
+// Down reverts the given number of the last applied migrations.
+func (m *Migrator) Down(steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("number of migrations to revert must be at least 1")
+	}
+
+	return ignoreNoChange(m.m.Steps(-steps))
+}
+
+// To applies or reverts migrations until the database is at the given version.
+func (m *Migrator) To(version uint) error {
+	return ignoreNoChange(m.m.Migrate(version))
+}
+
+// Status returns the version of the database schema and of the latest migration in the binary.
+func (m *Migrator) Status() (*MigrationStatus, error) {
+	latest, err := latestVersion(m.source)
+	if err != nil {
+		return nil, err
+	}
+
+	version, dirty, err := m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return &MigrationStatus{Latest: latest}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &MigrationStatus{Version: version, Dirty: dirty, Latest: latest}, nil
+}
+
+// latestVersion returns the version of the last migration in the source
+func latestVersion(src source.Driver) (uint, error) {
+	version, err := src.First()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		next, err := src.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+}
+
+// MigrationStatus returns the version of the database schema and of the latest migration in the binary,
+// reading the version recorded by the migrator without taking its lock.
+func (db *DB) MigrationStatus(ctx context.Context) (*MigrationStatus, error) {
+	src, err := iofs.New(migrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	latest, err := latestVersion(src)
+	if err != nil {
+		return nil, err
+	}
+
+	status := MigrationStatus{Latest: latest}
+
+	err = db.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&status.Version, &status.Dirty)
+	// The table is created when the database is first migrated, and is left empty once every migration is reverted
+	if errors.Is(err, pgx.ErrNoRows) || db.ErrorCode(err) == UndefinedTableErrorCode {
+		return &status, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// Close closes the connection to the database.
+func (m *Migrator) Close() error {
+	sourceErr, databaseErr := m.m.Close()
+	return errors.Join(sourceErr, databaseErr)
+}
+
+// ignoreNoChange treats finding no migrations to apply or revert as success
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
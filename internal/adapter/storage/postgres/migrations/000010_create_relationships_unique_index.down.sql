@@ -0,0 +1,2 @@
+-- Drop index
+DROP INDEX IF EXISTS uq_relationships_applicants;
\ No newline at end of file
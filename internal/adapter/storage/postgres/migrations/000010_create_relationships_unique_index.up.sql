@@ -0,0 +1,13 @@
+-- Relationships used to be allowed more than once per pair of applicants. Keep the latest relationship of each pair,
+-- and delete the others.
+UPDATE relationships
+SET deleted_at = now()
+WHERE id IN (SELECT id
+             FROM (SELECT id,
+                          row_number() OVER (PARTITION BY applicant_a_id, applicant_b_id ORDER BY created_at DESC, id DESC) AS recency
+                   FROM relationships
+                   WHERE deleted_at IS NULL) AS live
+             WHERE recency > 1);
+
+-- Prevent the same pair of applicants from being linked more than once
+CREATE UNIQUE INDEX uq_relationships_applicants ON relationships (applicant_a_id, applicant_b_id) WHERE deleted_at IS NULL;
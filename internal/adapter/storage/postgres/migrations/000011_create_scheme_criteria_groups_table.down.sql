@@ -0,0 +1,9 @@
+-- Remove group from scheme_criteria
+ALTER TABLE scheme_criteria
+    DROP COLUMN IF EXISTS group_id;
+
+-- Drop table
+DROP TABLE IF EXISTS scheme_criteria_groups;
+
+-- Drop type
+DROP TYPE IF EXISTS criteria_group_operator;
\ No newline at end of file
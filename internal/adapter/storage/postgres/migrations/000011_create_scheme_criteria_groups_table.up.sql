@@ -0,0 +1,34 @@
+-- Create criteria group operator type
+CREATE TYPE criteria_group_operator AS ENUM ('and', 'or', 'not');
+
+-- Create scheme_criteria_groups table
+CREATE TABLE IF NOT EXISTS scheme_criteria_groups
+(
+    id              UUID PRIMARY KEY,
+    created_at      TIMESTAMP(3) NOT NULL,
+    updated_at      TIMESTAMP(3) NOT NULL,
+    deleted_at      TIMESTAMP(3),
+    scheme_id       UUID NOT NULL,
+    parent_group_id UUID,
+    operator        criteria_group_operator NOT NULL,
+    CONSTRAINT fk_schemes_criteria_groups FOREIGN KEY (scheme_id) REFERENCES schemes (id),
+    CONSTRAINT fk_scheme_criteria_groups_parent FOREIGN KEY (parent_group_id) REFERENCES scheme_criteria_groups (id)
+);
+
+CREATE INDEX idx_scheme_criteria_groups_deleted_at ON scheme_criteria_groups (deleted_at);
+CREATE INDEX fk_schemes_criteria_groups ON scheme_criteria_groups (scheme_id);
+CREATE INDEX fk_scheme_criteria_groups_parent ON scheme_criteria_groups (parent_group_id);
+
+-- Create triggers for the scheme_criteria_groups table
+CREATE TRIGGER set_timestamps
+    BEFORE INSERT OR UPDATE
+    ON scheme_criteria_groups
+    FOR EACH ROW
+EXECUTE FUNCTION update_timestamps();
+
+-- Criteria without a group belong to the root of the scheme's criteria tree
+ALTER TABLE scheme_criteria
+    ADD COLUMN group_id UUID,
+    ADD CONSTRAINT fk_scheme_criteria_groups FOREIGN KEY (group_id) REFERENCES scheme_criteria_groups (id);
+
+CREATE INDEX fk_scheme_criteria_groups ON scheme_criteria (group_id);
\ No newline at end of file
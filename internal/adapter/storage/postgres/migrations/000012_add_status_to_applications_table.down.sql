@@ -0,0 +1,9 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_applications_status;
+
+-- Remove status from applications
+ALTER TABLE applications
+    DROP COLUMN IF EXISTS status;
+
+-- Drop type
+DROP TYPE IF EXISTS application_status;
\ No newline at end of file
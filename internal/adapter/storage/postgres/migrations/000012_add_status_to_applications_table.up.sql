@@ -0,0 +1,8 @@
+-- Create application status type
+CREATE TYPE application_status AS ENUM ('submitted', 'under_review', 'approved', 'rejected', 'withdrawn', 'disbursed');
+
+-- Existing applications start out as submitted
+ALTER TABLE applications
+    ADD COLUMN status application_status NOT NULL DEFAULT 'submitted';
+
+CREATE INDEX idx_applications_status ON applications (status);
\ No newline at end of file
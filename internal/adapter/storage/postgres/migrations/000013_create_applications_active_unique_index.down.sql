@@ -0,0 +1,2 @@
+-- Drop unique index
+DROP INDEX IF EXISTS uq_applications_active_applicant_scheme;
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- Applications used to be allowed more than once per applicant and scheme, and they all became submitted when statuses
+-- were added. Keep the latest active application of each applicant and scheme, and withdraw the others.
+UPDATE applications
+SET status = 'withdrawn'
+WHERE id IN (SELECT id
+             FROM (SELECT id,
+                          row_number() OVER (PARTITION BY applicant_id, scheme_id ORDER BY created_at DESC, id DESC) AS recency
+                   FROM applications
+                   WHERE deleted_at IS NULL
+                     AND status IN ('submitted', 'under_review', 'approved')) AS active
+             WHERE recency > 1);
+
+-- An applicant can only have one active application per scheme
+CREATE UNIQUE INDEX uq_applications_active_applicant_scheme ON applications (applicant_id, scheme_id)
+    WHERE deleted_at IS NULL AND status IN ('submitted', 'under_review', 'approved');
@@ -0,0 +1,3 @@
+-- Remove reapply cooldown from schemes
+ALTER TABLE schemes
+    DROP COLUMN IF EXISTS reapply_cooldown_days;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- Number of days an applicant must wait after a rejected or disbursed application before applying to the scheme again
+ALTER TABLE schemes
+    ADD COLUMN reapply_cooldown_days INTEGER NOT NULL DEFAULT 0 CHECK (reapply_cooldown_days >= 0);
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Drop table
+DROP TABLE IF EXISTS users;
+
+-- Drop type
+DROP TYPE IF EXISTS user_role;
\ No newline at end of file
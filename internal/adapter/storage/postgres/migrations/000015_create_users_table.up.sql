@@ -0,0 +1,27 @@
+-- Create user role type
+CREATE TYPE user_role AS ENUM ('viewer', 'caseworker', 'scheme_admin', 'superadmin');
+
+-- Create users table
+CREATE TABLE IF NOT EXISTS users
+(
+    id            UUID PRIMARY KEY,
+    created_at    TIMESTAMP(3) NOT NULL,
+    updated_at    TIMESTAMP(3) NOT NULL,
+    deleted_at    TIMESTAMP(3),
+    email         TEXT NOT NULL,
+    name          TEXT NOT NULL,
+    password_hash TEXT NOT NULL,
+    role          user_role DEFAULT 'viewer' NOT NULL
+);
+
+CREATE INDEX idx_users_deleted_at ON users (deleted_at);
+
+-- Email addresses are unique regardless of case
+CREATE UNIQUE INDEX uq_users_email ON users (lower(email)) WHERE deleted_at IS NULL;
+
+-- Create triggers for the users table
+CREATE TRIGGER set_timestamps
+    BEFORE INSERT OR UPDATE
+    ON users
+    FOR EACH ROW
+EXECUTE FUNCTION update_timestamps();
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- Drop table
+DROP TABLE IF EXISTS audit_logs;
+
+-- Drop function
+DROP FUNCTION IF EXISTS prevent_audit_log_changes;
+
+-- Drop type
+DROP TYPE IF EXISTS audit_action;
\ No newline at end of file
@@ -0,0 +1,43 @@
+-- Create audit action type
+CREATE TYPE audit_action AS ENUM ('create', 'update', 'delete');
+
+-- Create audit logs table
+CREATE TABLE IF NOT EXISTS audit_logs
+(
+    id          UUID PRIMARY KEY,
+    created_at  TIMESTAMP(3) NOT NULL,
+    actor_id    UUID,
+    actor_role  user_role,
+    entity_type TEXT NOT NULL,
+    entity_id   UUID NOT NULL,
+    action      audit_action NOT NULL,
+    old_values  JSONB,
+    new_values  JSONB,
+    CONSTRAINT fk_audit_logs_actor FOREIGN KEY (actor_id) REFERENCES users (id)
+);
+
+CREATE INDEX idx_audit_logs_entity ON audit_logs (entity_type, entity_id);
+CREATE INDEX idx_audit_logs_actor_id ON audit_logs (actor_id);
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at);
+
+-- Create trigger function that rejects changes to audit log entries
+CREATE OR REPLACE FUNCTION prevent_audit_log_changes()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit log entries are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create triggers for the audit logs table, so that entries can only be inserted
+CREATE TRIGGER prevent_changes
+    BEFORE UPDATE OR DELETE
+    ON audit_logs
+    FOR EACH ROW
+EXECUTE FUNCTION prevent_audit_log_changes();
+
+CREATE TRIGGER prevent_truncate
+    BEFORE TRUNCATE
+    ON audit_logs
+    FOR EACH STATEMENT
+EXECUTE FUNCTION prevent_audit_log_changes();
\ No newline at end of file
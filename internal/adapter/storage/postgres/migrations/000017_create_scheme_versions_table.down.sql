@@ -0,0 +1,15 @@
+-- Remove scheme version from applications
+DROP INDEX IF EXISTS fk_applications_scheme_version;
+
+ALTER TABLE applications
+    DROP COLUMN IF EXISTS scheme_version_id;
+
+-- Drop table
+DROP TABLE IF EXISTS scheme_versions;
+
+-- Drop functions
+DROP FUNCTION IF EXISTS prevent_published_scheme_version_changes;
+DROP FUNCTION IF EXISTS scheme_definition;
+
+-- Drop type
+DROP TYPE IF EXISTS scheme_version_status;
\ No newline at end of file
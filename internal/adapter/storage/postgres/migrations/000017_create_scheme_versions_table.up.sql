@@ -0,0 +1,115 @@
+-- Create scheme version status type
+CREATE TYPE scheme_version_status AS ENUM ('draft', 'published', 'retired');
+
+-- Create function that returns a copy of a scheme's details, benefits and criteria, as stored with published versions
+CREATE OR REPLACE FUNCTION scheme_definition(scheme UUID)
+    RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'scheme', (SELECT jsonb_build_object('id', s.id, 'name', s.name,
+                                                    'reapply_cooldown_days', s.reapply_cooldown_days)
+                          FROM schemes s
+                          WHERE s.id = scheme),
+               'benefits', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', b.id, 'scheme_id', b.scheme_id,
+                                                                         'name', b.name, 'amount', b.amount)
+                                                      ORDER BY b.created_at), '[]'::jsonb)
+                            FROM benefits b
+                            WHERE b.scheme_id = scheme AND b.deleted_at IS NULL),
+               'benefit_criteria', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', bc.id, 'benefit_id', bc.benefit_id,
+                                                                                 'name', bc.name, 'value', bc.value)
+                                                              ORDER BY bc.created_at), '[]'::jsonb)
+                                    FROM benefit_criteria bc
+                                             JOIN benefits b ON bc.benefit_id = b.id AND b.deleted_at IS NULL
+                                    WHERE b.scheme_id = scheme AND bc.deleted_at IS NULL),
+               'criteria', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', sc.id, 'scheme_id', sc.scheme_id,
+                                                                         'group_id', sc.group_id, 'name', sc.name,
+                                                                         'value', sc.value)
+                                                      ORDER BY sc.created_at), '[]'::jsonb)
+                            FROM scheme_criteria sc
+                            WHERE sc.scheme_id = scheme AND sc.deleted_at IS NULL),
+               'criteria_groups', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', g.id, 'scheme_id', g.scheme_id,
+                                                                                'parent_group_id', g.parent_group_id,
+                                                                                'operator', g.operator)
+                                                             ORDER BY g.created_at), '[]'::jsonb)
+                                   FROM scheme_criteria_groups g
+                                   WHERE g.scheme_id = scheme AND g.deleted_at IS NULL)
+       );
+$$ LANGUAGE sql STABLE;
+
+-- Create scheme_versions table
+CREATE TABLE IF NOT EXISTS scheme_versions
+(
+    id             UUID PRIMARY KEY,
+    created_at     TIMESTAMP(3) NOT NULL,
+    updated_at     TIMESTAMP(3) NOT NULL,
+    deleted_at     TIMESTAMP(3),
+    scheme_id      UUID NOT NULL,
+    version        INTEGER NOT NULL,
+    status         scheme_version_status DEFAULT 'draft' NOT NULL,
+    effective_from DATE NOT NULL,
+    effective_to   DATE,
+    definition     JSONB,
+    published_at   TIMESTAMP(3),
+    retired_at     TIMESTAMP(3),
+    CONSTRAINT fk_scheme_versions_scheme FOREIGN KEY (scheme_id) REFERENCES schemes (id),
+    CONSTRAINT uq_scheme_versions_scheme_version UNIQUE (scheme_id, version),
+    CONSTRAINT chk_scheme_versions_effective_dates CHECK (effective_to IS NULL OR effective_to > effective_from),
+    CONSTRAINT chk_scheme_versions_definition CHECK (status = 'draft' OR definition IS NOT NULL)
+);
+
+CREATE INDEX idx_scheme_versions_deleted_at ON scheme_versions (deleted_at);
+CREATE INDEX fk_scheme_versions_scheme ON scheme_versions (scheme_id);
+CREATE INDEX idx_scheme_versions_effective ON scheme_versions (status, effective_from, effective_to);
+
+-- A scheme can only have one draft version at a time
+CREATE UNIQUE INDEX uq_scheme_versions_draft ON scheme_versions (scheme_id)
+    WHERE deleted_at IS NULL AND status = 'draft';
+
+-- Create triggers for the scheme_versions table
+CREATE TRIGGER set_timestamps
+    BEFORE INSERT OR UPDATE
+    ON scheme_versions
+    FOR EACH ROW
+EXECUTE FUNCTION update_timestamps();
+
+-- Create trigger function that rejects changes to published versions, other than ending or retiring them
+CREATE OR REPLACE FUNCTION prevent_published_scheme_version_changes()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF OLD.status <> 'draft' AND (NEW.scheme_id IS DISTINCT FROM OLD.scheme_id
+        OR NEW.version IS DISTINCT FROM OLD.version
+        OR NEW.effective_from IS DISTINCT FROM OLD.effective_from
+        OR NEW.definition IS DISTINCT FROM OLD.definition
+        OR NEW.published_at IS DISTINCT FROM OLD.published_at
+        OR NEW.deleted_at IS DISTINCT FROM OLD.deleted_at) THEN
+        RAISE EXCEPTION 'published scheme versions cannot be changed';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_published_changes
+    BEFORE UPDATE
+    ON scheme_versions
+    FOR EACH ROW
+EXECUTE FUNCTION prevent_published_scheme_version_changes();
+
+-- Publish the current benefits and criteria of every existing scheme as its first version
+INSERT INTO scheme_versions (id, created_at, scheme_id, version, status, effective_from, definition, published_at)
+SELECT gen_random_uuid(), now(), s.id, 1, 'published', s.created_at::date, scheme_definition(s.id), now()
+FROM schemes s
+WHERE s.deleted_at IS NULL;
+
+-- Applications record the scheme version they were assessed against
+ALTER TABLE applications
+    ADD COLUMN scheme_version_id UUID,
+    ADD CONSTRAINT fk_applications_scheme_version FOREIGN KEY (scheme_version_id) REFERENCES scheme_versions (id);
+
+CREATE INDEX fk_applications_scheme_version ON applications (scheme_version_id);
+
+-- Existing applications were assessed against the first version of their scheme
+UPDATE applications a
+SET scheme_version_id = v.id
+FROM scheme_versions v
+WHERE v.scheme_id = a.scheme_id AND v.version = 1;
\ No newline at end of file
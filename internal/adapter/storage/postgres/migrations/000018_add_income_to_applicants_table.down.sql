@@ -0,0 +1,4 @@
+-- Remove income and assets from applicants
+ALTER TABLE applicants
+    DROP COLUMN IF EXISTS monthly_income,
+    DROP COLUMN IF EXISTS assets;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Monthly income and assets of applicants, used for means testing, stored exactly in Singapore dollars like the other
+-- amounts without a currency. Both are unknown for existing applicants.
+ALTER TABLE applicants
+    ADD COLUMN monthly_income NUMERIC(14, 2) CHECK (monthly_income >= 0),
+    ADD COLUMN assets         NUMERIC(14, 2) CHECK (assets >= 0);
\ No newline at end of file
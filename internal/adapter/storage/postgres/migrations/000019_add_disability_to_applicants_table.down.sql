@@ -0,0 +1,3 @@
+-- Remove disability from applicants
+ALTER TABLE applicants
+    DROP COLUMN IF EXISTS has_disability;
\ No newline at end of file
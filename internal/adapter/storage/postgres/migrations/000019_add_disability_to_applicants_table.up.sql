@@ -0,0 +1,3 @@
+-- Whether applicants have a disability, used for criteria about dependants. Existing applicants have none recorded.
+ALTER TABLE applicants
+    ADD COLUMN has_disability BOOLEAN NOT NULL DEFAULT false;
\ No newline at end of file
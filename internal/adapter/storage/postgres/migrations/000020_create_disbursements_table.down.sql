@@ -0,0 +1,50 @@
+-- Drop table
+DROP TABLE IF EXISTS disbursements;
+
+-- Drop type
+DROP TYPE IF EXISTS disbursement_status;
+
+-- Stop recording how benefits are paid in published versions
+CREATE OR REPLACE FUNCTION scheme_definition(scheme UUID)
+    RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'scheme', (SELECT jsonb_build_object('id', s.id, 'name', s.name,
+                                                    'reapply_cooldown_days', s.reapply_cooldown_days)
+                          FROM schemes s
+                          WHERE s.id = scheme),
+               'benefits', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', b.id, 'scheme_id', b.scheme_id,
+                                                                         'name', b.name, 'amount', b.amount)
+                                                      ORDER BY b.created_at), '[]'::jsonb)
+                            FROM benefits b
+                            WHERE b.scheme_id = scheme AND b.deleted_at IS NULL),
+               'benefit_criteria', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', bc.id, 'benefit_id', bc.benefit_id,
+                                                                                 'name', bc.name, 'value', bc.value)
+                                                              ORDER BY bc.created_at), '[]'::jsonb)
+                                    FROM benefit_criteria bc
+                                             JOIN benefits b ON bc.benefit_id = b.id AND b.deleted_at IS NULL
+                                    WHERE b.scheme_id = scheme AND bc.deleted_at IS NULL),
+               'criteria', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', sc.id, 'scheme_id', sc.scheme_id,
+                                                                         'group_id', sc.group_id, 'name', sc.name,
+                                                                         'value', sc.value)
+                                                      ORDER BY sc.created_at), '[]'::jsonb)
+                            FROM scheme_criteria sc
+                            WHERE sc.scheme_id = scheme AND sc.deleted_at IS NULL),
+               'criteria_groups', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', g.id, 'scheme_id', g.scheme_id,
+                                                                                'parent_group_id', g.parent_group_id,
+                                                                                'operator', g.operator)
+                                                             ORDER BY g.created_at), '[]'::jsonb)
+                                   FROM scheme_criteria_groups g
+                                   WHERE g.scheme_id = scheme AND g.deleted_at IS NULL)
+       );
+$$ LANGUAGE sql STABLE;
+
+-- Remove payout schedules from benefits
+ALTER TABLE benefits
+    DROP CONSTRAINT IF EXISTS chk_benefits_duration,
+    DROP COLUMN IF EXISTS frequency,
+    DROP COLUMN IF EXISTS duration_months,
+    DROP COLUMN IF EXISTS cap;
+
+-- Drop type
+DROP TYPE IF EXISTS benefit_frequency;
\ No newline at end of file
@@ -0,0 +1,82 @@
+-- Create benefit frequency type
+CREATE TYPE benefit_frequency AS ENUM ('one_off', 'monthly', 'quarterly');
+
+-- Benefits are paid once, or every month or quarter for a number of months, up to an optional cap on the total paid
+ALTER TABLE benefits
+    ADD COLUMN frequency       benefit_frequency DEFAULT 'one_off' NOT NULL,
+    ADD COLUMN duration_months INTEGER CHECK (duration_months > 0),
+    ADD COLUMN cap             DOUBLE PRECISION CHECK (cap >= 0),
+    ADD CONSTRAINT chk_benefits_duration CHECK ((frequency = 'one_off') = (duration_months IS NULL));
+
+-- Published versions also record how their benefits are paid
+CREATE OR REPLACE FUNCTION scheme_definition(scheme UUID)
+    RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'scheme', (SELECT jsonb_build_object('id', s.id, 'name', s.name,
+                                                    'reapply_cooldown_days', s.reapply_cooldown_days)
+                          FROM schemes s
+                          WHERE s.id = scheme),
+               'benefits', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', b.id, 'scheme_id', b.scheme_id,
+                                                                         'name', b.name, 'amount', b.amount,
+                                                                         'frequency', b.frequency,
+                                                                         'duration_months', b.duration_months,
+                                                                         'cap', b.cap)
+                                                      ORDER BY b.created_at), '[]'::jsonb)
+                            FROM benefits b
+                            WHERE b.scheme_id = scheme AND b.deleted_at IS NULL),
+               'benefit_criteria', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', bc.id, 'benefit_id', bc.benefit_id,
+                                                                                 'name', bc.name, 'value', bc.value)
+                                                              ORDER BY bc.created_at), '[]'::jsonb)
+                                    FROM benefit_criteria bc
+                                             JOIN benefits b ON bc.benefit_id = b.id AND b.deleted_at IS NULL
+                                    WHERE b.scheme_id = scheme AND bc.deleted_at IS NULL),
+               'criteria', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', sc.id, 'scheme_id', sc.scheme_id,
+                                                                         'group_id', sc.group_id, 'name', sc.name,
+                                                                         'value', sc.value)
+                                                      ORDER BY sc.created_at), '[]'::jsonb)
+                            FROM scheme_criteria sc
+                            WHERE sc.scheme_id = scheme AND sc.deleted_at IS NULL),
+               'criteria_groups', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', g.id, 'scheme_id', g.scheme_id,
+                                                                                'parent_group_id', g.parent_group_id,
+                                                                                'operator', g.operator)
+                                                             ORDER BY g.created_at), '[]'::jsonb)
+                                   FROM scheme_criteria_groups g
+                                   WHERE g.scheme_id = scheme AND g.deleted_at IS NULL)
+       );
+$$ LANGUAGE sql STABLE;
+
+-- Create disbursement status type
+CREATE TYPE disbursement_status AS ENUM ('scheduled', 'paid', 'failed', 'clawed_back');
+
+-- Create disbursements table, the ledger of payouts of the benefits of approved applications
+CREATE TABLE IF NOT EXISTS disbursements
+(
+    id             UUID PRIMARY KEY,
+    created_at     TIMESTAMP(3) NOT NULL,
+    updated_at     TIMESTAMP(3) NOT NULL,
+    deleted_at     TIMESTAMP(3),
+    application_id UUID NOT NULL,
+    benefit_id     UUID NOT NULL,
+    benefit_name   TEXT NOT NULL,
+    installment    INTEGER NOT NULL CHECK (installment > 0),
+    amount         DOUBLE PRECISION NOT NULL CHECK (amount >= 0),
+    due_date       DATE NOT NULL,
+    status         disbursement_status DEFAULT 'scheduled' NOT NULL,
+    paid_at        TIMESTAMP(3),
+    CONSTRAINT fk_disbursements_application FOREIGN KEY (application_id) REFERENCES applications (id),
+    CONSTRAINT fk_disbursements_benefit FOREIGN KEY (benefit_id) REFERENCES benefits (id),
+    CONSTRAINT uq_disbursements_installment UNIQUE (application_id, benefit_id, installment)
+);
+
+CREATE INDEX idx_disbursements_deleted_at ON disbursements (deleted_at);
+CREATE INDEX fk_disbursements_application ON disbursements (application_id);
+CREATE INDEX fk_disbursements_benefit ON disbursements (benefit_id);
+CREATE INDEX idx_disbursements_status_due_date ON disbursements (status, due_date);
+
+-- Create triggers for the disbursements table
+CREATE TRIGGER set_timestamps
+    BEFORE INSERT OR UPDATE
+    ON disbursements
+    FOR EACH ROW
+EXECUTE FUNCTION update_timestamps();
\ No newline at end of file
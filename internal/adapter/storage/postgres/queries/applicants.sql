@@ -20,9 +20,12 @@ INSERT INTO applicants (
     employment_status,
     marital_status,
     sex,
-    date_of_birth
+    date_of_birth,
+    monthly_income,
+    assets,
+    has_disability
 ) VALUES (
-             gen_random_uuid(), now(), $1, $2, $3, $4, $5
+             gen_random_uuid(), now(), $1, $2, $3, $4, $5, $6, $7, $8
          )
 RETURNING *;
 
@@ -34,7 +37,10 @@ SET
     employment_status = $3,
     marital_status = $4,
     sex = $5,
-    date_of_birth = $6
+    date_of_birth = $6,
+    monthly_income = $7,
+    assets = $8,
+    has_disability = $9
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
@@ -55,7 +61,10 @@ SELECT
     family.employment_status as family_member_employment_status,
     family.marital_status as family_member_marital_status,
     family.sex as family_member_sex,
-    family.date_of_birth as family_member_date_of_birth
+    family.date_of_birth as family_member_date_of_birth,
+    family.monthly_income as family_member_monthly_income,
+    family.assets as family_member_assets,
+    family.has_disability as family_member_has_disability
 FROM applicants a
          LEFT JOIN relationships r ON a.id = r.applicant_a_id AND r.deleted_at IS NULL
          LEFT JOIN applicants family ON r.applicant_b_id = family.id AND family.deleted_at IS NULL
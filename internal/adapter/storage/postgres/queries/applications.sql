@@ -17,15 +17,22 @@ SELECT * FROM applications
 WHERE applicant_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC;
 
+-- name: GetApplicationsByApplicantAndScheme :many
+-- Used for checking an applicant's existing applications before applying to a scheme
+SELECT * FROM applications
+WHERE applicant_id = $1 AND scheme_id = $2 AND deleted_at IS NULL
+ORDER BY created_at DESC;
+
 -- name: CreateApplication :one
 -- Used for POST /api/applications
 INSERT INTO applications (
     id,
     created_at,
     applicant_id,
-    scheme_id
+    scheme_id,
+    scheme_version_id
 ) VALUES (
-             gen_random_uuid(), now(), $1, $2
+             gen_random_uuid(), now(), $1, $2, $3
          )
 RETURNING *;
 
@@ -45,6 +52,15 @@ SET
     deleted_at = now()
 WHERE id = $1 AND deleted_at IS NULL;
 
+-- name: UpdateApplicationStatus :one
+-- Used for the status transition endpoints under /api/applications/{id}
+-- Only updates the application if it is still in the expected status
+UPDATE applications
+SET
+    status = sqlc.arg(new_status)
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(current_status) AND deleted_at IS NULL
+RETURNING *;
+
 -- name: GetApplicationsWithDetails :many
 -- Used for getting applications with applicant and scheme details
 SELECT
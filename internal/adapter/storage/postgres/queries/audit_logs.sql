@@ -0,0 +1,17 @@
+-- db/query/audit_logs.sql
+
+-- name: CreateAuditLog :exec
+-- Used for recording every change to an entity
+INSERT INTO audit_logs (
+    id,
+    created_at,
+    actor_id,
+    actor_role,
+    entity_type,
+    entity_id,
+    action,
+    old_values,
+    new_values
+) VALUES (
+             gen_random_uuid(), now(), $1, $2, $3, $4, $5, $6, $7
+         );
\ No newline at end of file
@@ -9,9 +9,10 @@ SELECT *
 FROM benefit_criteria
 WHERE benefit_id = $1 AND deleted_at IS NULL;
 
--- name: CreateBenefitCriteria :exec
+-- name: CreateBenefitCriteria :one
 INSERT INTO benefit_criteria (id, created_at, name, value, benefit_id)
-VALUES (gen_random_uuid(), now(), $1, $2, $3);
+VALUES (gen_random_uuid(), now(), $1, $2, $3)
+RETURNING *;
 
 -- name: UpdateBenefitCriteria :exec
 UPDATE benefit_criteria
@@ -24,9 +24,13 @@ INSERT INTO benefits (
     created_at,
     scheme_id,
     name,
-    amount
+    amount,
+    frequency,
+    duration_months,
+    cap,
+    currency
 ) VALUES (
-            gen_random_uuid(), now(), $1, $2, $3
+            gen_random_uuid(), now(), $1, $2, $3, $4, $5, $6, $7
          )
 RETURNING *;
 
@@ -35,7 +39,11 @@ RETURNING *;
 UPDATE benefits
 SET
     name = $2,
-    amount = $3
+    amount = $3,
+    frequency = $4,
+    duration_months = $5,
+    cap = $6,
+    currency = $7
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
@@ -0,0 +1,46 @@
+-- db/query/disbursements.sql
+
+-- name: GetDisbursement :one
+-- Used for the status transition endpoints under /api/applications/{id}/disbursements
+SELECT * FROM disbursements
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListDisbursementsByApplication :many
+-- Used for GET /api/applications/{id}/disbursements
+SELECT * FROM disbursements
+WHERE application_id = $1 AND deleted_at IS NULL
+ORDER BY due_date, installment, benefit_name;
+
+-- name: ListDisbursementsByApplicant :many
+-- Used for GET /api/applicants/{id}/disbursements
+SELECT d.* FROM disbursements d
+         JOIN applications a ON d.application_id = a.id AND a.deleted_at IS NULL
+WHERE a.applicant_id = $1 AND d.deleted_at IS NULL
+ORDER BY d.due_date DESC, d.installment DESC, d.benefit_name;
+
+-- name: CreateDisbursement :one
+-- Used for scheduling the payouts of an application when it is approved
+INSERT INTO disbursements (
+    id,
+    created_at,
+    application_id,
+    benefit_id,
+    benefit_name,
+    installment,
+    amount,
+    due_date,
+    currency
+) VALUES (
+             gen_random_uuid(), now(), $1, $2, $3, $4, $5, $6, $7
+         )
+RETURNING *;
+
+-- name: UpdateDisbursementStatus :one
+-- Used for the status transition endpoints under /api/applications/{id}/disbursements
+-- Only updates the disbursement if it is still in the expected status, and records when it is paid
+UPDATE disbursements
+SET
+    status = sqlc.arg(new_status),
+    paid_at = CASE WHEN sqlc.arg(new_status) = 'paid' THEN now() ELSE paid_at END
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(current_status) AND deleted_at IS NULL
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,51 @@
+-- db/query/relationships.sql
+
+-- name: GetRelationship :one
+-- Used for getting a relationship by ID
+SELECT * FROM relationships
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListRelationshipsByApplicant :many
+-- Used for GET /api/applicants/{id}/relationships
+SELECT * FROM relationships
+WHERE applicant_a_id = $1 AND deleted_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: GetRelationshipBetweenApplicants :one
+-- Used for checking if two applicants are already related in either direction
+SELECT * FROM relationships
+WHERE ((applicant_a_id = $1 AND applicant_b_id = $2) OR (applicant_a_id = $2 AND applicant_b_id = $1))
+  AND deleted_at IS NULL
+LIMIT 1;
+
+-- name: GetRelationshipByApplicants :one
+-- Used for finding the reverse of a relationship
+SELECT * FROM relationships
+WHERE applicant_a_id = $1 AND applicant_b_id = $2 AND deleted_at IS NULL;
+
+-- name: CreateRelationship :one
+-- Used for POST /api/applicants/{id}/relationships
+INSERT INTO relationships (
+    id,
+    created_at,
+    applicant_a_id,
+    applicant_b_id,
+    relationship_type
+) VALUES (
+             gen_random_uuid(), now(), $1, $2, $3
+         )
+RETURNING *;
+
+-- name: UpdateRelationshipType :exec
+-- Used for PUT /api/applicants/{id}/relationships/{relationship_id}
+UPDATE relationships
+SET
+    relationship_type = $3
+WHERE applicant_a_id = $1 AND applicant_b_id = $2 AND deleted_at IS NULL;
+
+-- name: DeleteRelationship :exec
+-- Used for DELETE /api/applicants/{id}/relationships/{relationship_id}
+UPDATE relationships
+SET
+    deleted_at = now()
+WHERE applicant_a_id = $1 AND applicant_b_id = $2 AND deleted_at IS NULL;
\ No newline at end of file
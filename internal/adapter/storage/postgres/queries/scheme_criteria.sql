@@ -18,9 +18,10 @@ INSERT INTO scheme_criteria (
     created_at,
     name,
     value,
-    scheme_id
+    scheme_id,
+    group_id
 ) VALUES (
-             gen_random_uuid(), now(), $1, $2, $3
+             gen_random_uuid(), now(), $1, $2, $3, $4
          )
 RETURNING *;
 
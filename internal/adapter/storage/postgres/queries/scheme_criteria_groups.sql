@@ -0,0 +1,50 @@
+-- db/query/scheme_criteria_groups.sql
+
+-- name: GetSchemeCriteriaGroups :many
+-- Used for getting all criteria groups for a scheme
+SELECT * FROM scheme_criteria_groups
+WHERE scheme_id = $1 AND deleted_at IS NULL;
+
+-- name: GetSchemeCriteriaGroupByID :one
+-- Used for getting a scheme criteria group by ID
+SELECT * FROM scheme_criteria_groups
+WHERE id = $1 AND deleted_at IS NULL
+LIMIT 1;
+
+-- name: ListSchemeCriteriaGroups :many
+-- Used to get a list of all scheme criteria groups
+SELECT * FROM scheme_criteria_groups
+WHERE deleted_at IS NULL;
+
+-- name: CreateSchemeCriteriaGroup :one
+-- Used when adding a criteria group to a scheme
+INSERT INTO scheme_criteria_groups (
+    id,
+    created_at,
+    scheme_id,
+    parent_group_id,
+    operator
+) VALUES (
+             gen_random_uuid(), now(), $1, $2, $3
+         )
+RETURNING *;
+
+-- name: DeleteSchemeCriteriaGroup :exec
+-- Used when deleting a criteria group together with its nested groups and criteria
+WITH RECURSIVE descendants AS (
+    SELECT g.id FROM scheme_criteria_groups g
+    WHERE g.id = $1 AND g.deleted_at IS NULL
+    UNION ALL
+    SELECT g.id FROM scheme_criteria_groups g
+    JOIN descendants d ON g.parent_group_id = d.id
+    WHERE g.deleted_at IS NULL
+), deleted_criteria AS (
+    UPDATE scheme_criteria
+    SET
+        deleted_at = now()
+    WHERE group_id IN (SELECT id FROM descendants) AND deleted_at IS NULL
+)
+UPDATE scheme_criteria_groups
+SET
+    deleted_at = now()
+WHERE id IN (SELECT id FROM descendants);
\ No newline at end of file
@@ -0,0 +1,81 @@
+-- db/query/scheme_versions.sql
+
+-- name: GetSchemeVersion :one
+-- Used for GET /api/schemes/versions/{version_id}
+SELECT * FROM scheme_versions
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListSchemeVersions :many
+-- Used for GET /api/schemes/{scheme_id}/versions
+SELECT * FROM scheme_versions
+WHERE scheme_id = $1 AND deleted_at IS NULL
+ORDER BY version DESC;
+
+-- name: GetEffectiveSchemeVersion :one
+-- Used for checking an applicant's eligibility for a scheme as of a date
+SELECT v.* FROM scheme_versions v
+         JOIN schemes s ON v.scheme_id = s.id AND s.deleted_at IS NULL
+WHERE v.scheme_id = sqlc.arg(scheme_id) AND v.status = 'published' AND v.deleted_at IS NULL
+  AND v.effective_from <= sqlc.arg(as_of)::date
+  AND (v.effective_to IS NULL OR v.effective_to > sqlc.arg(as_of)::date)
+LIMIT 1;
+
+-- name: ListEffectiveSchemeVersions :many
+-- Used for finding the schemes an applicant can apply for as of a date
+SELECT v.* FROM scheme_versions v
+         JOIN schemes s ON v.scheme_id = s.id AND s.deleted_at IS NULL
+WHERE v.status = 'published' AND v.deleted_at IS NULL
+  AND v.effective_from <= sqlc.arg(as_of)::date
+  AND (v.effective_to IS NULL OR v.effective_to > sqlc.arg(as_of)::date)
+ORDER BY s.created_at DESC;
+
+-- name: CreateSchemeVersion :one
+-- Used for POST /api/schemes/{scheme_id}/versions
+-- Versions are numbered in the order they are created for each scheme
+INSERT INTO scheme_versions (
+    id,
+    created_at,
+    scheme_id,
+    version,
+    effective_from,
+    effective_to
+) VALUES (
+             gen_random_uuid(), now(), $1,
+             (SELECT COALESCE(MAX(version), 0) + 1 FROM scheme_versions WHERE scheme_id = $1),
+             $2, $3
+         )
+RETURNING *;
+
+-- name: PublishSchemeVersion :one
+-- Used for POST /api/schemes/versions/{version_id}/publish
+-- Copies the current benefits and criteria of the scheme into the version
+UPDATE scheme_versions
+SET
+    status = 'published',
+    definition = scheme_definition(scheme_id),
+    published_at = now()
+WHERE id = $1 AND status = 'draft' AND deleted_at IS NULL
+RETURNING *;
+
+-- name: RetireSchemeVersion :one
+-- Used for POST /api/schemes/versions/{version_id}/retire
+UPDATE scheme_versions
+SET
+    status = 'retired',
+    retired_at = now()
+WHERE id = $1 AND status = 'published' AND deleted_at IS NULL
+RETURNING *;
+
+-- name: EndSchemeVersion :exec
+-- Used for ending the current version of a scheme when the next version is published
+UPDATE scheme_versions
+SET
+    effective_to = $2
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: DeleteSchemeVersion :exec
+-- Used for DELETE /api/schemes/versions/{version_id}
+UPDATE scheme_versions
+SET
+    deleted_at = now()
+WHERE id = $1 AND status = 'draft' AND deleted_at IS NULL;
\ No newline at end of file
@@ -16,9 +16,10 @@ ORDER BY created_at DESC;
 INSERT INTO schemes (
     id,
     created_at,
-    name
+    name,
+    reapply_cooldown_days
 ) VALUES (
-            gen_random_uuid(), now(), $1
+            gen_random_uuid(), now(), $1, $2
          )
 RETURNING *;
 
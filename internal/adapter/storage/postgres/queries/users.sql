@@ -0,0 +1,43 @@
+-- db/query/users.sql
+
+-- name: GetUser :one
+-- Used for GET /api/users/{id}
+SELECT * FROM users
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetUserByEmail :one
+-- Used for POST /api/auth/login
+SELECT * FROM users
+WHERE lower(email) = lower($1) AND deleted_at IS NULL;
+
+-- name: ListUsers :many
+-- Used for GET /api/users
+SELECT * FROM users
+WHERE deleted_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: CountUsers :one
+-- Used for checking if the first superadmin needs to be created
+SELECT COUNT(*) FROM users
+WHERE deleted_at IS NULL;
+
+-- name: CreateUser :one
+-- Used for POST /api/users
+INSERT INTO users (
+    id,
+    created_at,
+    email,
+    name,
+    password_hash,
+    role
+) VALUES (
+             gen_random_uuid(), now(), $1, $2, $3, $4
+         )
+RETURNING *;
+
+-- name: DeleteUser :exec
+-- Used for DELETE /api/users/{id}
+UPDATE users
+SET
+    deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL;
\ No newline at end of file
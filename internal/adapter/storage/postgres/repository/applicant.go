@@ -10,6 +10,7 @@ import (
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"time"
 )
 
@@ -40,7 +41,8 @@ func (r *ApplicantRepository) GetApplicantById(ctx context.Context, id uuid.UUID
 }
 
 // GetApplicantFamily retrieves an applicant's family members by the applicant's ID from the database.
-func (r *ApplicantRepository) GetApplicantFamily(ctx context.Context, id uuid.UUID) (map[domain.RelationshipType]*domain.Applicant, error) {
+// Family members are grouped by relationship type, so an applicant can have several children, siblings, etc.
+func (r *ApplicantRepository) GetApplicantFamily(ctx context.Context, id uuid.UUID) (domain.Family, error) {
 	query := r.db.QueryBuilder.
 		Select(
 			"r.relationship_type",
@@ -50,6 +52,9 @@ func (r *ApplicantRepository) GetApplicantFamily(ctx context.Context, id uuid.UU
 			"family.marital_status AS family_member_marital_status",
 			"family.sex AS family_member_sex",
 			"family.date_of_birth AS family_member_date_of_birth",
+			"family.monthly_income AS family_member_monthly_income",
+			"family.assets AS family_member_assets",
+			"family.has_disability AS family_member_has_disability",
 		).
 		From("relationships r").
 		LeftJoin("applicants family ON r.applicant_b_id = family.id AND family.deleted_at IS NULL").
@@ -67,17 +72,20 @@ func (r *ApplicantRepository) GetApplicantFamily(ctx context.Context, id uuid.UU
 	}
 	defer rows.Close()
 
-	family := make(map[domain.RelationshipType]*domain.Applicant)
+	family := make(domain.Family)
 
 	for rows.Next() {
 		var relationshipType *string
 		var familyMemberID *uuid.UUID
 		var familyMemberName, familyMemberEmploymentStatus, familyMemberMaritalStatus, familyMemberSex *string
 		var familyMemberDateOfBirth *time.Time
+		var familyMemberMonthlyIncome, familyMemberAssets pgtype.Numeric
+		var familyMemberHasDisability *bool
 
 		err = rows.Scan(
 			&relationshipType, &familyMemberID, &familyMemberName, &familyMemberEmploymentStatus,
 			&familyMemberMaritalStatus, &familyMemberSex, &familyMemberDateOfBirth,
+			&familyMemberMonthlyIncome, &familyMemberAssets, &familyMemberHasDisability,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
@@ -85,15 +93,22 @@ func (r *ApplicantRepository) GetApplicantFamily(ctx context.Context, id uuid.UU
 
 		// Add family member if exists
 		if familyMemberID != nil && relationshipType != nil {
-			familyMember := &domain.Applicant{
+			// Convert the amounts of the family member the same way as those of applicants
+			amounts := (&pg.Applicant{MonthlyIncome: familyMemberMonthlyIncome, Assets: familyMemberAssets}).ToEntity()
+
+			familyMember := domain.Applicant{
 				ID:               familyMemberID,
 				Name:             familyMemberName,
 				EmploymentStatus: (*domain.EmploymentStatus)(familyMemberEmploymentStatus),
 				MaritalStatus:    (*domain.MaritalStatus)(familyMemberMaritalStatus),
 				Sex:              (*domain.Sex)(familyMemberSex),
 				DateOfBirth:      familyMemberDateOfBirth,
+				MonthlyIncome:    amounts.MonthlyIncome,
+				Assets:           amounts.Assets,
+				HasDisability:    familyMemberHasDisability,
 			}
-			family[domain.RelationshipType(*relationshipType)] = familyMember
+			rt := domain.RelationshipType(*relationshipType)
+			family[rt] = append(family[rt], familyMember)
 		}
 	}
 
@@ -105,41 +120,113 @@ func (r *ApplicantRepository) GetApplicantFamily(ctx context.Context, id uuid.UU
 	return family, nil
 }
 
-// ListApplicants retrieves all applicants from the database and converts them to the domain entity representation.
-func (r *ApplicantRepository) ListApplicants(ctx context.Context) (applicants []domain.Applicant, err error) {
-	dbApplicants, err := r.q.ListApplicants(ctx)
+// applicantSortColumns maps the fields applicants can be sorted by to their columns
+var applicantSortColumns = map[string]string{
+	"name":          "name",
+	"date_of_birth": "date_of_birth",
+	"created_at":    "created_at",
+}
+
+// ListApplicants retrieves a page of applicants matching the filter, along with the total number of matching applicants.
+func (r *ApplicantRepository) ListApplicants(ctx context.Context, filter domain.ApplicantFilter) (applicants []domain.Applicant, total int, err error) {
+	where := squirrel.And{squirrel.Eq{"deleted_at": nil}}
+
+	if filter.EmploymentStatus != nil {
+		where = append(where, squirrel.Eq{"employment_status": *filter.EmploymentStatus})
+	}
+
+	if filter.MaritalStatus != nil {
+		where = append(where, squirrel.Eq{"marital_status": *filter.MaritalStatus})
+	}
+
+	// An applicant is at least N years old if they were born on or before today N years ago
+	today := time.Now()
+	if filter.MinAge != nil {
+		where = append(where, squirrel.LtOrEq{"date_of_birth": today.AddDate(-*filter.MinAge, 0, 0)})
+	}
+
+	if filter.MaxAge != nil {
+		where = append(where, squirrel.Gt{"date_of_birth": today.AddDate(-*filter.MaxAge-1, 0, 0)})
+	}
+
+	query := r.db.QueryBuilder.
+		Select("id", "created_at", "updated_at", "deleted_at", "name", "employment_status", "marital_status", "sex", "date_of_birth", "monthly_income", "assets", "has_disability").
+		From("applicants").
+		Where(where)
+
+	query, err = applyListOptions(query, filter.ListOptions, applicantSortColumns)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domain.ApplicantNotFoundError
-		}
+		return nil, 0, err
+	}
 
-		return nil, err
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build applicants query: %w", err)
 	}
 
-	dbApplicantsEntities := make([]domain.Applicant, len(dbApplicants))
-	for i, dbApplicant := range dbApplicants {
-		dbApplicantsEntities[i] = *dbApplicant.ToEntity()
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	applicants = make([]domain.Applicant, 0)
+	for rows.Next() {
+		var a pg.Applicant
+		if err := rows.Scan(&a.ID, &a.CreatedAt, &a.UpdatedAt, &a.DeletedAt, &a.Name, &a.EmploymentStatus, &a.MaritalStatus, &a.Sex, &a.DateOfBirth, &a.MonthlyIncome, &a.Assets, &a.HasDisability); err != nil {
+			return nil, 0, err
+		}
+		applicants = append(applicants, *a.ToEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	total, err = countRows(ctx, r.db, "applicants", where)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return dbApplicantsEntities, nil
+	return applicants, total, nil
 }
 
 // CreateApplicant inserts a new applicant into the database and returns the created applicant or an error if one occurs.
 func (r *ApplicantRepository) CreateApplicant(ctx context.Context, applicant *domain.Applicant) (newApplicant *domain.Applicant, err error) {
 	dbApplicant := pg.ApplicantFromEntity(applicant)
 
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
 	params := pg.CreateApplicantParams{
 		Name:             dbApplicant.Name,
 		EmploymentStatus: dbApplicant.EmploymentStatus,
 		MaritalStatus:    dbApplicant.MaritalStatus,
 		Sex:              dbApplicant.Sex,
 		DateOfBirth:      dbApplicant.DateOfBirth,
+		MonthlyIncome:    dbApplicant.MonthlyIncome,
+		Assets:           dbApplicant.Assets,
+		HasDisability:    dbApplicant.HasDisability,
 	}
-	a, err := r.q.CreateApplicant(ctx, params)
+	a, err := qtx.CreateApplicant(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityApplicant, a.ID, domain.AuditActionCreate, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return a.ToEntity(), nil
 }
 
@@ -184,6 +271,21 @@ func (r *ApplicantRepository) UpdateApplicant(ctx context.Context, applicant *do
 		setFields = true
 	}
 
+	if applicant.MonthlyIncome != nil {
+		query = query.Set("monthly_income", pg.ApplicantFromEntity(applicant).MonthlyIncome)
+		setFields = true
+	}
+
+	if applicant.Assets != nil {
+		query = query.Set("assets", pg.ApplicantFromEntity(applicant).Assets)
+		setFields = true
+	}
+
+	if applicant.HasDisability != nil {
+		query = query.Set("has_disability", applicant.HasDisability)
+		setFields = true
+	}
+
 	if !setFields {
 		return nil, domain.NoUpdateFieldsError
 	}
@@ -195,7 +297,21 @@ func (r *ApplicantRepository) UpdateApplicant(ctx context.Context, applicant *do
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, sql, args...)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityApplicant, *applicant.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ApplicantNotFoundError
@@ -204,7 +320,7 @@ func (r *ApplicantRepository) UpdateApplicant(ctx context.Context, applicant *do
 		return nil, err
 	}
 
-	updatedDbApplicant, err = r.q.GetApplicant(ctx, *applicant.ID)
+	updatedDbApplicant, err = qtx.GetApplicant(ctx, *applicant.ID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ApplicantNotFoundError
@@ -213,12 +329,35 @@ func (r *ApplicantRepository) UpdateApplicant(ctx context.Context, applicant *do
 		return nil, err
 	}
 
+	err = audit.record(ctx, domain.AuditEntityApplicant, *applicant.ID, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return updatedDbApplicant.ToEntity(), nil
 }
 
 // DeleteApplicant deletes an applicant record from the database by their unique identifier. Returns an error if the operation fails.
 func (r *ApplicantRepository) DeleteApplicant(ctx context.Context, id uuid.UUID) (err error) {
-	err = r.q.DeleteApplicant(ctx, id)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityApplicant, id)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteApplicant(ctx, id)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -228,5 +367,10 @@ func (r *ApplicantRepository) DeleteApplicant(ctx context.Context, id uuid.UUID)
 		return err
 	}
 
-	return nil
+	err = audit.record(ctx, domain.AuditEntityApplicant, id, domain.AuditActionDelete, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
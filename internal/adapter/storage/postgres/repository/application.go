@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/Masterminds/squirrel"
 	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
 	pg "github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/sqlc"
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
@@ -21,23 +23,76 @@ func NewApplicationRepository(db *postgres.DB, q pg.Querier) *ApplicationReposit
 	return &ApplicationRepository{db: db, q: q}
 }
 
-// ListApplications retrieves a list of all applications from the database and converts them into domain entities.
-func (r *ApplicationRepository) ListApplications(ctx context.Context) ([]domain.Application, error) {
-	dbApplications, err := r.q.ListApplications(ctx)
+// applicationSortColumns maps the fields applications can be sorted by to their columns
+var applicationSortColumns = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"status":     "status",
+}
+
+// ListApplications retrieves a page of applications matching the filter, along with the total number of matching applications.
+func (r *ApplicationRepository) ListApplications(ctx context.Context, filter domain.ApplicationFilter) ([]domain.Application, int, error) {
+	where := squirrel.And{squirrel.Eq{"deleted_at": nil}}
+
+	if filter.ApplicantID != nil {
+		where = append(where, squirrel.Eq{"applicant_id": *filter.ApplicantID})
+	}
+
+	if filter.SchemeID != nil {
+		where = append(where, squirrel.Eq{"scheme_id": *filter.SchemeID})
+	}
+
+	if filter.Status != nil {
+		where = append(where, squirrel.Eq{"status": *filter.Status})
+	}
+
+	if filter.CreatedFrom != nil {
+		where = append(where, squirrel.GtOrEq{"created_at": *filter.CreatedFrom})
+	}
+
+	if filter.CreatedBefore != nil {
+		where = append(where, squirrel.Lt{"created_at": *filter.CreatedBefore})
+	}
+
+	query := r.db.QueryBuilder.
+		Select("id", "created_at", "updated_at", "deleted_at", "applicant_id", "scheme_id", "scheme_version_id", "status").
+		From("applications").
+		Where(where)
+
+	query, err := applyListOptions(query, filter.ListOptions, applicationSortColumns)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domain.ApplicationNotFoundError
-		}
+		return nil, 0, err
+	}
 
-		return nil, err
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build applications query: %w", err)
 	}
 
-	dbApplicationsEntities := make([]domain.Application, len(dbApplications))
-	for i, dbApplication := range dbApplications {
-		dbApplicationsEntities[i] = *dbApplication.ToEntity()
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	applications := make([]domain.Application, 0)
+	for rows.Next() {
+		var a pg.Application
+		if err := rows.Scan(&a.ID, &a.CreatedAt, &a.UpdatedAt, &a.DeletedAt, &a.ApplicantID, &a.SchemeID, &a.SchemeVersionID, &a.Status); err != nil {
+			return nil, 0, err
+		}
+		applications = append(applications, *a.ToEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := countRows(ctx, r.db, "applications", where)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return dbApplicationsEntities, nil
+	return applications, total, nil
 }
 
 // GetApplicationById retrieves an Application entity by its unique identifier from the database.
@@ -48,24 +103,66 @@ func (r *ApplicationRepository) GetApplicationById(ctx context.Context, id uuid.
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ApplicationNotFoundError
 		}
+		return nil, err
 	}
 
 	return dbApplication.ToEntity(), nil
 }
 
+// ListApplicationsByApplicantAndScheme retrieves all applications an applicant has made to a scheme, latest first.
+func (r *ApplicationRepository) ListApplicationsByApplicantAndScheme(ctx context.Context, applicantID, schemeID uuid.UUID) ([]domain.Application, error) {
+	params := pg.GetApplicationsByApplicantAndSchemeParams{
+		ApplicantID: applicantID,
+		SchemeID:    schemeID,
+	}
+
+	dbApplications, err := r.q.GetApplicationsByApplicantAndScheme(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	applications := make([]domain.Application, len(dbApplications))
+	for i, dbApplication := range dbApplications {
+		applications[i] = *dbApplication.ToEntity()
+	}
+
+	return applications, nil
+}
+
 // CreateApplication inserts a new application into the database and returns the created application entity or an error.
 func (r *ApplicationRepository) CreateApplication(ctx context.Context, application *domain.Application) (*domain.Application, error) {
 	dbApplication := pg.ApplicationFromEntity(application)
 
 	params := pg.CreateApplicationParams{
-		ApplicantID: dbApplication.ApplicantID,
-		SchemeID:    dbApplication.SchemeID,
+		ApplicantID:     dbApplication.ApplicantID,
+		SchemeID:        dbApplication.SchemeID,
+		SchemeVersionID: dbApplication.SchemeVersionID,
 	}
 
-	a, err := r.q.CreateApplication(ctx, params)
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	a, err := qtx.CreateApplication(ctx, params)
+	if err != nil {
+		// roll back first, so that the active application can still be looked up when ctx is in an outer transaction
+		tx.Rollback(ctx)
+		return nil, r.mapWriteError(ctx, application, err)
+	}
+
+	err = audit.record(ctx, domain.AuditEntityApplication, a.ID, domain.AuditActionCreate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
 
 	return a.ToEntity(), nil
 }
@@ -77,8 +174,8 @@ func (r *ApplicationRepository) UpdateApplication(ctx context.Context, applicati
 
 	query := r.db.QueryBuilder.Update("applications")
 
-	if application.SchemeID != nil {
-		query = query.Set("scheme_id", application.SchemeID)
+	if application.ApplicantID != nil {
+		query = query.Set("applicant_id", application.ApplicantID)
 		setFields = true
 	}
 
@@ -87,6 +184,11 @@ func (r *ApplicationRepository) UpdateApplication(ctx context.Context, applicati
 		setFields = true
 	}
 
+	if application.SchemeVersionID != nil {
+		query = query.Set("scheme_version_id", application.SchemeVersionID)
+		setFields = true
+	}
+
 	if !setFields {
 		return nil, domain.NoUpdateFieldsError
 	}
@@ -99,13 +201,28 @@ func (r *ApplicationRepository) UpdateApplication(ctx context.Context, applicati
 		return nil, err
 	}
 
-	_, err = r.db.Exec(ctx, sql, args...)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
 
+	before, err := audit.snapshot(ctx, domain.AuditEntityApplication, *application.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	a, err := r.q.GetApplication(ctx, *application.ID)
+	_, err = tx.Exec(ctx, sql, args...)
+
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, r.mapWriteError(ctx, application, err)
+	}
+
+	a, err := qtx.GetApplication(ctx, *application.ID)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -114,12 +231,78 @@ func (r *ApplicationRepository) UpdateApplication(ctx context.Context, applicati
 		return nil, err
 	}
 
+	err = audit.record(ctx, domain.AuditEntityApplication, *application.ID, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return a.ToEntity(), nil
+}
+
+// UpdateApplicationStatus moves an application from its current status to a new status.
+// Returns domain.InvalidApplicationStatusTransitionError if the application is no longer in the current status.
+func (r *ApplicationRepository) UpdateApplicationStatus(ctx context.Context, id uuid.UUID, currentStatus, newStatus domain.ApplicationStatus) (*domain.Application, error) {
+	params := pg.UpdateApplicationStatusParams{
+		NewStatus:     pg.ApplicationStatus(newStatus),
+		ID:            id,
+		CurrentStatus: pg.ApplicationStatus(currentStatus),
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityApplication, id)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := qtx.UpdateApplicationStatus(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.InvalidApplicationStatusTransitionError
+		}
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityApplication, id, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return a.ToEntity(), nil
 }
 
 // DeleteApplication removes an application from the database by its unique identifier. Returns an error if delete fails.
 func (r *ApplicationRepository) DeleteApplication(ctx context.Context, id uuid.UUID) error {
-	err := r.q.DeleteApplication(ctx, id)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityApplication, id)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteApplication(ctx, id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.ApplicationNotFoundError
@@ -127,5 +310,35 @@ func (r *ApplicationRepository) DeleteApplication(ctx context.Context, id uuid.U
 		return err
 	}
 
-	return nil
+	err = audit.record(ctx, domain.AuditEntityApplication, id, domain.AuditActionDelete, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// mapWriteError converts unique constraint violations into a domain.ActiveApplicationError naming the active application
+// the applicant already has for the scheme.
+func (r *ApplicationRepository) mapWriteError(ctx context.Context, application *domain.Application, err error) error {
+	if r.db.ErrorCode(err) != postgres.UniqueViolationErrorCode {
+		return err
+	}
+
+	if application.ApplicantID == nil || application.SchemeID == nil {
+		return domain.DuplicateApplicationError
+	}
+
+	applications, listErr := r.ListApplicationsByApplicantAndScheme(ctx, *application.ApplicantID, *application.SchemeID)
+	if listErr != nil {
+		return domain.DuplicateApplicationError
+	}
+
+	for _, a := range applications {
+		if !a.Status.IsFinal() {
+			return &domain.ActiveApplicationError{ApplicationID: *a.ID}
+		}
+	}
+
+	return domain.DuplicateApplicationError
 }
@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
+	pg "github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/sqlc"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"reflect"
+)
+
+// auditTables maps each audited entity type to the table its rows are stored in
+var auditTables = map[domain.AuditEntityType]string{
+	domain.AuditEntityApplicant:       "applicants",
+	domain.AuditEntityRelationship:    "relationships",
+	domain.AuditEntityScheme:          "schemes",
+	domain.AuditEntityBenefit:         "benefits",
+	domain.AuditEntityBenefitCriteria: "benefit_criteria",
+	domain.AuditEntitySchemeCriteria:  "scheme_criteria",
+	domain.AuditEntityCriteriaGroup:   "scheme_criteria_groups",
+	domain.AuditEntitySchemeVersion:   "scheme_versions",
+	domain.AuditEntityApplication:     "applications",
+	domain.AuditEntityDisbursement:    "disbursements",
+	domain.AuditEntityUser:            "users",
+}
+
+// auditIgnoredColumns are never copied into audit log entries. Password hashes are secret,
+// and updated_at changes on every update so it is left out of the changed fields.
+var auditIgnoredColumns = []string{"password_hash", "updated_at"}
+
+// auditLogSortColumns maps the fields audit log entries can be sorted by to their columns
+var auditLogSortColumns = map[string]string{
+	"created_at": "created_at",
+}
+
+// AuditRepository provides methods for reading the audit log from the database.
+type AuditRepository struct {
+	db *postgres.DB
+	q  pg.Querier
+}
+
+// NewAuditRepository creates a new instance of AuditRepository with the provided database and querier dependencies.
+func NewAuditRepository(db *postgres.DB, q pg.Querier) *AuditRepository {
+	return &AuditRepository{db: db, q: q}
+}
+
+// ListAuditLogs retrieves a page of audit log entries matching the filter, along with the total number of matching entries.
+func (r *AuditRepository) ListAuditLogs(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditLog, int, error) {
+	where := squirrel.And{}
+
+	if filter.EntityType != nil {
+		where = append(where, squirrel.Eq{"entity_type": *filter.EntityType})
+	}
+
+	if filter.EntityID != nil {
+		where = append(where, squirrel.Eq{"entity_id": *filter.EntityID})
+	}
+
+	if filter.ActorID != nil {
+		where = append(where, squirrel.Eq{"actor_id": *filter.ActorID})
+	}
+
+	if filter.Action != nil {
+		where = append(where, squirrel.Eq{"action": *filter.Action})
+	}
+
+	query := r.db.QueryBuilder.
+		Select("id", "created_at", "actor_id", "actor_role", "entity_type", "entity_id", "action", "old_values", "new_values").
+		From("audit_logs").
+		Where(where)
+
+	query, err := applyListOptions(query, filter.ListOptions, auditLogSortColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build audit logs query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	auditLogs := make([]domain.AuditLog, 0)
+	for rows.Next() {
+		var a pg.AuditLog
+		err := rows.Scan(&a.ID, &a.CreatedAt, &a.ActorID, &a.ActorRole, &a.EntityType, &a.EntityID, &a.Action, &a.OldValues, &a.NewValues)
+		if err != nil {
+			return nil, 0, err
+		}
+		auditLogs = append(auditLogs, *a.ToEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := countRows(ctx, r.db, "audit_logs", where)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return auditLogs, total, nil
+}
+
+// auditor records audit log entries inside the transaction that makes the change, so that a change is never
+// committed without its entry.
+type auditor struct {
+	tx pgx.Tx
+	q  pg.Querier
+}
+
+// newAuditor creates an auditor that snapshots rows and records entries using the given transaction.
+func newAuditor(tx pgx.Tx) *auditor {
+	return &auditor{tx: tx, q: pg.New(tx)}
+}
+
+// snapshot returns the current values of a row that has not been deleted, keyed by column, or nil if there is no such row.
+func (a *auditor) snapshot(ctx context.Context, entityType domain.AuditEntityType, id uuid.UUID) (map[string]any, error) {
+	table, ok := auditTables[entityType]
+	if !ok {
+		return nil, fmt.Errorf("unknown audit entity type %q", entityType)
+	}
+
+	sql := fmt.Sprintf("SELECT to_jsonb(t) FROM %s t WHERE t.id = $1 AND t.deleted_at IS NULL", table)
+
+	var values map[string]any
+	err := a.tx.QueryRow(ctx, sql, id).Scan(&values)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, column := range auditIgnoredColumns {
+		delete(values, column)
+	}
+
+	return values, nil
+}
+
+// record takes a snapshot of a row after it has been changed and records an audit log entry comparing it with the
+// snapshot taken before the change. Updates only record the fields that changed, and nothing is recorded if none did.
+// Deletes that did not match a row are not recorded either.
+func (a *auditor) record(ctx context.Context, entityType domain.AuditEntityType, id uuid.UUID, action domain.AuditAction, before map[string]any) error {
+	var oldValues, newValues map[string]any
+
+	switch action {
+	case domain.AuditActionCreate:
+		after, err := a.snapshot(ctx, entityType, id)
+		if err != nil {
+			return err
+		}
+		newValues = after
+
+	case domain.AuditActionUpdate:
+		after, err := a.snapshot(ctx, entityType, id)
+		if err != nil {
+			return err
+		}
+		oldValues, newValues = changedValues(before, after)
+		if len(newValues) == 0 && len(oldValues) == 0 {
+			return nil
+		}
+
+	case domain.AuditActionDelete:
+		if before == nil {
+			return nil
+		}
+		oldValues = before
+	}
+
+	oldJSON, err := marshalAuditValues(oldValues)
+	if err != nil {
+		return err
+	}
+
+	newJSON, err := marshalAuditValues(newValues)
+	if err != nil {
+		return err
+	}
+
+	params := pg.CreateAuditLogParams{
+		EntityType: string(entityType),
+		EntityID:   id,
+		Action:     pg.AuditAction(action),
+		OldValues:  oldJSON,
+		NewValues:  newJSON,
+	}
+
+	if actor := domain.ActorFromContext(ctx); actor != nil {
+		params.ActorID = uuid.NullUUID{UUID: actor.UserID, Valid: true}
+		params.ActorRole = pg.NullUserRole{UserRole: pg.UserRole(actor.Role), Valid: true}
+	}
+
+	return a.q.CreateAuditLog(ctx, params)
+}
+
+// changedValues returns the old and new values of the fields that differ between two snapshots of a row
+func changedValues(before, after map[string]any) (map[string]any, map[string]any) {
+	oldValues := map[string]any{}
+	newValues := map[string]any{}
+
+	for column, value := range after {
+		if !reflect.DeepEqual(before[column], value) {
+			oldValues[column] = before[column]
+			newValues[column] = value
+		}
+	}
+
+	return oldValues, newValues
+}
+
+// marshalAuditValues encodes the values of an audit log entry as JSON, or returns nil if there are none
+func marshalAuditValues(values map[string]any) ([]byte, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(values)
+}
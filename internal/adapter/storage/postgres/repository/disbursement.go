@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
+	pg "github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/sqlc"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// DisbursementRepository provides methods for managing the ledger of disbursements in the database.
+type DisbursementRepository struct {
+	db *postgres.DB
+	q  pg.Querier
+}
+
+// NewDisbursementRepository creates a new instance of DisbursementRepository with the provided database and querier dependencies.
+func NewDisbursementRepository(db *postgres.DB, q pg.Querier) *DisbursementRepository {
+	return &DisbursementRepository{db: db, q: q}
+}
+
+// toDisbursements converts disbursement rows into their domain representation
+func toDisbursements(rows []pg.Disbursement) []domain.Disbursement {
+	disbursements := make([]domain.Disbursement, 0, len(rows))
+	for _, row := range rows {
+		disbursements = append(disbursements, *row.ToEntity())
+	}
+	return disbursements
+}
+
+// GetDisbursementByID retrieves a disbursement by its unique identifier.
+// Returns domain.DisbursementNotFoundError if no matching record is found.
+func (r *DisbursementRepository) GetDisbursementByID(ctx context.Context, id uuid.UUID) (*domain.Disbursement, error) {
+	d, err := r.q.GetDisbursement(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.DisbursementNotFoundError
+		}
+		return nil, err
+	}
+
+	return d.ToEntity(), nil
+}
+
+// ListApplicationDisbursements retrieves the disbursements of an application, earliest due first.
+func (r *DisbursementRepository) ListApplicationDisbursements(ctx context.Context, applicationID uuid.UUID) ([]domain.Disbursement, error) {
+	rows, err := r.q.ListDisbursementsByApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDisbursements(rows), nil
+}
+
+// ListApplicantDisbursements retrieves the disbursements of every application of an applicant, latest due first.
+func (r *DisbursementRepository) ListApplicantDisbursements(ctx context.Context, applicantID uuid.UUID) ([]domain.Disbursement, error) {
+	rows, err := r.q.ListDisbursementsByApplicant(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDisbursements(rows), nil
+}
+
+// CreateDisbursement schedules a disbursement and returns it.
+func (r *DisbursementRepository) CreateDisbursement(ctx context.Context, disbursement *domain.Disbursement) (*domain.Disbursement, error) {
+	dbDisbursement := pg.DisbursementFromEntity(disbursement)
+
+	params := pg.CreateDisbursementParams{
+		ApplicationID: dbDisbursement.ApplicationID,
+		BenefitID:     dbDisbursement.BenefitID,
+		BenefitName:   dbDisbursement.BenefitName,
+		Installment:   dbDisbursement.Installment,
+		Amount:        dbDisbursement.Amount,
+		DueDate:       dbDisbursement.DueDate,
+		Currency:      dbDisbursement.Currency,
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	d, err := qtx.CreateDisbursement(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityDisbursement, d.ID, domain.AuditActionCreate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return d.ToEntity(), nil
+}
+
+// UpdateDisbursementStatus moves a disbursement from its current status to a new status.
+// Returns domain.InvalidDisbursementTransitionError if the disbursement is no longer in the current status.
+func (r *DisbursementRepository) UpdateDisbursementStatus(ctx context.Context, id uuid.UUID, currentStatus, newStatus domain.DisbursementStatus) (*domain.Disbursement, error) {
+	params := pg.UpdateDisbursementStatusParams{
+		NewStatus:     pg.DisbursementStatus(newStatus),
+		ID:            id,
+		CurrentStatus: pg.DisbursementStatus(currentStatus),
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityDisbursement, id)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := qtx.UpdateDisbursementStatus(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.InvalidDisbursementTransitionError
+		}
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityDisbursement, id, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return d.ToEntity(), nil
+}
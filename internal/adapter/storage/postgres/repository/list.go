@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"strings"
+)
+
+// likeEscaper escapes the wildcard characters of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsPattern returns a LIKE pattern matching any value that contains s
+func containsPattern(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
+
+// applyListOptions orders and pages a select query. sortColumns maps the sortable fields to their columns,
+// and rows are sorted by created_at, newest first, when no sort field is given. The id column breaks ties so pages are stable.
+func applyListOptions(query squirrel.SelectBuilder, opts domain.ListOptions, sortColumns map[string]string) (squirrel.SelectBuilder, error) {
+	column := "created_at"
+	if opts.SortBy != "" {
+		var ok bool
+		if column, ok = sortColumns[opts.SortBy]; !ok {
+			return query, domain.InvalidSortFieldError
+		}
+	}
+
+	order := "ASC"
+	if opts.SortOrder == domain.SortOrderDesc || (opts.SortOrder == "" && opts.SortBy == "") {
+		order = "DESC"
+	}
+
+	query = query.OrderBy(fmt.Sprintf("%s %s", column, order), fmt.Sprintf("id %s", order))
+
+	if opts.Limit > 0 {
+		query = query.Limit(uint64(opts.Limit))
+	}
+
+	if opts.Offset > 0 {
+		query = query.Offset(uint64(opts.Offset))
+	}
+
+	return query, nil
+}
+
+// countRows counts the rows of a table that match the given conditions
+func countRows(ctx context.Context, db *postgres.DB, table string, where squirrel.Sqlizer) (int, error) {
+	sql, args, err := db.QueryBuilder.Select("COUNT(*)").From(table).Where(where).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var total int
+	if err := db.QueryRow(ctx, sql, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
+	pg "github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/sqlc"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// RelationshipRepository provides methods for managing relationships between applicants in the database.
+// Every relationship is stored together with its reverse, so both applicants see each other as family.
+type RelationshipRepository struct {
+	db *postgres.DB
+	q  pg.Querier
+}
+
+// NewRelationshipRepository creates a new instance of RelationshipRepository using the provided database connection and querier.
+func NewRelationshipRepository(db *postgres.DB, q pg.Querier) *RelationshipRepository {
+	return &RelationshipRepository{db: db, q: q}
+}
+
+// GetRelationshipByID retrieves a relationship by its unique identifier from the database.
+func (r *RelationshipRepository) GetRelationshipByID(ctx context.Context, id uuid.UUID) (*domain.Relationship, error) {
+	relationship, err := r.q.GetRelationship(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.RelationshipNotFoundError
+		}
+
+		return nil, err
+	}
+
+	return relationship.ToEntity(), nil
+}
+
+// GetRelationshipBetweenApplicants retrieves the relationship linking two applicants in either direction.
+// Returns domain.RelationshipNotFoundError if the applicants are not related.
+func (r *RelationshipRepository) GetRelationshipBetweenApplicants(ctx context.Context, applicantAID uuid.UUID, applicantBID uuid.UUID) (*domain.Relationship, error) {
+	relationship, err := r.q.GetRelationshipBetweenApplicants(ctx, pg.GetRelationshipBetweenApplicantsParams{
+		ApplicantAID: applicantAID,
+		ApplicantBID: applicantBID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.RelationshipNotFoundError
+		}
+
+		return nil, err
+	}
+
+	return relationship.ToEntity(), nil
+}
+
+// ListApplicantRelationships retrieves all relationships of an applicant from the database.
+func (r *RelationshipRepository) ListApplicantRelationships(ctx context.Context, applicantID uuid.UUID) ([]domain.Relationship, error) {
+	dbRelationships, err := r.q.ListRelationshipsByApplicant(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	relationships := make([]domain.Relationship, len(dbRelationships))
+	for i, dbRelationship := range dbRelationships {
+		relationships[i] = *dbRelationship.ToEntity()
+	}
+
+	return relationships, nil
+}
+
+// CreateRelationship inserts a new relationship together with its reverse relationship in a single transaction
+// and returns the created relationship.
+func (r *RelationshipRepository) CreateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error) {
+	dbRelationship := pg.RelationshipFromEntity(relationship)
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	created, err := qtx.CreateRelationship(ctx, pg.CreateRelationshipParams{
+		ApplicantAID:     dbRelationship.ApplicantAID,
+		ApplicantBID:     dbRelationship.ApplicantBID,
+		RelationshipType: dbRelationship.RelationshipType,
+	})
+	if err != nil {
+		return nil, r.mapWriteError(err)
+	}
+
+	reverse, err := qtx.CreateRelationship(ctx, pg.CreateRelationshipParams{
+		ApplicantAID:     dbRelationship.ApplicantBID,
+		ApplicantBID:     dbRelationship.ApplicantAID,
+		RelationshipType: pg.RelationshipType(relationship.RelationshipType.Inverse()),
+	})
+	if err != nil {
+		return nil, r.mapWriteError(err)
+	}
+
+	for _, id := range []uuid.UUID{created.ID, reverse.ID} {
+		err = audit.record(ctx, domain.AuditEntityRelationship, id, domain.AuditActionCreate, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return created.ToEntity(), nil
+}
+
+// UpdateRelationship updates the type of an existing relationship and its reverse relationship in a single transaction
+// and returns the updated relationship.
+func (r *RelationshipRepository) UpdateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error) {
+	if relationship.ID == nil {
+		return nil, domain.InvalidRelationshipError
+	}
+
+	if relationship.RelationshipType == nil {
+		return nil, domain.NoUpdateFieldsError
+	}
+
+	existing, err := r.GetRelationshipByID(ctx, *relationship.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	ids, err := r.relationshipPairIDs(ctx, qtx, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	before := make([]map[string]any, len(ids))
+	for i, id := range ids {
+		before[i], err = audit.snapshot(ctx, domain.AuditEntityRelationship, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = qtx.UpdateRelationshipType(ctx, pg.UpdateRelationshipTypeParams{
+		ApplicantAID:     *existing.ApplicantAID,
+		ApplicantBID:     *existing.ApplicantBID,
+		RelationshipType: pg.RelationshipType(*relationship.RelationshipType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = qtx.UpdateRelationshipType(ctx, pg.UpdateRelationshipTypeParams{
+		ApplicantAID:     *existing.ApplicantBID,
+		ApplicantBID:     *existing.ApplicantAID,
+		RelationshipType: pg.RelationshipType(relationship.RelationshipType.Inverse()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := qtx.GetRelationship(ctx, *relationship.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.RelationshipNotFoundError
+		}
+
+		return nil, err
+	}
+
+	for i, id := range ids {
+		err = audit.record(ctx, domain.AuditEntityRelationship, id, domain.AuditActionUpdate, before[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return updated.ToEntity(), nil
+}
+
+// DeleteRelationship deletes a relationship and its reverse relationship from the database in a single transaction.
+func (r *RelationshipRepository) DeleteRelationship(ctx context.Context, id uuid.UUID) error {
+	existing, err := r.GetRelationshipByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	ids, err := r.relationshipPairIDs(ctx, qtx, existing)
+	if err != nil {
+		return err
+	}
+
+	before := make([]map[string]any, len(ids))
+	for i, id := range ids {
+		before[i], err = audit.snapshot(ctx, domain.AuditEntityRelationship, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = qtx.DeleteRelationship(ctx, pg.DeleteRelationshipParams{
+		ApplicantAID: *existing.ApplicantAID,
+		ApplicantBID: *existing.ApplicantBID,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteRelationship(ctx, pg.DeleteRelationshipParams{
+		ApplicantAID: *existing.ApplicantBID,
+		ApplicantBID: *existing.ApplicantAID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, id := range ids {
+		err = audit.record(ctx, domain.AuditEntityRelationship, id, domain.AuditActionDelete, before[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// relationshipPairIDs returns the IDs of a relationship and of its reverse relationship, if there is one.
+func (r *RelationshipRepository) relationshipPairIDs(ctx context.Context, q pg.Querier, relationship *domain.Relationship) ([]uuid.UUID, error) {
+	ids := []uuid.UUID{*relationship.ID}
+
+	reverse, err := q.GetRelationshipByApplicants(ctx, pg.GetRelationshipByApplicantsParams{
+		ApplicantAID: *relationship.ApplicantBID,
+		ApplicantBID: *relationship.ApplicantAID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ids, nil
+		}
+
+		return nil, err
+	}
+
+	return append(ids, reverse.ID), nil
+}
+
+// mapWriteError converts unique constraint violations into domain.DuplicateRelationshipError.
+func (r *RelationshipRepository) mapWriteError(err error) error {
+	if r.db.ErrorCode(err) == postgres.UniqueViolationErrorCode {
+		return domain.DuplicateRelationshipError
+	}
+
+	return err
+}
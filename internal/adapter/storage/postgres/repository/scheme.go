@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/Masterminds/squirrel"
 	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
 	pg "github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/sqlc"
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
@@ -29,7 +30,7 @@ func NewSchemeRepository(db *postgres.DB, q pg.Querier) *SchemeRepository {
 func (r *SchemeRepository) GetSchemeByID(ctx context.Context, id uuid.UUID) (*domain.Scheme, error) {
 	// Get the scheme by ID
 	schemesQuery := r.db.QueryBuilder.
-		Select("id", "name", "created_at", "updated_at").
+		Select("id", "name", "reapply_cooldown_days", "created_at", "updated_at").
 		From("schemes").
 		Where("id = ? AND deleted_at IS NULL", id)
 
@@ -40,7 +41,7 @@ func (r *SchemeRepository) GetSchemeByID(ctx context.Context, id uuid.UUID) (*do
 
 	row := r.db.QueryRow(ctx, sql, args...)
 	var scheme domain.Scheme
-	err = row.Scan(&scheme.ID, &scheme.Name, &scheme.CreatedAt, &scheme.UpdatedAt)
+	err = row.Scan(&scheme.ID, &scheme.Name, &scheme.ReapplyCooldownDays, &scheme.CreatedAt, &scheme.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.SchemeNotFoundError
@@ -49,6 +50,7 @@ func (r *SchemeRepository) GetSchemeByID(ctx context.Context, id uuid.UUID) (*do
 	}
 	scheme.Benefits = &[]domain.Benefit{}
 	scheme.Criteria = &[]domain.SchemeCriteria{}
+	scheme.CriteriaGroups = &[]domain.SchemeCriteriaGroup{}
 
 	// Create a temporary map with just this scheme
 	schemeMap := map[uuid.UUID]*domain.Scheme{*scheme.ID: &scheme}
@@ -66,55 +68,118 @@ func (r *SchemeRepository) GetSchemeByID(ctx context.Context, id uuid.UUID) (*do
 	return &scheme, nil
 }
 
-// ListSchemes retrieves a list of schemes available.
-func (r *SchemeRepository) ListSchemes(ctx context.Context) ([]domain.Scheme, error) {
-	// Get all schemes
-	schemesList, err := r.q.ListSchemes(ctx)
+// schemeSortColumns maps the fields schemes can be sorted by to their columns
+var schemeSortColumns = map[string]string{
+	"name":       "name",
+	"created_at": "created_at",
+}
+
+// ListSchemes retrieves a page of schemes matching the filter, along with the total number of matching schemes.
+func (r *SchemeRepository) ListSchemes(ctx context.Context, filter domain.SchemeFilter) ([]domain.Scheme, int, error) {
+	where := squirrel.And{squirrel.Eq{"deleted_at": nil}}
+
+	if filter.Name != nil {
+		where = append(where, squirrel.ILike{"name": containsPattern(*filter.Name)})
+	}
+
+	query := r.db.QueryBuilder.
+		Select("id", "created_at", "updated_at", "deleted_at", "name", "reapply_cooldown_days").
+		From("schemes").
+		Where(where)
+
+	query, err := applyListOptions(query, filter.ListOptions, schemeSortColumns)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domain.SchemeNotFoundError
-		}
-		return nil, err
+		return nil, 0, err
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build schemes query: %w", err)
 	}
 
-	// Store schemes in a map
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	// Store schemes in a map, keeping the order they were sorted in
 	schemesMap := make(map[uuid.UUID]*domain.Scheme)
+	schemeIDs := make([]uuid.UUID, 0)
+
+	for rows.Next() {
+		var dbScheme pg.Scheme
+		if err := rows.Scan(&dbScheme.ID, &dbScheme.CreatedAt, &dbScheme.UpdatedAt, &dbScheme.DeletedAt, &dbScheme.Name, &dbScheme.ReapplyCooldownDays); err != nil {
+			return nil, 0, err
+		}
 
-	for _, dbScheme := range schemesList {
 		scheme := dbScheme.ToEntity()
 		scheme.Benefits = &[]domain.Benefit{}
 		scheme.Criteria = &[]domain.SchemeCriteria{}
+		scheme.CriteriaGroups = &[]domain.SchemeCriteriaGroup{}
 		schemesMap[*scheme.ID] = scheme
+		schemeIDs = append(schemeIDs, *scheme.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := countRows(ctx, r.db, "schemes", where)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	// Fetch benefits and map them to schemes
 	if err := r.fetchBenefitsForSchemes(ctx, schemesMap, nil); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Fetch criteria and map them to schemes
 	if err := r.fetchCriteriaForSchemes(ctx, schemesMap, nil); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Convert map to slice
-	schemes := make([]domain.Scheme, 0, len(schemesMap))
-	for _, scheme := range schemesMap {
-		schemes = append(schemes, *scheme)
+	schemes := make([]domain.Scheme, 0, len(schemeIDs))
+	for _, id := range schemeIDs {
+		schemes = append(schemes, *schemesMap[id])
 	}
 
-	return schemes, nil
+	return schemes, total, nil
 }
 
 // CreateScheme inserts a new scheme into the database and returns the created scheme or an error if one occurs.
 func (r *SchemeRepository) CreateScheme(ctx context.Context, scheme *domain.Scheme) (newScheme *domain.Scheme, err error) {
 	dbScheme := pg.SchemeFromEntity(scheme)
 
-	a, err := r.q.CreateScheme(ctx, dbScheme.Name)
+	params := pg.CreateSchemeParams{
+		Name:                dbScheme.Name,
+		ReapplyCooldownDays: dbScheme.ReapplyCooldownDays,
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	a, err := qtx.CreateScheme(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
+	err = audit.record(ctx, domain.AuditEntityScheme, a.ID, domain.AuditActionCreate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return a.ToEntity(), nil
 }
 
@@ -139,6 +204,11 @@ func (r *SchemeRepository) UpdateScheme(ctx context.Context, scheme *domain.Sche
 		setFields = true
 	}
 
+	if scheme.ReapplyCooldownDays != nil {
+		query = query.Set("reapply_cooldown_days", *scheme.ReapplyCooldownDays)
+		setFields = true
+	}
+
 	if !setFields {
 		return nil, domain.NoUpdateFieldsError
 	}
@@ -150,7 +220,21 @@ func (r *SchemeRepository) UpdateScheme(ctx context.Context, scheme *domain.Sche
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, sql, args...)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityScheme, *scheme.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.SchemeNotFoundError
@@ -159,7 +243,7 @@ func (r *SchemeRepository) UpdateScheme(ctx context.Context, scheme *domain.Sche
 		return nil, err
 	}
 
-	updatedDbScheme, err = r.q.GetScheme(ctx, *scheme.ID)
+	updatedDbScheme, err = qtx.GetScheme(ctx, *scheme.ID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.SchemeNotFoundError
@@ -168,12 +252,35 @@ func (r *SchemeRepository) UpdateScheme(ctx context.Context, scheme *domain.Sche
 		return nil, err
 	}
 
+	err = audit.record(ctx, domain.AuditEntityScheme, *scheme.ID, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return updatedDbScheme.ToEntity(), nil
 }
 
 // DeleteScheme deletes a scheme record from the database by their unique identifier. Returns an error if the operation fails.
 func (r *SchemeRepository) DeleteScheme(ctx context.Context, id uuid.UUID) (err error) {
-	err = r.q.DeleteScheme(ctx, id)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityScheme, id)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteScheme(ctx, id)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -183,7 +290,12 @@ func (r *SchemeRepository) DeleteScheme(ctx context.Context, id uuid.UUID) (err
 		return err
 	}
 
-	return nil
+	err = audit.record(ctx, domain.AuditEntityScheme, id, domain.AuditActionDelete, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 // =======================================================
@@ -207,11 +319,31 @@ func (r *SchemeRepository) fetchBenefitsForSchemes(ctx context.Context, schemeMa
 		return err
 	}
 
+	// Store benefits in a map
+	benefitMap := make(map[uuid.UUID]*domain.Benefit)
+	benefits := make([]*domain.Benefit, 0, len(benefitArray))
+
 	for _, benefit := range benefitArray {
 		schemeBenefit := benefit.ToEntity()
+		schemeBenefit.Criteria = &[]domain.BenefitCriteria{}
+		benefitMap[benefit.ID] = schemeBenefit
+		benefits = append(benefits, schemeBenefit)
+	}
 
-		if scheme, exists := schemeMap[benefit.SchemeID]; exists {
-			*scheme.Benefits = append(*scheme.Benefits, *schemeBenefit)
+	// Fetch criteria and map them to benefits
+	if schemeID != nil {
+		for _, benefit := range benefits {
+			if err := r.fetchCriteriaForBenefits(ctx, benefitMap, benefit.ID); err != nil {
+				return err
+			}
+		}
+	} else if err := r.fetchCriteriaForBenefits(ctx, benefitMap, nil); err != nil {
+		return err
+	}
+
+	for _, benefit := range benefits {
+		if scheme, exists := schemeMap[*benefit.SchemeID]; exists {
+			*scheme.Benefits = append(*scheme.Benefits, *benefit)
 		}
 	}
 
@@ -230,7 +362,16 @@ func (r *SchemeRepository) GetBenefitByID(ctx context.Context, benefitID uuid.UU
 		return nil, err
 	}
 
-	return benefit.ToEntity(), nil
+	benefitEntity := benefit.ToEntity()
+	benefitEntity.Criteria = &[]domain.BenefitCriteria{}
+
+	// Fetch criteria for the benefit
+	benefitMap := map[uuid.UUID]*domain.Benefit{benefit.ID: benefitEntity}
+	if err := r.fetchCriteriaForBenefits(ctx, benefitMap, &benefit.ID); err != nil {
+		return nil, err
+	}
+
+	return benefitEntity, nil
 }
 
 // AddSchemeBenefit inserts a new benefit into a specific scheme and returns the created benefit or an error if one occurs.
@@ -238,15 +379,37 @@ func (r *SchemeRepository) AddSchemeBenefit(ctx context.Context, benefit *domain
 	dbBenefit := pg.BenefitFromEntity(benefit)
 
 	params := pg.CreateBenefitParams{
-		SchemeID: dbBenefit.SchemeID,
-		Name:     dbBenefit.Name,
-		Amount:   dbBenefit.Amount,
+		SchemeID:       dbBenefit.SchemeID,
+		Name:           dbBenefit.Name,
+		Amount:         dbBenefit.Amount,
+		Frequency:      dbBenefit.Frequency,
+		DurationMonths: dbBenefit.DurationMonths,
+		Cap:            dbBenefit.Cap,
+		Currency:       dbBenefit.Currency,
 	}
 
-	b, err := r.q.CreateBenefit(ctx, params)
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	b, err := qtx.CreateBenefit(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityBenefit, b.ID, domain.AuditActionCreate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
 
 	return b.ToEntity(), nil
 }
@@ -258,6 +421,7 @@ func (r *SchemeRepository) UpdateSchemeBenefit(ctx context.Context, benefit *dom
 	}
 
 	query := r.db.QueryBuilder.Update("benefits").Where("id = ? AND scheme_id = ?", *benefit.ID, benefit.SchemeID)
+	dbBenefit := pg.BenefitFromEntity(benefit)
 
 	setFields := false
 
@@ -266,8 +430,20 @@ func (r *SchemeRepository) UpdateSchemeBenefit(ctx context.Context, benefit *dom
 		setFields = true
 	}
 
+	// The currency of a benefit is the currency of its amount
 	if benefit.Amount != nil {
-		query = query.Set("amount", *benefit.Amount)
+		query = query.
+			Set("amount", dbBenefit.Amount).
+			Set("currency", dbBenefit.Currency)
+		setFields = true
+	}
+
+	// The frequency, duration and cap of a benefit are its payout schedule, and are replaced together
+	if benefit.Frequency != nil {
+		query = query.
+			Set("frequency", *benefit.Frequency).
+			Set("duration_months", benefit.DurationMonths).
+			Set("cap", dbBenefit.Cap)
 		setFields = true
 	}
 
@@ -280,7 +456,21 @@ func (r *SchemeRepository) UpdateSchemeBenefit(ctx context.Context, benefit *dom
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, sql, args...)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityBenefit, *benefit.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.BenefitNotFoundError
@@ -289,7 +479,7 @@ func (r *SchemeRepository) UpdateSchemeBenefit(ctx context.Context, benefit *dom
 		return nil, err
 	}
 
-	updatedBenefitEntity, err := r.q.GetBenefitByID(ctx, *benefit.ID)
+	updatedBenefitEntity, err := qtx.GetBenefitByID(ctx, *benefit.ID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.BenefitNotFoundError
@@ -297,13 +487,36 @@ func (r *SchemeRepository) UpdateSchemeBenefit(ctx context.Context, benefit *dom
 		return nil, err
 	}
 
+	err = audit.record(ctx, domain.AuditEntityBenefit, *benefit.ID, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return updatedBenefitEntity.ToEntity(), nil
 }
 
 // DeleteSchemeBenefit deletes a benefit from the specified scheme by its ID.
 // Returns an error if the operation fails.
 func (r *SchemeRepository) DeleteSchemeBenefit(ctx context.Context, benefitID uuid.UUID) (err error) {
-	err = r.q.DeleteBenefit(ctx, benefitID)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityBenefit, benefitID)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteBenefit(ctx, benefitID)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -313,39 +526,301 @@ func (r *SchemeRepository) DeleteSchemeBenefit(ctx context.Context, benefitID uu
 		return err
 	}
 
+	err = audit.record(ctx, domain.AuditEntityBenefit, benefitID, domain.AuditActionDelete, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// =======================================================
+// ============= Benefit Criteria Functions ==============
+// =======================================================
+
+// fetchCriteriaForBenefits fetches criteria for specified benefits
+func (r *SchemeRepository) fetchCriteriaForBenefits(ctx context.Context, benefitMap map[uuid.UUID]*domain.Benefit, benefitID *uuid.UUID) error {
+	var err error
+	var criteriaArray []pg.BenefitCriterium
+
+	if benefitID != nil {
+		// Query for a specific benefit
+		criteriaArray, err = r.q.GetBenefitCriteriaByBenefitID(ctx, *benefitID)
+	} else {
+		// Query for all benefits
+		criteriaArray, err = r.q.GetAllBenefitCriteria(ctx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, criteria := range criteriaArray {
+		benefitCriteria := criteria.ToEntity()
+
+		if benefit, exists := benefitMap[criteria.BenefitID]; exists {
+			*benefit.Criteria = append(*benefit.Criteria, *benefitCriteria)
+		}
+	}
+
 	return nil
 }
 
+// GetBenefitCriteriaByID retrieves a benefit criteria by its ID or returns an error if not found.
+func (r *SchemeRepository) GetBenefitCriteriaByID(ctx context.Context, criteriaID uuid.UUID) (*domain.BenefitCriteria, error) {
+	criteria, err := r.q.GetBenefitCriteriaByID(ctx, criteriaID)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.BenefitCriteriaNotFoundError
+		}
+
+		return nil, err
+	}
+
+	return criteria.ToEntity(), nil
+}
+
+// ListBenefitCriteria retrieves all criteria of a specific benefit.
+func (r *SchemeRepository) ListBenefitCriteria(ctx context.Context, benefitID uuid.UUID) ([]domain.BenefitCriteria, error) {
+	criteriaArray, err := r.q.GetBenefitCriteriaByBenefitID(ctx, benefitID)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := make([]domain.BenefitCriteria, len(criteriaArray))
+	for i, c := range criteriaArray {
+		criteria[i] = *c.ToEntity()
+	}
+
+	return criteria, nil
+}
+
+// AddBenefitCriteria adds a new criteria to a specific benefit and returns the created criteria or an error if one occurs.
+func (r *SchemeRepository) AddBenefitCriteria(ctx context.Context, criteria *domain.BenefitCriteria) (newCriteria *domain.BenefitCriteria, err error) {
+	dbBenefitCriteria := pg.BenefitCriteriumFromEntity(criteria)
+
+	params := pg.CreateBenefitCriteriaParams{
+		BenefitID: dbBenefitCriteria.BenefitID,
+		Name:      dbBenefitCriteria.Name,
+		Value:     dbBenefitCriteria.Value,
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	c, err := qtx.CreateBenefitCriteria(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityBenefitCriteria, c.ID, domain.AuditActionCreate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.ToEntity(), nil
+}
+
+// UpdateBenefitCriteria updates existing criteria of the specified benefit and returns the updated criteria or an error if one occurs.
+func (r *SchemeRepository) UpdateBenefitCriteria(ctx context.Context, criteria *domain.BenefitCriteria) (updatedCriteria *domain.BenefitCriteria, err error) {
+	if criteria.ID == nil {
+		return nil, fmt.Errorf("criteria ID cannot be nil")
+	}
+
+	query := r.db.QueryBuilder.Update("benefit_criteria").Where("id = ? AND benefit_id = ?", *criteria.ID, criteria.BenefitID)
+
+	setFields := false
+
+	if criteria.Name != nil {
+		query = query.Set("name", *criteria.Name)
+		setFields = true
+	}
+
+	if criteria.Value != nil {
+		query = query.Set("value", *criteria.Value)
+		setFields = true
+	}
+
+	if !setFields {
+		return nil, domain.NoUpdateFieldsError
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityBenefitCriteria, *criteria.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedCriteriaEntity, err := qtx.GetBenefitCriteriaByID(ctx, *criteria.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.BenefitCriteriaNotFoundError
+		}
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityBenefitCriteria, *criteria.ID, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return updatedCriteriaEntity.ToEntity(), nil
+}
+
+// DeleteBenefitCriteria deletes a criteria from a benefit by its ID.
+// Returns an error if the operation fails.
+func (r *SchemeRepository) DeleteBenefitCriteria(ctx context.Context, criteriaID uuid.UUID) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityBenefitCriteria, criteriaID)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteBenefitCriteria(ctx, criteriaID)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.BenefitCriteriaNotFoundError
+		}
+
+		return err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityBenefitCriteria, criteriaID, domain.AuditActionDelete, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
 // =======================================================
 // ============== Scheme Criteria Functions ==============
 // =======================================================
 
-// fetchCriteriaForSchemes fetches criteria for specified schemes
+// fetchCriteriaForSchemes fetches criteria and criteria groups for specified schemes
 func (r *SchemeRepository) fetchCriteriaForSchemes(ctx context.Context, schemeMap map[uuid.UUID]*domain.Scheme, schemeID *uuid.UUID) error {
 	var err error
 	var criteriaArray []pg.SchemeCriterium
+	var groupArray []pg.SchemeCriteriaGroup
 
 	if schemeID != nil {
 		// Query for a specific scheme
 		criteriaArray, err = r.q.GetSchemeCriteria(ctx, *schemeID)
+		if err == nil {
+			groupArray, err = r.q.GetSchemeCriteriaGroups(ctx, *schemeID)
+		}
 	} else {
 		// Query for all schemes
 		criteriaArray, err = r.q.ListSchemeCriteria(ctx)
+		if err == nil {
+			groupArray, err = r.q.ListSchemeCriteriaGroups(ctx)
+		}
 	}
 
 	if err != nil {
 		return err
 	}
 
+	assembleSchemeCriteria(schemeMap, criteriaArray, groupArray)
+
+	return nil
+}
+
+// assembleSchemeCriteria adds criteria and the trees of criteria groups they belong to onto the schemes in the map
+func assembleSchemeCriteria(schemeMap map[uuid.UUID]*domain.Scheme, criteriaArray []pg.SchemeCriterium, groupArray []pg.SchemeCriteriaGroup) {
+	// Store grouped criteria by the group they belong to
+	groupCriteria := make(map[uuid.UUID][]domain.SchemeCriteria)
+
 	for _, criteria := range criteriaArray {
 		schemeCriteria := criteria.ToEntity()
 
+		if criteria.GroupID.Valid {
+			groupCriteria[criteria.GroupID.UUID] = append(groupCriteria[criteria.GroupID.UUID], *schemeCriteria)
+			continue
+		}
+
 		if scheme, exists := schemeMap[criteria.SchemeID]; exists {
 			*scheme.Criteria = append(*scheme.Criteria, *schemeCriteria)
 		}
 	}
 
-	return nil
+	// Store nested groups by their parent group
+	childGroups := make(map[uuid.UUID][]pg.SchemeCriteriaGroup)
+
+	for _, group := range groupArray {
+		if group.ParentGroupID.Valid {
+			childGroups[group.ParentGroupID.UUID] = append(childGroups[group.ParentGroupID.UUID], group)
+		}
+	}
+
+	// Build the tree of every top-level group
+	for _, group := range groupArray {
+		if group.ParentGroupID.Valid {
+			continue
+		}
+
+		if scheme, exists := schemeMap[group.SchemeID]; exists {
+			*scheme.CriteriaGroups = append(*scheme.CriteriaGroups, buildCriteriaGroup(group, childGroups, groupCriteria))
+		}
+	}
+}
+
+// buildCriteriaGroup assembles a criteria group together with its criteria and nested groups
+func buildCriteriaGroup(group pg.SchemeCriteriaGroup, childGroups map[uuid.UUID][]pg.SchemeCriteriaGroup, groupCriteria map[uuid.UUID][]domain.SchemeCriteria) domain.SchemeCriteriaGroup {
+	criteriaGroup := group.ToEntity()
+
+	criteria := append([]domain.SchemeCriteria{}, groupCriteria[group.ID]...)
+	criteriaGroup.Criteria = &criteria
+
+	groups := make([]domain.SchemeCriteriaGroup, 0, len(childGroups[group.ID]))
+	for _, childGroup := range childGroups[group.ID] {
+		groups = append(groups, buildCriteriaGroup(childGroup, childGroups, groupCriteria))
+	}
+	criteriaGroup.Groups = &groups
+
+	return *criteriaGroup
 }
 
 // GetSchemeCriteriaByID retrieves the criteria of a specific scheme by its ID or returns an error if not found.
@@ -373,11 +848,29 @@ func (r *SchemeRepository) AddSchemeCriteria(ctx context.Context, criteria *doma
 		Value:    dbSchemeCriteria.Value,
 	}
 
-	c, err := r.q.CreateSchemeCriteria(ctx, params)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	c, err := qtx.CreateSchemeCriteria(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntitySchemeCriteria, c.ID, domain.AuditActionCreate, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return c.ToEntity(), nil
 }
 
@@ -410,7 +903,21 @@ func (r *SchemeRepository) UpdateSchemeCriteria(ctx context.Context, criteria *d
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, sql, args...)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntitySchemeCriteria, *criteria.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.SchemeCriteriaNotFoundError
@@ -419,7 +926,7 @@ func (r *SchemeRepository) UpdateSchemeCriteria(ctx context.Context, criteria *d
 		return nil, err
 	}
 
-	updatedCriteriaEntity, err := r.q.GetSchemeCriteriaByID(ctx, *criteria.ID)
+	updatedCriteriaEntity, err := qtx.GetSchemeCriteriaByID(ctx, *criteria.ID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.SchemeCriteriaNotFoundError
@@ -427,13 +934,36 @@ func (r *SchemeRepository) UpdateSchemeCriteria(ctx context.Context, criteria *d
 		return nil, err
 	}
 
+	err = audit.record(ctx, domain.AuditEntitySchemeCriteria, *criteria.ID, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return updatedCriteriaEntity.ToEntity(), nil
 }
 
 // DeleteSchemeCriteria deletes a criteria from the specified scheme by its ID.
 // Returns an error if the operation fails.
 func (r *SchemeRepository) DeleteSchemeCriteria(ctx context.Context, criteriaID uuid.UUID) (err error) {
-	err = r.q.DeleteSchemeCriteria(ctx, criteriaID)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntitySchemeCriteria, criteriaID)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteSchemeCriteria(ctx, criteriaID)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -443,5 +973,154 @@ func (r *SchemeRepository) DeleteSchemeCriteria(ctx context.Context, criteriaID
 		return err
 	}
 
-	return nil
+	err = audit.record(ctx, domain.AuditEntitySchemeCriteria, criteriaID, domain.AuditActionDelete, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// =======================================================
+// =========== Scheme Criteria Group Functions ===========
+// =======================================================
+
+// GetSchemeCriteriaGroupByID retrieves a criteria group by its ID, without its criteria and nested groups,
+// or returns an error if not found.
+func (r *SchemeRepository) GetSchemeCriteriaGroupByID(ctx context.Context, groupID uuid.UUID) (*domain.SchemeCriteriaGroup, error) {
+	group, err := r.q.GetSchemeCriteriaGroupByID(ctx, groupID)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.CriteriaGroupNotFoundError
+		}
+
+		return nil, err
+	}
+
+	return group.ToEntity(), nil
+}
+
+// AddSchemeCriteriaGroup adds a criteria group, together with all of its criteria and nested groups, to a specific scheme
+// in a single transaction and returns the created group or an error if one occurs.
+func (r *SchemeRepository) AddSchemeCriteriaGroup(ctx context.Context, group *domain.SchemeCriteriaGroup) (newGroup *domain.SchemeCriteriaGroup, err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	newGroup, err = r.createCriteriaGroup(ctx, pg.New(tx), newAuditor(tx), group, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return newGroup, nil
+}
+
+// createCriteriaGroup recursively inserts a criteria group with its criteria and nested groups under the given parent group,
+// recording each of them with the given auditor.
+func (r *SchemeRepository) createCriteriaGroup(ctx context.Context, q pg.Querier, audit *auditor, group *domain.SchemeCriteriaGroup, parentGroupID *uuid.UUID) (*domain.SchemeCriteriaGroup, error) {
+	group.ParentGroupID = parentGroupID
+	dbGroup := pg.SchemeCriteriaGroupFromEntity(group)
+
+	g, err := q.CreateSchemeCriteriaGroup(ctx, pg.CreateSchemeCriteriaGroupParams{
+		SchemeID:      dbGroup.SchemeID,
+		ParentGroupID: dbGroup.ParentGroupID,
+		Operator:      dbGroup.Operator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityCriteriaGroup, g.ID, domain.AuditActionCreate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	newGroup := g.ToEntity()
+	criteria := []domain.SchemeCriteria{}
+	groups := []domain.SchemeCriteriaGroup{}
+
+	if group.Criteria != nil {
+		for _, c := range *group.Criteria {
+			c.SchemeID = newGroup.SchemeID
+			c.GroupID = newGroup.ID
+			dbSchemeCriteria := pg.SchemeCriteriumFromEntity(&c)
+
+			newCriteria, err := q.CreateSchemeCriteria(ctx, pg.CreateSchemeCriteriaParams{
+				Name:     dbSchemeCriteria.Name,
+				Value:    dbSchemeCriteria.Value,
+				SchemeID: dbSchemeCriteria.SchemeID,
+				GroupID:  dbSchemeCriteria.GroupID,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			err = audit.record(ctx, domain.AuditEntitySchemeCriteria, newCriteria.ID, domain.AuditActionCreate, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			criteria = append(criteria, *newCriteria.ToEntity())
+		}
+	}
+
+	if group.Groups != nil {
+		for _, nestedGroup := range *group.Groups {
+			nestedGroup.SchemeID = newGroup.SchemeID
+
+			newNestedGroup, err := r.createCriteriaGroup(ctx, q, audit, &nestedGroup, newGroup.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			groups = append(groups, *newNestedGroup)
+		}
+	}
+
+	newGroup.Criteria = &criteria
+	newGroup.Groups = &groups
+
+	return newGroup, nil
+}
+
+// DeleteSchemeCriteriaGroup deletes a criteria group together with its criteria and nested groups by its ID.
+// Returns an error if the operation fails.
+func (r *SchemeRepository) DeleteSchemeCriteriaGroup(ctx context.Context, groupID uuid.UUID) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityCriteriaGroup, groupID)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteSchemeCriteriaGroup(ctx, groupID)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.CriteriaGroupNotFoundError
+		}
+
+		return err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityCriteriaGroup, groupID, domain.AuditActionDelete, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
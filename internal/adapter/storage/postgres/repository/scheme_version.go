@@ -0,0 +1,359 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
+	pg "github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/sqlc"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+// =======================================================
+// =============== Scheme Version Functions ==============
+// =======================================================
+
+// schemeDefinition is the JSON document built by the scheme_definition database function when a version is published
+type schemeDefinition struct {
+	Scheme struct {
+		ID                  uuid.UUID `json:"id"`
+		Name                string    `json:"name"`
+		ReapplyCooldownDays int32     `json:"reapply_cooldown_days"`
+	} `json:"scheme"`
+	Benefits []struct {
+		ID             uuid.UUID           `json:"id"`
+		SchemeID       uuid.UUID           `json:"scheme_id"`
+		Name           string              `json:"name"`
+		Amount         pgtype.Numeric      `json:"amount"`
+		Frequency      pg.BenefitFrequency `json:"frequency"`
+		DurationMonths pgtype.Int4         `json:"duration_months"`
+		Cap            pgtype.Numeric      `json:"cap"`
+		Currency       string              `json:"currency"`
+	} `json:"benefits"`
+	BenefitCriteria []struct {
+		ID        uuid.UUID   `json:"id"`
+		BenefitID uuid.UUID   `json:"benefit_id"`
+		Name      string      `json:"name"`
+		Value     pgtype.Text `json:"value"`
+	} `json:"benefit_criteria"`
+	Criteria []struct {
+		ID       uuid.UUID     `json:"id"`
+		SchemeID uuid.UUID     `json:"scheme_id"`
+		GroupID  uuid.NullUUID `json:"group_id"`
+		Name     string        `json:"name"`
+		Value    pgtype.Text   `json:"value"`
+	} `json:"criteria"`
+	CriteriaGroups []struct {
+		ID            uuid.UUID                `json:"id"`
+		SchemeID      uuid.UUID                `json:"scheme_id"`
+		ParentGroupID uuid.NullUUID            `json:"parent_group_id"`
+		Operator      pg.CriteriaGroupOperator `json:"operator"`
+	} `json:"criteria_groups"`
+}
+
+// toSchemeVersion converts a scheme version row into its domain representation, decoding its definition if it has one
+func toSchemeVersion(v pg.SchemeVersion) (*domain.SchemeVersion, error) {
+	version := v.ToEntity()
+
+	if v.Definition == nil {
+		return version, nil
+	}
+
+	var definition schemeDefinition
+	if err := json.Unmarshal(v.Definition, &definition); err != nil {
+		return nil, fmt.Errorf("failed to decode definition of scheme version %s: %w", v.ID, err)
+	}
+
+	reapplyCooldownDays := int(definition.Scheme.ReapplyCooldownDays)
+	scheme := &domain.Scheme{
+		ID:                  &definition.Scheme.ID,
+		Name:                &definition.Scheme.Name,
+		ReapplyCooldownDays: &reapplyCooldownDays,
+		VersionID:           version.ID,
+		Version:             version.Version,
+		Benefits:            &[]domain.Benefit{},
+		Criteria:            &[]domain.SchemeCriteria{},
+		CriteriaGroups:      &[]domain.SchemeCriteriaGroup{},
+	}
+
+	// Store benefit criteria by the benefit they belong to
+	benefitCriteria := make(map[uuid.UUID][]domain.BenefitCriteria)
+	for _, c := range definition.BenefitCriteria {
+		criteria := pg.BenefitCriterium{ID: c.ID, BenefitID: c.BenefitID, Name: c.Name, Value: c.Value}
+		benefitCriteria[c.BenefitID] = append(benefitCriteria[c.BenefitID], *criteria.ToEntity())
+	}
+
+	for _, b := range definition.Benefits {
+		// Versions published before benefits had a payout schedule only paid their benefits once
+		if b.Frequency == "" {
+			b.Frequency = pg.BenefitFrequencyOneOff
+		}
+
+		// and were paid in the default currency
+		if b.Currency == "" {
+			b.Currency = string(domain.DefaultCurrency)
+		}
+
+		dbBenefit := pg.Benefit{
+			ID:             b.ID,
+			SchemeID:       b.SchemeID,
+			Name:           b.Name,
+			Amount:         b.Amount,
+			Frequency:      b.Frequency,
+			DurationMonths: b.DurationMonths,
+			Cap:            b.Cap,
+			Currency:       b.Currency,
+		}
+		benefit := dbBenefit.ToEntity()
+		criteria := append([]domain.BenefitCriteria{}, benefitCriteria[b.ID]...)
+		benefit.Criteria = &criteria
+		*scheme.Benefits = append(*scheme.Benefits, *benefit)
+	}
+
+	criteriaArray := make([]pg.SchemeCriterium, 0, len(definition.Criteria))
+	for _, c := range definition.Criteria {
+		criteriaArray = append(criteriaArray, pg.SchemeCriterium{ID: c.ID, SchemeID: c.SchemeID, GroupID: c.GroupID, Name: c.Name, Value: c.Value})
+	}
+
+	groupArray := make([]pg.SchemeCriteriaGroup, 0, len(definition.CriteriaGroups))
+	for _, g := range definition.CriteriaGroups {
+		groupArray = append(groupArray, pg.SchemeCriteriaGroup{ID: g.ID, SchemeID: g.SchemeID, ParentGroupID: g.ParentGroupID, Operator: g.Operator})
+	}
+
+	assembleSchemeCriteria(map[uuid.UUID]*domain.Scheme{*scheme.ID: scheme}, criteriaArray, groupArray)
+
+	version.Definition = scheme
+
+	return version, nil
+}
+
+// toSchemeVersions converts scheme version rows into their domain representation
+func toSchemeVersions(rows []pg.SchemeVersion) ([]domain.SchemeVersion, error) {
+	versions := make([]domain.SchemeVersion, 0, len(rows))
+
+	for _, row := range rows {
+		version, err := toSchemeVersion(row)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, *version)
+	}
+
+	return versions, nil
+}
+
+// ListSchemeVersions retrieves every version of a scheme, latest first.
+func (r *SchemeRepository) ListSchemeVersions(ctx context.Context, schemeID uuid.UUID) ([]domain.SchemeVersion, error) {
+	rows, err := r.q.ListSchemeVersions(ctx, schemeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSchemeVersions(rows)
+}
+
+// GetSchemeVersionByID retrieves a scheme version by its ID, or returns domain.SchemeVersionNotFoundError if not found.
+func (r *SchemeRepository) GetSchemeVersionByID(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error) {
+	row, err := r.q.GetSchemeVersion(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.SchemeVersionNotFoundError
+		}
+		return nil, err
+	}
+
+	return toSchemeVersion(row)
+}
+
+// GetEffectiveSchemeVersion retrieves the published version of a scheme in effect on the given date.
+// Returns domain.SchemeNotEffectiveError if no version is in effect on that date.
+func (r *SchemeRepository) GetEffectiveSchemeVersion(ctx context.Context, schemeID uuid.UUID, asOf time.Time) (*domain.SchemeVersion, error) {
+	params := pg.GetEffectiveSchemeVersionParams{
+		SchemeID: schemeID,
+		AsOf:     pgtype.Date{Time: asOf, Valid: true},
+	}
+
+	row, err := r.q.GetEffectiveSchemeVersion(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.SchemeNotEffectiveError
+		}
+		return nil, err
+	}
+
+	return toSchemeVersion(row)
+}
+
+// ListEffectiveSchemeVersions retrieves the published version of every scheme that has one in effect on the given date.
+func (r *SchemeRepository) ListEffectiveSchemeVersions(ctx context.Context, asOf time.Time) ([]domain.SchemeVersion, error) {
+	rows, err := r.q.ListEffectiveSchemeVersions(ctx, pgtype.Date{Time: asOf, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return toSchemeVersions(rows)
+}
+
+// CreateSchemeVersion creates a new draft version of a scheme with the given effective dates.
+// Returns domain.DuplicateSchemeVersionDraftError if the scheme already has a draft.
+func (r *SchemeRepository) CreateSchemeVersion(ctx context.Context, version *domain.SchemeVersion) (*domain.SchemeVersion, error) {
+	if version == nil || version.SchemeID == nil || version.EffectiveFrom == nil {
+		return nil, fmt.Errorf("scheme version must have a scheme and an effective from date")
+	}
+
+	dbVersion := pg.SchemeVersionFromEntity(version)
+
+	params := pg.CreateSchemeVersionParams{
+		SchemeID:      dbVersion.SchemeID,
+		EffectiveFrom: dbVersion.EffectiveFrom,
+		EffectiveTo:   dbVersion.EffectiveTo,
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	row, err := qtx.CreateSchemeVersion(ctx, params)
+	if err != nil {
+		if r.db.ErrorCode(err) == postgres.UniqueViolationErrorCode {
+			return nil, domain.DuplicateSchemeVersionDraftError
+		}
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntitySchemeVersion, row.ID, domain.AuditActionCreate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return toSchemeVersion(row)
+}
+
+// PublishSchemeVersion publishes a draft version, copying the current benefits and criteria of its scheme into it.
+// Returns domain.InvalidSchemeVersionTransitionError if the version is not a draft.
+func (r *SchemeRepository) PublishSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error) {
+	return r.transitionSchemeVersion(ctx, id, func(q *pg.Queries) (pg.SchemeVersion, error) {
+		return q.PublishSchemeVersion(ctx, id)
+	})
+}
+
+// RetireSchemeVersion retires a published version so that it is no longer in effect.
+// Returns domain.InvalidSchemeVersionTransitionError if the version is not published.
+func (r *SchemeRepository) RetireSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error) {
+	return r.transitionSchemeVersion(ctx, id, func(q *pg.Queries) (pg.SchemeVersion, error) {
+		return q.RetireSchemeVersion(ctx, id)
+	})
+}
+
+// transitionSchemeVersion runs a status change on a scheme version and records it in the audit log
+func (r *SchemeRepository) transitionSchemeVersion(ctx context.Context, id uuid.UUID, transition func(q *pg.Queries) (pg.SchemeVersion, error)) (*domain.SchemeVersion, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntitySchemeVersion, id)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := transition(qtx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.InvalidSchemeVersionTransitionError
+		}
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntitySchemeVersion, id, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return toSchemeVersion(row)
+}
+
+// EndSchemeVersion sets the date a scheme version stops being in effect.
+func (r *SchemeRepository) EndSchemeVersion(ctx context.Context, id uuid.UUID, effectiveTo time.Time) error {
+	params := pg.EndSchemeVersionParams{
+		ID:          id,
+		EffectiveTo: pgtype.Date{Time: effectiveTo, Valid: true},
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntitySchemeVersion, id)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.EndSchemeVersion(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	err = audit.record(ctx, domain.AuditEntitySchemeVersion, id, domain.AuditActionUpdate, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// DeleteSchemeVersion deletes a draft scheme version. Published and retired versions are kept.
+func (r *SchemeRepository) DeleteSchemeVersion(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntitySchemeVersion, id)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteSchemeVersion(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = audit.record(ctx, domain.AuditEntitySchemeVersion, id, domain.AuditActionDelete, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
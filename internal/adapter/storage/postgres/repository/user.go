@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres"
+	pg "github.com/cxnub/fas-mgmt-system/internal/adapter/storage/postgres/sqlc"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// UserRepository provides methods for managing the users of the system in the database.
+type UserRepository struct {
+	db *postgres.DB
+	q  pg.Querier
+}
+
+// NewUserRepository creates a new instance of UserRepository with the provided database and querier dependencies.
+func NewUserRepository(db *postgres.DB, q pg.Querier) *UserRepository {
+	return &UserRepository{db: db, q: q}
+}
+
+// GetUserByID retrieves a user by their ID, or returns domain.UserNotFoundError if they do not exist.
+func (r *UserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	user, err := r.q.GetUser(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.UserNotFoundError
+		}
+		return nil, err
+	}
+
+	return user.ToEntity(), nil
+}
+
+// GetUserByEmail retrieves a user by their email, ignoring case, or returns domain.UserNotFoundError if they do not exist.
+func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	user, err := r.q.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.UserNotFoundError
+		}
+		return nil, err
+	}
+
+	return user.ToEntity(), nil
+}
+
+// ListUsers retrieves all users, newest first.
+func (r *UserRepository) ListUsers(ctx context.Context) ([]domain.User, error) {
+	dbUsers, err := r.q.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]domain.User, len(dbUsers))
+	for i, dbUser := range dbUsers {
+		users[i] = *dbUser.ToEntity()
+	}
+
+	return users, nil
+}
+
+// CountUsers returns the number of users.
+func (r *UserRepository) CountUsers(ctx context.Context) (int, error) {
+	count, err := r.q.CountUsers(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// CreateUser inserts a new user into the database and returns the created user.
+func (r *UserRepository) CreateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+	dbUser := pg.UserFromEntity(user)
+
+	params := pg.CreateUserParams{
+		Email:        dbUser.Email,
+		Name:         dbUser.Name,
+		PasswordHash: dbUser.PasswordHash,
+		Role:         dbUser.Role,
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	u, err := qtx.CreateUser(ctx, params)
+	if err != nil {
+		return nil, r.mapWriteError(err)
+	}
+
+	err = audit.record(ctx, domain.AuditEntityUser, u.ID, domain.AuditActionCreate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return u.ToEntity(), nil
+}
+
+// UpdateUser updates the given fields of an existing user and returns the updated user.
+// Password changes are recorded in the audit log without the password hash.
+func (r *UserRepository) UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+	query := r.db.QueryBuilder.Update("users")
+
+	setFields := false
+
+	if user.Email != nil {
+		query = query.Set("email", *user.Email)
+		setFields = true
+	}
+
+	if user.Name != nil {
+		query = query.Set("name", *user.Name)
+		setFields = true
+	}
+
+	if user.PasswordHash != nil {
+		query = query.Set("password_hash", *user.PasswordHash)
+		setFields = true
+	}
+
+	if user.Role != nil {
+		query = query.Set("role", *user.Role)
+		setFields = true
+	}
+
+	if !setFields {
+		return nil, domain.NoUpdateFieldsError
+	}
+
+	query = query.Where("id = ? AND deleted_at IS NULL", user.ID)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityUser, *user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return nil, r.mapWriteError(err)
+	}
+
+	u, err := qtx.GetUser(ctx, *user.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.UserNotFoundError
+		}
+		return nil, err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityUser, *user.ID, domain.AuditActionUpdate, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return u.ToEntity(), nil
+}
+
+// DeleteUser soft deletes a user by their ID.
+func (r *UserRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pg.New(tx)
+	audit := newAuditor(tx)
+
+	before, err := audit.snapshot(ctx, domain.AuditEntityUser, id)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = audit.record(ctx, domain.AuditEntityUser, id, domain.AuditActionDelete, before)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// mapWriteError converts unique constraint violations into domain.DuplicateUserEmailError.
+func (r *UserRepository) mapWriteError(err error) error {
+	if r.db.ErrorCode(err) == postgres.UniqueViolationErrorCode {
+		return domain.DuplicateUserEmailError
+	}
+
+	return err
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+)
+
+// sampleDataMigration is the migration that inserts the sample applicants, relationships, schemes and application
+const sampleDataMigration = "migrations/000009_seeder.up.sql"
+
+// sampleApplicantID is the ID of the first applicant inserted by sampleDataMigration
+const sampleApplicantID = "b6c29c96-024b-4e70-834b-8e0dd2c66645"
+
+// publishSampleSchemesSQL publishes the first version of the sample schemes and assesses the sample application against
+// it, the way the migration that added scheme versions did for the schemes that existed before it. Every other scheme
+// is given a version when it is created.
+const publishSampleSchemesSQL = `
+INSERT INTO scheme_versions (id, created_at, scheme_id, version, status, effective_from, definition, published_at)
+SELECT gen_random_uuid(), now(), s.id, 1, 'published', s.created_at::date, scheme_definition(s.id), now()
+FROM schemes s
+WHERE s.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheme_versions v WHERE v.scheme_id = s.id);
+
+UPDATE applications a
+SET scheme_version_id = v.id
+FROM scheme_versions v
+WHERE a.scheme_version_id IS NULL AND v.scheme_id = a.scheme_id AND v.version = 1;`
+
+// SeedSampleData inserts the rows of the seeder migration, with the same IDs, unless they are already in the database,
+// and reports whether they were inserted. Databases migrated past the seeder migration already have them.
+func (db *DB) SeedSampleData(ctx context.Context) (bool, error) {
+	sql, err := migrations.ReadFile(sampleDataMigration)
+	if err != nil {
+		return false, err
+	}
+
+	seeded := false
+	err = db.WithinTransaction(ctx, func(ctx context.Context) error {
+		var exists bool
+		err := db.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM applicants WHERE id = $1)", sampleApplicantID).Scan(&exists)
+		if err != nil || exists {
+			return err
+		}
+
+		// Queries without arguments may hold several statements
+		if _, err = db.Exec(ctx, string(sql)); err != nil {
+			return err
+		}
+
+		if _, err = db.Exec(ctx, publishSampleSchemesSQL); err != nil {
+			return err
+		}
+
+		seeded = true
+		return nil
+	})
+
+	return seeded, err
+}
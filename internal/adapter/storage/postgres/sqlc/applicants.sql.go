@@ -20,11 +20,14 @@ INSERT INTO applicants (
     employment_status,
     marital_status,
     sex,
-    date_of_birth
+    date_of_birth,
+    monthly_income,
+    assets,
+    has_disability
 ) VALUES (
-             gen_random_uuid(), now(), $1, $2, $3, $4, $5
+             gen_random_uuid(), now(), $1, $2, $3, $4, $5, $6, $7, $8
          )
-RETURNING id, created_at, updated_at, deleted_at, name, employment_status, marital_status, sex, date_of_birth
+RETURNING id, created_at, updated_at, deleted_at, name, employment_status, marital_status, sex, date_of_birth, monthly_income, assets, has_disability
 `
 
 type CreateApplicantParams struct {
@@ -33,6 +36,9 @@ type CreateApplicantParams struct {
 	MaritalStatus    MaritalStatus
 	Sex              Sex
 	DateOfBirth      pgtype.Date
+	MonthlyIncome    pgtype.Numeric
+	Assets           pgtype.Numeric
+	HasDisability    bool
 }
 
 // Used for POST /api/applicants
@@ -43,6 +49,9 @@ func (q *Queries) CreateApplicant(ctx context.Context, arg CreateApplicantParams
 		arg.MaritalStatus,
 		arg.Sex,
 		arg.DateOfBirth,
+		arg.MonthlyIncome,
+		arg.Assets,
+		arg.HasDisability,
 	)
 	var i Applicant
 	err := row.Scan(
@@ -55,6 +64,9 @@ func (q *Queries) CreateApplicant(ctx context.Context, arg CreateApplicantParams
 		&i.MaritalStatus,
 		&i.Sex,
 		&i.DateOfBirth,
+		&i.MonthlyIncome,
+		&i.Assets,
+		&i.HasDisability,
 	)
 	return i, err
 }
@@ -74,7 +86,7 @@ func (q *Queries) DeleteApplicant(ctx context.Context, id uuid.UUID) error {
 
 const getApplicant = `-- name: GetApplicant :one
 
-SELECT id, created_at, updated_at, deleted_at, name, employment_status, marital_status, sex, date_of_birth FROM applicants
+SELECT id, created_at, updated_at, deleted_at, name, employment_status, marital_status, sex, date_of_birth, monthly_income, assets, has_disability FROM applicants
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -93,20 +105,26 @@ func (q *Queries) GetApplicant(ctx context.Context, id uuid.UUID) (Applicant, er
 		&i.MaritalStatus,
 		&i.Sex,
 		&i.DateOfBirth,
+		&i.MonthlyIncome,
+		&i.Assets,
+		&i.HasDisability,
 	)
 	return i, err
 }
 
 const getApplicantWithFamily = `-- name: GetApplicantWithFamily :many
 SELECT
-    a.id, a.created_at, a.updated_at, a.deleted_at, a.name, a.employment_status, a.marital_status, a.sex, a.date_of_birth,
+    a.id, a.created_at, a.updated_at, a.deleted_at, a.name, a.employment_status, a.marital_status, a.sex, a.date_of_birth, a.monthly_income, a.assets, a.has_disability,
     r.relationship_type,
     family.id as family_member_id,
     family.name as family_member_name,
     family.employment_status as family_member_employment_status,
     family.marital_status as family_member_marital_status,
     family.sex as family_member_sex,
-    family.date_of_birth as family_member_date_of_birth
+    family.date_of_birth as family_member_date_of_birth,
+    family.monthly_income as family_member_monthly_income,
+    family.assets as family_member_assets,
+    family.has_disability as family_member_has_disability
 FROM applicants a
          LEFT JOIN relationships r ON a.id = r.applicant_a_id AND r.deleted_at IS NULL
          LEFT JOIN applicants family ON r.applicant_b_id = family.id AND family.deleted_at IS NULL
@@ -123,6 +141,9 @@ type GetApplicantWithFamilyRow struct {
 	MaritalStatus                MaritalStatus
 	Sex                          Sex
 	DateOfBirth                  pgtype.Date
+	MonthlyIncome                pgtype.Numeric
+	Assets                       pgtype.Numeric
+	HasDisability                bool
 	RelationshipType             NullRelationshipType
 	FamilyMemberID               pgtype.UUID
 	FamilyMemberName             pgtype.Text
@@ -130,6 +151,9 @@ type GetApplicantWithFamilyRow struct {
 	FamilyMemberMaritalStatus    NullMaritalStatus
 	FamilyMemberSex              NullSex
 	FamilyMemberDateOfBirth      pgtype.Date
+	FamilyMemberMonthlyIncome    pgtype.Numeric
+	FamilyMemberAssets           pgtype.Numeric
+	FamilyMemberHasDisability    pgtype.Bool
 }
 
 // Used for getting an applicant with their family members
@@ -152,6 +176,9 @@ func (q *Queries) GetApplicantWithFamily(ctx context.Context, id uuid.UUID) ([]G
 			&i.MaritalStatus,
 			&i.Sex,
 			&i.DateOfBirth,
+			&i.MonthlyIncome,
+			&i.Assets,
+			&i.HasDisability,
 			&i.RelationshipType,
 			&i.FamilyMemberID,
 			&i.FamilyMemberName,
@@ -159,6 +186,9 @@ func (q *Queries) GetApplicantWithFamily(ctx context.Context, id uuid.UUID) ([]G
 			&i.FamilyMemberMaritalStatus,
 			&i.FamilyMemberSex,
 			&i.FamilyMemberDateOfBirth,
+			&i.FamilyMemberMonthlyIncome,
+			&i.FamilyMemberAssets,
+			&i.FamilyMemberHasDisability,
 		); err != nil {
 			return nil, err
 		}
@@ -171,7 +201,7 @@ func (q *Queries) GetApplicantWithFamily(ctx context.Context, id uuid.UUID) ([]G
 }
 
 const listApplicants = `-- name: ListApplicants :many
-SELECT id, created_at, updated_at, deleted_at, name, employment_status, marital_status, sex, date_of_birth FROM applicants
+SELECT id, created_at, updated_at, deleted_at, name, employment_status, marital_status, sex, date_of_birth, monthly_income, assets, has_disability FROM applicants
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -196,6 +226,9 @@ func (q *Queries) ListApplicants(ctx context.Context) ([]Applicant, error) {
 			&i.MaritalStatus,
 			&i.Sex,
 			&i.DateOfBirth,
+			&i.MonthlyIncome,
+			&i.Assets,
+			&i.HasDisability,
 		); err != nil {
 			return nil, err
 		}
@@ -214,9 +247,12 @@ SET
     employment_status = $3,
     marital_status = $4,
     sex = $5,
-    date_of_birth = $6
+    date_of_birth = $6,
+    monthly_income = $7,
+    assets = $8,
+    has_disability = $9
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, created_at, updated_at, deleted_at, name, employment_status, marital_status, sex, date_of_birth
+RETURNING id, created_at, updated_at, deleted_at, name, employment_status, marital_status, sex, date_of_birth, monthly_income, assets, has_disability
 `
 
 type UpdateApplicantParams struct {
@@ -226,6 +262,9 @@ type UpdateApplicantParams struct {
 	MaritalStatus    MaritalStatus
 	Sex              Sex
 	DateOfBirth      pgtype.Date
+	MonthlyIncome    pgtype.Numeric
+	Assets           pgtype.Numeric
+	HasDisability    bool
 }
 
 // Used for PUT /api/applicants/{id}
@@ -237,6 +276,9 @@ func (q *Queries) UpdateApplicant(ctx context.Context, arg UpdateApplicantParams
 		arg.MaritalStatus,
 		arg.Sex,
 		arg.DateOfBirth,
+		arg.MonthlyIncome,
+		arg.Assets,
+		arg.HasDisability,
 	)
 	var i Applicant
 	err := row.Scan(
@@ -249,6 +291,9 @@ func (q *Queries) UpdateApplicant(ctx context.Context, arg UpdateApplicantParams
 		&i.MaritalStatus,
 		&i.Sex,
 		&i.DateOfBirth,
+		&i.MonthlyIncome,
+		&i.Assets,
+		&i.HasDisability,
 	)
 	return i, err
 }
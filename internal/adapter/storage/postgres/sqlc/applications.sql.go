@@ -17,21 +17,23 @@ INSERT INTO applications (
     id,
     created_at,
     applicant_id,
-    scheme_id
+    scheme_id,
+    scheme_version_id
 ) VALUES (
-             gen_random_uuid(), now(), $1, $2
+             gen_random_uuid(), now(), $1, $2, $3
          )
-RETURNING id, created_at, updated_at, deleted_at, applicant_id, scheme_id
+RETURNING id, created_at, updated_at, deleted_at, applicant_id, scheme_id, status, scheme_version_id
 `
 
 type CreateApplicationParams struct {
-	ApplicantID uuid.UUID
-	SchemeID    uuid.UUID
+	ApplicantID     uuid.UUID
+	SchemeID        uuid.UUID
+	SchemeVersionID uuid.NullUUID
 }
 
 // Used for POST /api/applications
 func (q *Queries) CreateApplication(ctx context.Context, arg CreateApplicationParams) (Application, error) {
-	row := q.db.QueryRow(ctx, createApplication, arg.ApplicantID, arg.SchemeID)
+	row := q.db.QueryRow(ctx, createApplication, arg.ApplicantID, arg.SchemeID, arg.SchemeVersionID)
 	var i Application
 	err := row.Scan(
 		&i.ID,
@@ -40,6 +42,8 @@ func (q *Queries) CreateApplication(ctx context.Context, arg CreateApplicationPa
 		&i.DeletedAt,
 		&i.ApplicantID,
 		&i.SchemeID,
+		&i.Status,
+		&i.SchemeVersionID,
 	)
 	return i, err
 }
@@ -59,7 +63,7 @@ func (q *Queries) DeleteApplication(ctx context.Context, id uuid.UUID) error {
 
 const getApplication = `-- name: GetApplication :one
 
-SELECT id, created_at, updated_at, deleted_at, applicant_id, scheme_id FROM applications
+SELECT id, created_at, updated_at, deleted_at, applicant_id, scheme_id, status, scheme_version_id FROM applications
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -75,12 +79,14 @@ func (q *Queries) GetApplication(ctx context.Context, id uuid.UUID) (Application
 		&i.DeletedAt,
 		&i.ApplicantID,
 		&i.SchemeID,
+		&i.Status,
+		&i.SchemeVersionID,
 	)
 	return i, err
 }
 
 const getApplicationsByApplicant = `-- name: GetApplicationsByApplicant :many
-SELECT id, created_at, updated_at, deleted_at, applicant_id, scheme_id FROM applications
+SELECT id, created_at, updated_at, deleted_at, applicant_id, scheme_id, status, scheme_version_id FROM applications
 WHERE applicant_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -102,6 +108,49 @@ func (q *Queries) GetApplicationsByApplicant(ctx context.Context, applicantID uu
 			&i.DeletedAt,
 			&i.ApplicantID,
 			&i.SchemeID,
+			&i.Status,
+			&i.SchemeVersionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getApplicationsByApplicantAndScheme = `-- name: GetApplicationsByApplicantAndScheme :many
+SELECT id, created_at, updated_at, deleted_at, applicant_id, scheme_id, status, scheme_version_id FROM applications
+WHERE applicant_id = $1 AND scheme_id = $2 AND deleted_at IS NULL
+ORDER BY created_at DESC
+`
+
+type GetApplicationsByApplicantAndSchemeParams struct {
+	ApplicantID uuid.UUID
+	SchemeID    uuid.UUID
+}
+
+// Used for checking an applicant's existing applications before applying to a scheme
+func (q *Queries) GetApplicationsByApplicantAndScheme(ctx context.Context, arg GetApplicationsByApplicantAndSchemeParams) ([]Application, error) {
+	rows, err := q.db.Query(ctx, getApplicationsByApplicantAndScheme, arg.ApplicantID, arg.SchemeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Application
+	for rows.Next() {
+		var i Application
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ApplicantID,
+			&i.SchemeID,
+			&i.Status,
+			&i.SchemeVersionID,
 		); err != nil {
 			return nil, err
 		}
@@ -115,7 +164,7 @@ func (q *Queries) GetApplicationsByApplicant(ctx context.Context, applicantID uu
 
 const getApplicationsWithDetails = `-- name: GetApplicationsWithDetails :many
 SELECT
-    app.id, app.created_at, app.updated_at, app.deleted_at, app.applicant_id, app.scheme_id,
+    app.id, app.created_at, app.updated_at, app.deleted_at, app.applicant_id, app.scheme_id, app.status, app.scheme_version_id,
     a.name as applicant_name,
     a.employment_status as applicant_employment_status,
     s.name as scheme_name
@@ -139,6 +188,8 @@ type GetApplicationsWithDetailsRow struct {
 	DeletedAt                 pgtype.Timestamp
 	ApplicantID               uuid.UUID
 	SchemeID                  uuid.UUID
+	Status                    ApplicationStatus
+	SchemeVersionID           uuid.NullUUID
 	ApplicantName             string
 	ApplicantEmploymentStatus EmploymentStatus
 	SchemeName                string
@@ -161,6 +212,8 @@ func (q *Queries) GetApplicationsWithDetails(ctx context.Context, arg GetApplica
 			&i.DeletedAt,
 			&i.ApplicantID,
 			&i.SchemeID,
+			&i.Status,
+			&i.SchemeVersionID,
 			&i.ApplicantName,
 			&i.ApplicantEmploymentStatus,
 			&i.SchemeName,
@@ -176,7 +229,7 @@ func (q *Queries) GetApplicationsWithDetails(ctx context.Context, arg GetApplica
 }
 
 const listApplications = `-- name: ListApplications :many
-SELECT id, created_at, updated_at, deleted_at, applicant_id, scheme_id FROM applications
+SELECT id, created_at, updated_at, deleted_at, applicant_id, scheme_id, status, scheme_version_id FROM applications
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -198,6 +251,8 @@ func (q *Queries) ListApplications(ctx context.Context) ([]Application, error) {
 			&i.DeletedAt,
 			&i.ApplicantID,
 			&i.SchemeID,
+			&i.Status,
+			&i.SchemeVersionID,
 		); err != nil {
 			return nil, err
 		}
@@ -215,7 +270,7 @@ SET
     applicant_id = $2,
     scheme_id = $3
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, created_at, updated_at, deleted_at, applicant_id, scheme_id
+RETURNING id, created_at, updated_at, deleted_at, applicant_id, scheme_id, status, scheme_version_id
 `
 
 type UpdateApplicationParams struct {
@@ -235,6 +290,40 @@ func (q *Queries) UpdateApplication(ctx context.Context, arg UpdateApplicationPa
 		&i.DeletedAt,
 		&i.ApplicantID,
 		&i.SchemeID,
+		&i.Status,
+		&i.SchemeVersionID,
+	)
+	return i, err
+}
+
+const updateApplicationStatus = `-- name: UpdateApplicationStatus :one
+UPDATE applications
+SET
+    status = $1
+WHERE id = $2 AND status = $3 AND deleted_at IS NULL
+RETURNING id, created_at, updated_at, deleted_at, applicant_id, scheme_id, status, scheme_version_id
+`
+
+type UpdateApplicationStatusParams struct {
+	NewStatus     ApplicationStatus
+	ID            uuid.UUID
+	CurrentStatus ApplicationStatus
+}
+
+// Used for the status transition endpoints under /api/applications/{id}
+// Only updates the application if it is still in the expected status
+func (q *Queries) UpdateApplicationStatus(ctx context.Context, arg UpdateApplicationStatusParams) (Application, error) {
+	row := q.db.QueryRow(ctx, updateApplicationStatus, arg.NewStatus, arg.ID, arg.CurrentStatus)
+	var i Application
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ApplicantID,
+		&i.SchemeID,
+		&i.Status,
+		&i.SchemeVersionID,
 	)
 	return i, err
 }
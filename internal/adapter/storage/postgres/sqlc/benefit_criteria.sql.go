@@ -12,9 +12,10 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const createBenefitCriteria = `-- name: CreateBenefitCriteria :exec
+const createBenefitCriteria = `-- name: CreateBenefitCriteria :one
 INSERT INTO benefit_criteria (id, created_at, name, value, benefit_id)
 VALUES (gen_random_uuid(), now(), $1, $2, $3)
+RETURNING id, created_at, updated_at, deleted_at, name, value, benefit_id
 `
 
 type CreateBenefitCriteriaParams struct {
@@ -23,9 +24,19 @@ type CreateBenefitCriteriaParams struct {
 	BenefitID uuid.UUID
 }
 
-func (q *Queries) CreateBenefitCriteria(ctx context.Context, arg CreateBenefitCriteriaParams) error {
-	_, err := q.db.Exec(ctx, createBenefitCriteria, arg.Name, arg.Value, arg.BenefitID)
-	return err
+func (q *Queries) CreateBenefitCriteria(ctx context.Context, arg CreateBenefitCriteriaParams) (BenefitCriterium, error) {
+	row := q.db.QueryRow(ctx, createBenefitCriteria, arg.Name, arg.Value, arg.BenefitID)
+	var i BenefitCriterium
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Name,
+		&i.Value,
+		&i.BenefitID,
+	)
+	return i, err
 }
 
 const deleteBenefitCriteria = `-- name: DeleteBenefitCriteria :exec
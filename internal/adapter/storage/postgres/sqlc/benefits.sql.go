@@ -18,22 +18,38 @@ INSERT INTO benefits (
     created_at,
     scheme_id,
     name,
-    amount
+    amount,
+    frequency,
+    duration_months,
+    cap,
+    currency
 ) VALUES (
-            gen_random_uuid(), now(), $1, $2, $3
+            gen_random_uuid(), now(), $1, $2, $3, $4, $5, $6, $7
          )
-RETURNING id, created_at, updated_at, deleted_at, scheme_id, name, amount
+RETURNING id, created_at, updated_at, deleted_at, scheme_id, name, amount, frequency, duration_months, cap, currency
 `
 
 type CreateBenefitParams struct {
-	SchemeID uuid.UUID
-	Name     string
-	Amount   pgtype.Float8
+	SchemeID       uuid.UUID
+	Name           string
+	Amount         pgtype.Numeric
+	Frequency      BenefitFrequency
+	DurationMonths pgtype.Int4
+	Cap            pgtype.Numeric
+	Currency       string
 }
 
 // Used when creating a scheme with benefits
 func (q *Queries) CreateBenefit(ctx context.Context, arg CreateBenefitParams) (Benefit, error) {
-	row := q.db.QueryRow(ctx, createBenefit, arg.SchemeID, arg.Name, arg.Amount)
+	row := q.db.QueryRow(ctx, createBenefit,
+		arg.SchemeID,
+		arg.Name,
+		arg.Amount,
+		arg.Frequency,
+		arg.DurationMonths,
+		arg.Cap,
+		arg.Currency,
+	)
 	var i Benefit
 	err := row.Scan(
 		&i.ID,
@@ -43,6 +59,10 @@ func (q *Queries) CreateBenefit(ctx context.Context, arg CreateBenefitParams) (B
 		&i.SchemeID,
 		&i.Name,
 		&i.Amount,
+		&i.Frequency,
+		&i.DurationMonths,
+		&i.Cap,
+		&i.Currency,
 	)
 	return i, err
 }
@@ -61,7 +81,7 @@ func (q *Queries) DeleteBenefit(ctx context.Context, id uuid.UUID) error {
 }
 
 const getBenefitByID = `-- name: GetBenefitByID :one
-SELECT id, created_at, updated_at, deleted_at, scheme_id, name, amount FROM benefits
+SELECT id, created_at, updated_at, deleted_at, scheme_id, name, amount, frequency, duration_months, cap, currency FROM benefits
 WHERE id = $1 AND deleted_at IS NULL
 LIMIT 1
 `
@@ -78,13 +98,17 @@ func (q *Queries) GetBenefitByID(ctx context.Context, id uuid.UUID) (Benefit, er
 		&i.SchemeID,
 		&i.Name,
 		&i.Amount,
+		&i.Frequency,
+		&i.DurationMonths,
+		&i.Cap,
+		&i.Currency,
 	)
 	return i, err
 }
 
 const getBenefitsByScheme = `-- name: GetBenefitsByScheme :many
 
-SELECT id, created_at, updated_at, deleted_at, scheme_id, name, amount FROM benefits
+SELECT id, created_at, updated_at, deleted_at, scheme_id, name, amount, frequency, duration_months, cap, currency FROM benefits
 WHERE scheme_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -108,6 +132,10 @@ func (q *Queries) GetBenefitsByScheme(ctx context.Context, schemeID uuid.UUID) (
 			&i.SchemeID,
 			&i.Name,
 			&i.Amount,
+			&i.Frequency,
+			&i.DurationMonths,
+			&i.Cap,
+			&i.Currency,
 		); err != nil {
 			return nil, err
 		}
@@ -120,7 +148,7 @@ func (q *Queries) GetBenefitsByScheme(ctx context.Context, schemeID uuid.UUID) (
 }
 
 const listBenefits = `-- name: ListBenefits :many
-SELECT id, created_at, updated_at, deleted_at, scheme_id, name, amount FROM benefits
+SELECT id, created_at, updated_at, deleted_at, scheme_id, name, amount, frequency, duration_months, cap, currency FROM benefits
 WHERE deleted_at is NULL
 `
 
@@ -142,6 +170,10 @@ func (q *Queries) ListBenefits(ctx context.Context) ([]Benefit, error) {
 			&i.SchemeID,
 			&i.Name,
 			&i.Amount,
+			&i.Frequency,
+			&i.DurationMonths,
+			&i.Cap,
+			&i.Currency,
 		); err != nil {
 			return nil, err
 		}
@@ -157,20 +189,36 @@ const updateBenefit = `-- name: UpdateBenefit :one
 UPDATE benefits
 SET
     name = $2,
-    amount = $3
+    amount = $3,
+    frequency = $4,
+    duration_months = $5,
+    cap = $6,
+    currency = $7
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, created_at, updated_at, deleted_at, scheme_id, name, amount
+RETURNING id, created_at, updated_at, deleted_at, scheme_id, name, amount, frequency, duration_months, cap, currency
 `
 
 type UpdateBenefitParams struct {
-	ID     uuid.UUID
-	Name   string
-	Amount pgtype.Float8
+	ID             uuid.UUID
+	Name           string
+	Amount         pgtype.Numeric
+	Frequency      BenefitFrequency
+	DurationMonths pgtype.Int4
+	Cap            pgtype.Numeric
+	Currency       string
 }
 
 // Used when updating scheme benefits
 func (q *Queries) UpdateBenefit(ctx context.Context, arg UpdateBenefitParams) (Benefit, error) {
-	row := q.db.QueryRow(ctx, updateBenefit, arg.ID, arg.Name, arg.Amount)
+	row := q.db.QueryRow(ctx, updateBenefit,
+		arg.ID,
+		arg.Name,
+		arg.Amount,
+		arg.Frequency,
+		arg.DurationMonths,
+		arg.Cap,
+		arg.Currency,
+	)
 	var i Benefit
 	err := row.Scan(
 		&i.ID,
@@ -180,6 +228,10 @@ func (q *Queries) UpdateBenefit(ctx context.Context, arg UpdateBenefitParams) (B
 		&i.SchemeID,
 		&i.Name,
 		&i.Amount,
+		&i.Frequency,
+		&i.DurationMonths,
+		&i.Cap,
+		&i.Currency,
 	)
 	return i, err
 }
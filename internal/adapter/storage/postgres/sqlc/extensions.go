@@ -1,9 +1,11 @@
 package pg
 
 import (
+	"encoding/json"
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"math/big"
 	"time"
 )
 
@@ -39,6 +41,89 @@ func fromDate(t *time.Time) *pgtype.Date {
 	return &pgtype.Date{Valid: false}
 }
 
+// helper to convert nullable pgtype.Numeric to domain.Money. Amounts are stored with two decimal places.
+func toMoney(valid *pgtype.Numeric, currency string) *domain.Money {
+	if valid == nil || !valid.Valid {
+		return nil
+	}
+
+	cents := new(big.Int)
+	if valid.Int != nil {
+		cents.Set(valid.Int)
+	}
+
+	ten := big.NewInt(10)
+	for exp := valid.Exp + 2; exp > 0; exp-- {
+		cents.Mul(cents, ten)
+	}
+	for exp := valid.Exp + 2; exp < 0; exp++ {
+		cents.Quo(cents, ten)
+	}
+
+	return &domain.Money{Cents: cents.Int64(), Currency: domain.Currency(currency)}
+}
+
+// helper to convert domain.Money to nullable pgtype.Numeric
+func fromMoney(m *domain.Money) *pgtype.Numeric {
+	if m != nil {
+		return &pgtype.Numeric{Int: big.NewInt(m.Cents), Exp: -2, Valid: true}
+	}
+	return &pgtype.Numeric{Valid: false}
+}
+
+// helper to get the currency of an amount of money, or the default currency if there is none
+func safeCurrency(m *domain.Money) string {
+	if m == nil || m.Currency == "" {
+		return string(domain.DefaultCurrency)
+	}
+	return string(m.Currency)
+}
+
+// helper to convert nullable pgtype.Int4 to int
+func toInt(valid *pgtype.Int4) *int {
+	if valid != nil && valid.Valid {
+		i := int(valid.Int32)
+		return &i
+	}
+	return nil
+}
+
+// helper to convert int to nullable pgtype.Int4
+func fromInt(i *int) *pgtype.Int4 {
+	if i != nil {
+		return &pgtype.Int4{Int32: int32(*i), Valid: true}
+	}
+	return &pgtype.Int4{Valid: false}
+}
+
+// helper to convert nullable uuid.NullUUID to uuid.UUID
+func toUUID(valid *uuid.NullUUID) *uuid.UUID {
+	if valid != nil && valid.Valid {
+		return &valid.UUID
+	}
+	return nil
+}
+
+// helper to convert uuid.UUID to nullable uuid.NullUUID
+func fromUUID(id *uuid.UUID) uuid.NullUUID {
+	if id != nil {
+		return uuid.NullUUID{UUID: *id, Valid: true}
+	}
+	return uuid.NullUUID{Valid: false}
+}
+
+// helper to convert a JSON object column to a map, returning nil for NULL
+func toJSONMap(b []byte) map[string]any {
+	if b == nil {
+		return nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil
+	}
+	return m
+}
+
 func safeUUID(id *uuid.UUID) uuid.UUID {
 	if id == nil {
 		return uuid.Nil // Return an empty UUID
@@ -53,6 +138,20 @@ func safeString(s *string) string {
 	return *s
 }
 
+func safeInt32(i *int) int32 {
+	if i == nil {
+		return 0 // Default to zero
+	}
+	return int32(*i)
+}
+
+func safeBool(b *bool) bool {
+	if b == nil {
+		return false // Default to false
+	}
+	return *b
+}
+
 func safeEmploymentStatus(es *domain.EmploymentStatus) EmploymentStatus {
 	if es == nil {
 		return "" // Default to empty status
@@ -87,6 +186,9 @@ func (a *Applicant) ToEntity() *domain.Applicant {
 		MaritalStatus:    (*domain.MaritalStatus)(&a.MaritalStatus),
 		Sex:              (*domain.Sex)(&a.Sex),
 		DateOfBirth:      toDate(&a.DateOfBirth),
+		MonthlyIncome:    toMoney(&a.MonthlyIncome, string(domain.DefaultCurrency)),
+		Assets:           toMoney(&a.Assets, string(domain.DefaultCurrency)),
+		HasDisability:    &a.HasDisability,
 		CreatedAt:        toTime(&a.CreatedAt),
 		UpdatedAt:        toTime(&a.UpdatedAt),
 	}
@@ -103,6 +205,9 @@ func ApplicantFromEntity(e *domain.Applicant) *Applicant {
 		MaritalStatus:    safeMaritalStatus(e.MaritalStatus),
 		Sex:              safeSex(e.Sex),
 		DateOfBirth:      *fromDate(e.DateOfBirth),
+		MonthlyIncome:    *fromMoney(e.MonthlyIncome),
+		Assets:           *fromMoney(e.Assets),
+		HasDisability:    safeBool(e.HasDisability),
 		CreatedAt:        *fromTime(e.CreatedAt),
 		UpdatedAt:        *fromTime(e.UpdatedAt),
 	}
@@ -115,11 +220,13 @@ func (a *Application) ToEntity() *domain.Application {
 		return nil
 	}
 	return &domain.Application{
-		ID:          &a.ID,
-		ApplicantID: &a.ApplicantID,
-		SchemeID:    &a.SchemeID,
-		CreatedAt:   toTime(&a.CreatedAt),
-		UpdatedAt:   toTime(&a.UpdatedAt),
+		ID:              &a.ID,
+		ApplicantID:     &a.ApplicantID,
+		SchemeID:        &a.SchemeID,
+		SchemeVersionID: toUUID(&a.SchemeVersionID),
+		Status:          (*domain.ApplicationStatus)(&a.Status),
+		CreatedAt:       toTime(&a.CreatedAt),
+		UpdatedAt:       toTime(&a.UpdatedAt),
 	}
 }
 
@@ -128,11 +235,13 @@ func ApplicationFromEntity(e *domain.Application) *Application {
 		return nil
 	}
 	return &Application{
-		ID:          safeUUID(e.ID),
-		ApplicantID: safeUUID(e.ApplicantID),
-		SchemeID:    safeUUID(e.SchemeID),
-		CreatedAt:   *fromTime(e.CreatedAt),
-		UpdatedAt:   *fromTime(e.UpdatedAt),
+		ID:              safeUUID(e.ID),
+		ApplicantID:     safeUUID(e.ApplicantID),
+		SchemeID:        safeUUID(e.SchemeID),
+		SchemeVersionID: fromUUID(e.SchemeVersionID),
+		Status:          ApplicationStatus(safeString((*string)(e.Status))),
+		CreatedAt:       *fromTime(e.CreatedAt),
+		UpdatedAt:       *fromTime(e.UpdatedAt),
 	}
 }
 
@@ -142,13 +251,21 @@ func (b *Benefit) ToEntity() *domain.Benefit {
 	if b == nil {
 		return nil
 	}
+	// Benefits without an amount pay nothing
+	amount := toMoney(&b.Amount, b.Currency)
+	if amount == nil {
+		amount = &domain.Money{Currency: domain.Currency(b.Currency)}
+	}
 	return &domain.Benefit{
-		ID:        &b.ID,
-		SchemeID:  &b.SchemeID,
-		Name:      &b.Name,
-		Amount:    &b.Amount.Float64,
-		CreatedAt: toTime(&b.CreatedAt),
-		UpdatedAt: toTime(&b.UpdatedAt),
+		ID:             &b.ID,
+		SchemeID:       &b.SchemeID,
+		Name:           &b.Name,
+		Amount:         amount,
+		Frequency:      (*domain.BenefitFrequency)(&b.Frequency),
+		DurationMonths: toInt(&b.DurationMonths),
+		Cap:            toMoney(&b.Cap, b.Currency),
+		CreatedAt:      toTime(&b.CreatedAt),
+		UpdatedAt:      toTime(&b.UpdatedAt),
 	}
 }
 
@@ -157,12 +274,16 @@ func BenefitFromEntity(e *domain.Benefit) *Benefit {
 		return nil
 	}
 	return &Benefit{
-		ID:        safeUUID(e.ID),
-		SchemeID:  safeUUID(e.SchemeID),
-		Name:      safeString(e.Name),
-		Amount:    pgtype.Float8{Float64: *e.Amount, Valid: true},
-		CreatedAt: *fromTime(e.CreatedAt),
-		UpdatedAt: *fromTime(e.UpdatedAt),
+		ID:             safeUUID(e.ID),
+		SchemeID:       safeUUID(e.SchemeID),
+		Name:           safeString(e.Name),
+		Amount:         *fromMoney(e.Amount),
+		Frequency:      BenefitFrequency(safeString((*string)(e.Frequency))),
+		DurationMonths: *fromInt(e.DurationMonths),
+		Cap:            *fromMoney(e.Cap),
+		Currency:       safeCurrency(e.Amount),
+		CreatedAt:      *fromTime(e.CreatedAt),
+		UpdatedAt:      *fromTime(e.UpdatedAt),
 	}
 }
 
@@ -196,6 +317,48 @@ func BenefitCriteriumFromEntity(e *domain.BenefitCriteria) *BenefitCriterium {
 	}
 }
 
+// ==================== Disbursement Conversions ====================
+
+func (d *Disbursement) ToEntity() *domain.Disbursement {
+	if d == nil {
+		return nil
+	}
+	installment := int(d.Installment)
+	return &domain.Disbursement{
+		ID:            &d.ID,
+		ApplicationID: &d.ApplicationID,
+		BenefitID:     &d.BenefitID,
+		BenefitName:   &d.BenefitName,
+		Installment:   &installment,
+		Amount:        toMoney(&d.Amount, d.Currency),
+		DueDate:       toDate(&d.DueDate),
+		Status:        (*domain.DisbursementStatus)(&d.Status),
+		PaidAt:        toTime(&d.PaidAt),
+		CreatedAt:     toTime(&d.CreatedAt),
+		UpdatedAt:     toTime(&d.UpdatedAt),
+	}
+}
+
+func DisbursementFromEntity(e *domain.Disbursement) *Disbursement {
+	if e == nil {
+		return nil
+	}
+	return &Disbursement{
+		ID:            safeUUID(e.ID),
+		ApplicationID: safeUUID(e.ApplicationID),
+		BenefitID:     safeUUID(e.BenefitID),
+		BenefitName:   safeString(e.BenefitName),
+		Installment:   safeInt32(e.Installment),
+		Amount:        *fromMoney(e.Amount),
+		DueDate:       *fromDate(e.DueDate),
+		Status:        DisbursementStatus(safeString((*string)(e.Status))),
+		PaidAt:        *fromTime(e.PaidAt),
+		Currency:      safeCurrency(e.Amount),
+		CreatedAt:     *fromTime(e.CreatedAt),
+		UpdatedAt:     *fromTime(e.UpdatedAt),
+	}
+}
+
 // ==================== Relationship Conversions ====================
 
 func (r *Relationship) ToEntity() *domain.Relationship {
@@ -232,11 +395,13 @@ func (s *Scheme) ToEntity() *domain.Scheme {
 	if s == nil {
 		return nil
 	}
+	reapplyCooldownDays := int(s.ReapplyCooldownDays)
 	return &domain.Scheme{
-		ID:        &s.ID,
-		Name:      &s.Name,
-		CreatedAt: toTime(&s.CreatedAt),
-		UpdatedAt: toTime(&s.UpdatedAt),
+		ID:                  &s.ID,
+		Name:                &s.Name,
+		ReapplyCooldownDays: &reapplyCooldownDays,
+		CreatedAt:           toTime(&s.CreatedAt),
+		UpdatedAt:           toTime(&s.UpdatedAt),
 	}
 }
 
@@ -245,10 +410,51 @@ func SchemeFromEntity(e *domain.Scheme) *Scheme {
 		return nil
 	}
 	return &Scheme{
-		ID:        safeUUID(e.ID),
-		Name:      safeString(e.Name),
-		CreatedAt: *fromTime(e.CreatedAt),
-		UpdatedAt: *fromTime(e.UpdatedAt),
+		ID:                  safeUUID(e.ID),
+		Name:                safeString(e.Name),
+		ReapplyCooldownDays: safeInt32(e.ReapplyCooldownDays),
+		CreatedAt:           *fromTime(e.CreatedAt),
+		UpdatedAt:           *fromTime(e.UpdatedAt),
+	}
+}
+
+// ==================== SchemeVersion Conversions ====================
+
+// ToEntity converts the version without its definition, which is decoded by the repository.
+func (v *SchemeVersion) ToEntity() *domain.SchemeVersion {
+	if v == nil {
+		return nil
+	}
+	version := int(v.Version)
+	return &domain.SchemeVersion{
+		ID:            &v.ID,
+		SchemeID:      &v.SchemeID,
+		Version:       &version,
+		Status:        (*domain.SchemeVersionStatus)(&v.Status),
+		EffectiveFrom: toDate(&v.EffectiveFrom),
+		EffectiveTo:   toDate(&v.EffectiveTo),
+		PublishedAt:   toTime(&v.PublishedAt),
+		RetiredAt:     toTime(&v.RetiredAt),
+		CreatedAt:     toTime(&v.CreatedAt),
+		UpdatedAt:     toTime(&v.UpdatedAt),
+	}
+}
+
+func SchemeVersionFromEntity(e *domain.SchemeVersion) *SchemeVersion {
+	if e == nil {
+		return nil
+	}
+	return &SchemeVersion{
+		ID:            safeUUID(e.ID),
+		SchemeID:      safeUUID(e.SchemeID),
+		Version:       safeInt32(e.Version),
+		Status:        SchemeVersionStatus(safeString((*string)(e.Status))),
+		EffectiveFrom: *fromDate(e.EffectiveFrom),
+		EffectiveTo:   *fromDate(e.EffectiveTo),
+		PublishedAt:   *fromTime(e.PublishedAt),
+		RetiredAt:     *fromTime(e.RetiredAt),
+		CreatedAt:     *fromTime(e.CreatedAt),
+		UpdatedAt:     *fromTime(e.UpdatedAt),
 	}
 }
 
@@ -261,6 +467,7 @@ func (sc *SchemeCriterium) ToEntity() *domain.SchemeCriteria {
 	return &domain.SchemeCriteria{
 		ID:        &sc.ID,
 		SchemeID:  &sc.SchemeID,
+		GroupID:   toUUID(&sc.GroupID),
 		Name:      &sc.Name,
 		Value:     &sc.Value.String,
 		CreatedAt: toTime(&sc.CreatedAt),
@@ -275,9 +482,98 @@ func SchemeCriteriumFromEntity(e *domain.SchemeCriteria) *SchemeCriterium {
 	return &SchemeCriterium{
 		ID:        safeUUID(e.ID),
 		SchemeID:  safeUUID(e.SchemeID),
+		GroupID:   fromUUID(e.GroupID),
 		Name:      safeString(e.Name),
 		Value:     pgtype.Text{String: safeString(e.Value), Valid: *e.Value != ""},
 		CreatedAt: *fromTime(e.CreatedAt),
 		UpdatedAt: *fromTime(e.UpdatedAt),
 	}
 }
+
+// ==================== SchemeCriteriaGroup Conversions ====================
+
+func (g *SchemeCriteriaGroup) ToEntity() *domain.SchemeCriteriaGroup {
+	if g == nil {
+		return nil
+	}
+	return &domain.SchemeCriteriaGroup{
+		ID:            &g.ID,
+		SchemeID:      &g.SchemeID,
+		ParentGroupID: toUUID(&g.ParentGroupID),
+		Operator:      (*domain.CriteriaGroupOperator)(&g.Operator),
+		CreatedAt:     toTime(&g.CreatedAt),
+		UpdatedAt:     toTime(&g.UpdatedAt),
+	}
+}
+
+func SchemeCriteriaGroupFromEntity(e *domain.SchemeCriteriaGroup) *SchemeCriteriaGroup {
+	if e == nil {
+		return nil
+	}
+	return &SchemeCriteriaGroup{
+		ID:            safeUUID(e.ID),
+		SchemeID:      safeUUID(e.SchemeID),
+		ParentGroupID: fromUUID(e.ParentGroupID),
+		Operator:      CriteriaGroupOperator(safeString((*string)(e.Operator))),
+		CreatedAt:     *fromTime(e.CreatedAt),
+		UpdatedAt:     *fromTime(e.UpdatedAt),
+	}
+}
+
+// ==================== User Conversions ====================
+
+func (u *User) ToEntity() *domain.User {
+	if u == nil {
+		return nil
+	}
+	return &domain.User{
+		ID:           &u.ID,
+		Email:        &u.Email,
+		Name:         &u.Name,
+		PasswordHash: &u.PasswordHash,
+		Role:         (*domain.Role)(&u.Role),
+		CreatedAt:    toTime(&u.CreatedAt),
+		UpdatedAt:    toTime(&u.UpdatedAt),
+	}
+}
+
+func UserFromEntity(e *domain.User) *User {
+	if e == nil {
+		return nil
+	}
+	return &User{
+		ID:           safeUUID(e.ID),
+		Email:        safeString(e.Email),
+		Name:         safeString(e.Name),
+		PasswordHash: safeString(e.PasswordHash),
+		Role:         UserRole(safeString((*string)(e.Role))),
+		CreatedAt:    *fromTime(e.CreatedAt),
+		UpdatedAt:    *fromTime(e.UpdatedAt),
+	}
+}
+
+// ==================== Audit Log Conversions ====================
+
+func (a *AuditLog) ToEntity() *domain.AuditLog {
+	if a == nil {
+		return nil
+	}
+
+	var actorRole *domain.Role
+	if a.ActorRole.Valid {
+		role := domain.Role(a.ActorRole.UserRole)
+		actorRole = &role
+	}
+
+	return &domain.AuditLog{
+		ID:         &a.ID,
+		ActorID:    toUUID(&a.ActorID),
+		ActorRole:  actorRole,
+		EntityType: (*domain.AuditEntityType)(&a.EntityType),
+		EntityID:   &a.EntityID,
+		Action:     (*domain.AuditAction)(&a.Action),
+		OldValues:  toJSONMap(a.OldValues),
+		NewValues:  toJSONMap(a.NewValues),
+		CreatedAt:  toTime(&a.CreatedAt),
+	}
+}
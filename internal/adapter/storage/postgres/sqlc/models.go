@@ -12,6 +12,225 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApplicationStatus string
+
+const (
+	ApplicationStatusSubmitted   ApplicationStatus = "submitted"
+	ApplicationStatusUnderReview ApplicationStatus = "under_review"
+	ApplicationStatusApproved    ApplicationStatus = "approved"
+	ApplicationStatusRejected    ApplicationStatus = "rejected"
+	ApplicationStatusWithdrawn   ApplicationStatus = "withdrawn"
+	ApplicationStatusDisbursed   ApplicationStatus = "disbursed"
+)
+
+func (e *ApplicationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ApplicationStatus(s)
+	case string:
+		*e = ApplicationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ApplicationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullApplicationStatus struct {
+	ApplicationStatus ApplicationStatus
+	Valid             bool // Valid is true if ApplicationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullApplicationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ApplicationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ApplicationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullApplicationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ApplicationStatus), nil
+}
+
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+)
+
+func (e *AuditAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuditAction(s)
+	case string:
+		*e = AuditAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuditAction: %T", src)
+	}
+	return nil
+}
+
+type NullAuditAction struct {
+	AuditAction AuditAction
+	Valid       bool // Valid is true if AuditAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuditAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuditAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuditAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuditAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuditAction), nil
+}
+
+type BenefitFrequency string
+
+const (
+	BenefitFrequencyOneOff    BenefitFrequency = "one_off"
+	BenefitFrequencyMonthly   BenefitFrequency = "monthly"
+	BenefitFrequencyQuarterly BenefitFrequency = "quarterly"
+)
+
+func (e *BenefitFrequency) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = BenefitFrequency(s)
+	case string:
+		*e = BenefitFrequency(s)
+	default:
+		return fmt.Errorf("unsupported scan type for BenefitFrequency: %T", src)
+	}
+	return nil
+}
+
+type NullBenefitFrequency struct {
+	BenefitFrequency BenefitFrequency
+	Valid            bool // Valid is true if BenefitFrequency is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullBenefitFrequency) Scan(value interface{}) error {
+	if value == nil {
+		ns.BenefitFrequency, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.BenefitFrequency.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullBenefitFrequency) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.BenefitFrequency), nil
+}
+
+type CriteriaGroupOperator string
+
+const (
+	CriteriaGroupOperatorAnd CriteriaGroupOperator = "and"
+	CriteriaGroupOperatorOr  CriteriaGroupOperator = "or"
+	CriteriaGroupOperatorNot CriteriaGroupOperator = "not"
+)
+
+func (e *CriteriaGroupOperator) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CriteriaGroupOperator(s)
+	case string:
+		*e = CriteriaGroupOperator(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CriteriaGroupOperator: %T", src)
+	}
+	return nil
+}
+
+type NullCriteriaGroupOperator struct {
+	CriteriaGroupOperator CriteriaGroupOperator
+	Valid                 bool // Valid is true if CriteriaGroupOperator is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCriteriaGroupOperator) Scan(value interface{}) error {
+	if value == nil {
+		ns.CriteriaGroupOperator, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CriteriaGroupOperator.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCriteriaGroupOperator) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CriteriaGroupOperator), nil
+}
+
+type DisbursementStatus string
+
+const (
+	DisbursementStatusScheduled  DisbursementStatus = "scheduled"
+	DisbursementStatusPaid       DisbursementStatus = "paid"
+	DisbursementStatusFailed     DisbursementStatus = "failed"
+	DisbursementStatusClawedBack DisbursementStatus = "clawed_back"
+)
+
+func (e *DisbursementStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DisbursementStatus(s)
+	case string:
+		*e = DisbursementStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DisbursementStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDisbursementStatus struct {
+	DisbursementStatus DisbursementStatus
+	Valid              bool // Valid is true if DisbursementStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDisbursementStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DisbursementStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DisbursementStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDisbursementStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DisbursementStatus), nil
+}
+
 type EmploymentStatus string
 
 const (
@@ -142,6 +361,49 @@ func (ns NullRelationshipType) Value() (driver.Value, error) {
 	return string(ns.RelationshipType), nil
 }
 
+type SchemeVersionStatus string
+
+const (
+	SchemeVersionStatusDraft     SchemeVersionStatus = "draft"
+	SchemeVersionStatusPublished SchemeVersionStatus = "published"
+	SchemeVersionStatusRetired   SchemeVersionStatus = "retired"
+)
+
+func (e *SchemeVersionStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SchemeVersionStatus(s)
+	case string:
+		*e = SchemeVersionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SchemeVersionStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSchemeVersionStatus struct {
+	SchemeVersionStatus SchemeVersionStatus
+	Valid               bool // Valid is true if SchemeVersionStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSchemeVersionStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SchemeVersionStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SchemeVersionStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSchemeVersionStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SchemeVersionStatus), nil
+}
+
 type Sex string
 
 const (
@@ -184,6 +446,50 @@ func (ns NullSex) Value() (driver.Value, error) {
 	return string(ns.Sex), nil
 }
 
+type UserRole string
+
+const (
+	UserRoleViewer      UserRole = "viewer"
+	UserRoleCaseworker  UserRole = "caseworker"
+	UserRoleSchemeAdmin UserRole = "scheme_admin"
+	UserRoleSuperadmin  UserRole = "superadmin"
+)
+
+func (e *UserRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserRole(s)
+	case string:
+		*e = UserRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserRole: %T", src)
+	}
+	return nil
+}
+
+type NullUserRole struct {
+	UserRole UserRole
+	Valid    bool // Valid is true if UserRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserRole), nil
+}
+
 type Applicant struct {
 	ID               uuid.UUID
 	CreatedAt        pgtype.Timestamp
@@ -194,25 +500,46 @@ type Applicant struct {
 	MaritalStatus    MaritalStatus
 	Sex              Sex
 	DateOfBirth      pgtype.Date
+	MonthlyIncome    pgtype.Numeric
+	Assets           pgtype.Numeric
+	HasDisability    bool
 }
 
 type Application struct {
-	ID          uuid.UUID
-	CreatedAt   pgtype.Timestamp
-	UpdatedAt   pgtype.Timestamp
-	DeletedAt   pgtype.Timestamp
-	ApplicantID uuid.UUID
-	SchemeID    uuid.UUID
+	ID              uuid.UUID
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	DeletedAt       pgtype.Timestamp
+	ApplicantID     uuid.UUID
+	SchemeID        uuid.UUID
+	Status          ApplicationStatus
+	SchemeVersionID uuid.NullUUID
+}
+
+type AuditLog struct {
+	ID         uuid.UUID
+	CreatedAt  pgtype.Timestamp
+	ActorID    uuid.NullUUID
+	ActorRole  NullUserRole
+	EntityType string
+	EntityID   uuid.UUID
+	Action     AuditAction
+	OldValues  []byte
+	NewValues  []byte
 }
 
 type Benefit struct {
-	ID        uuid.UUID
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
-	SchemeID  uuid.UUID
-	Name      string
-	Amount    pgtype.Float8
+	ID             uuid.UUID
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	DeletedAt      pgtype.Timestamp
+	SchemeID       uuid.UUID
+	Name           string
+	Amount         pgtype.Numeric
+	Frequency      BenefitFrequency
+	DurationMonths pgtype.Int4
+	Cap            pgtype.Numeric
+	Currency       string
 }
 
 type BenefitCriterium struct {
@@ -225,6 +552,22 @@ type BenefitCriterium struct {
 	BenefitID uuid.UUID
 }
 
+type Disbursement struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	DeletedAt     pgtype.Timestamp
+	ApplicationID uuid.UUID
+	BenefitID     uuid.UUID
+	BenefitName   string
+	Installment   int32
+	Amount        pgtype.Numeric
+	DueDate       pgtype.Date
+	Status        DisbursementStatus
+	PaidAt        pgtype.Timestamp
+	Currency      string
+}
+
 type Relationship struct {
 	ID               uuid.UUID
 	CreatedAt        pgtype.Timestamp
@@ -236,11 +579,22 @@ type Relationship struct {
 }
 
 type Scheme struct {
-	ID        uuid.UUID
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
-	Name      string
+	ID                  uuid.UUID
+	CreatedAt           pgtype.Timestamp
+	UpdatedAt           pgtype.Timestamp
+	DeletedAt           pgtype.Timestamp
+	Name                string
+	ReapplyCooldownDays int32
+}
+
+type SchemeCriteriaGroup struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	DeletedAt     pgtype.Timestamp
+	SchemeID      uuid.UUID
+	ParentGroupID uuid.NullUUID
+	Operator      CriteriaGroupOperator
 }
 
 type SchemeCriterium struct {
@@ -251,4 +605,31 @@ type SchemeCriterium struct {
 	Name      string
 	Value     pgtype.Text
 	SchemeID  uuid.UUID
+	GroupID   uuid.NullUUID
+}
+
+type SchemeVersion struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	DeletedAt     pgtype.Timestamp
+	SchemeID      uuid.UUID
+	Version       int32
+	Status        SchemeVersionStatus
+	EffectiveFrom pgtype.Date
+	EffectiveTo   pgtype.Date
+	Definition    []byte
+	PublishedAt   pgtype.Timestamp
+	RetiredAt     pgtype.Timestamp
+}
+
+type User struct {
+	ID           uuid.UUID
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+	DeletedAt    pgtype.Timestamp
+	Email        string
+	Name         string
+	PasswordHash string
+	Role         UserRole
 }
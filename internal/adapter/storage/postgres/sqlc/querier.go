@@ -8,20 +8,37 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	// Used for checking if the first superadmin needs to be created
+	CountUsers(ctx context.Context) (int64, error)
 	// Used for POST /api/applicants
 	CreateApplicant(ctx context.Context, arg CreateApplicantParams) (Applicant, error)
 	// Used for POST /api/applications
 	CreateApplication(ctx context.Context, arg CreateApplicationParams) (Application, error)
+	// db/query/audit_logs.sql
+	// Used for recording every change to an entity
+	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error
 	// Used when creating a scheme with benefits
 	CreateBenefit(ctx context.Context, arg CreateBenefitParams) (Benefit, error)
-	CreateBenefitCriteria(ctx context.Context, arg CreateBenefitCriteriaParams) error
+	CreateBenefitCriteria(ctx context.Context, arg CreateBenefitCriteriaParams) (BenefitCriterium, error)
+	// Used for scheduling the payouts of an application when it is approved
+	CreateDisbursement(ctx context.Context, arg CreateDisbursementParams) (Disbursement, error)
+	// Used for POST /api/applicants/{id}/relationships
+	CreateRelationship(ctx context.Context, arg CreateRelationshipParams) (Relationship, error)
 	// Used for POST /api/schemes
-	CreateScheme(ctx context.Context, name string) (Scheme, error)
+	CreateScheme(ctx context.Context, arg CreateSchemeParams) (Scheme, error)
 	// Used when creating a scheme with criteria
 	CreateSchemeCriteria(ctx context.Context, arg CreateSchemeCriteriaParams) (SchemeCriterium, error)
+	// Used when adding a criteria group to a scheme
+	CreateSchemeCriteriaGroup(ctx context.Context, arg CreateSchemeCriteriaGroupParams) (SchemeCriteriaGroup, error)
+	// Used for POST /api/schemes/{scheme_id}/versions
+	// Versions are numbered in the order they are created for each scheme
+	CreateSchemeVersion(ctx context.Context, arg CreateSchemeVersionParams) (SchemeVersion, error)
+	// Used for POST /api/users
+	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	// Used for DELETE /api/applicants/{id}
 	DeleteApplicant(ctx context.Context, id uuid.UUID) error
 	// Used for DELETE /api/applications/{id}
@@ -29,10 +46,20 @@ type Querier interface {
 	// Used when deleting scheme benefits
 	DeleteBenefit(ctx context.Context, id uuid.UUID) error
 	DeleteBenefitCriteria(ctx context.Context, id uuid.UUID) error
+	// Used for DELETE /api/applicants/{id}/relationships/{relationship_id}
+	DeleteRelationship(ctx context.Context, arg DeleteRelationshipParams) error
 	// Used for DELETE /api/schemes/{id}
 	DeleteScheme(ctx context.Context, id uuid.UUID) error
 	// Used when deleting scheme criteria
 	DeleteSchemeCriteria(ctx context.Context, id uuid.UUID) error
+	// Used when deleting a criteria group together with its nested groups and criteria
+	DeleteSchemeCriteriaGroup(ctx context.Context, id uuid.UUID) error
+	// Used for DELETE /api/schemes/versions/{version_id}
+	DeleteSchemeVersion(ctx context.Context, id uuid.UUID) error
+	// Used for DELETE /api/users/{id}
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+	// Used for ending the current version of a scheme when the next version is published
+	EndSchemeVersion(ctx context.Context, arg EndSchemeVersionParams) error
 	GetAllBenefitCriteria(ctx context.Context) ([]BenefitCriterium, error)
 	// db/query/applicants.sql
 	// Used for GET /api/applicants/{id}
@@ -44,6 +71,8 @@ type Querier interface {
 	GetApplication(ctx context.Context, id uuid.UUID) (Application, error)
 	// Used for getting applications for a specific applicant
 	GetApplicationsByApplicant(ctx context.Context, applicantID uuid.UUID) ([]Application, error)
+	// Used for checking an applicant's existing applications before applying to a scheme
+	GetApplicationsByApplicantAndScheme(ctx context.Context, arg GetApplicationsByApplicantAndSchemeParams) ([]Application, error)
 	// Used for getting applications with applicant and scheme details
 	GetApplicationsWithDetails(ctx context.Context, arg GetApplicationsWithDetailsParams) ([]GetApplicationsWithDetailsRow, error)
 	// Used for getting benefits by id
@@ -53,6 +82,18 @@ type Querier interface {
 	// db/query/benefits.sql
 	// Used for getting benefits for a scheme
 	GetBenefitsByScheme(ctx context.Context, schemeID uuid.UUID) ([]Benefit, error)
+	// db/query/disbursements.sql
+	// Used for the status transition endpoints under /api/applications/{id}/disbursements
+	GetDisbursement(ctx context.Context, id uuid.UUID) (Disbursement, error)
+	// Used for checking an applicant's eligibility for a scheme as of a date
+	GetEffectiveSchemeVersion(ctx context.Context, arg GetEffectiveSchemeVersionParams) (SchemeVersion, error)
+	// db/query/relationships.sql
+	// Used for getting a relationship by ID
+	GetRelationship(ctx context.Context, id uuid.UUID) (Relationship, error)
+	// Used for checking if two applicants are already related in either direction
+	GetRelationshipBetweenApplicants(ctx context.Context, arg GetRelationshipBetweenApplicantsParams) (Relationship, error)
+	// Used for finding the reverse of a relationship
+	GetRelationshipByApplicants(ctx context.Context, arg GetRelationshipByApplicantsParams) (Relationship, error)
 	// db/query/schemes.sql
 	// Used for GET /api/schemes/{id}
 	GetScheme(ctx context.Context, id uuid.UUID) (Scheme, error)
@@ -61,27 +102,67 @@ type Querier interface {
 	GetSchemeCriteria(ctx context.Context, schemeID uuid.UUID) ([]SchemeCriterium, error)
 	// Used for getting scheme criteria by ID
 	GetSchemeCriteriaByID(ctx context.Context, id uuid.UUID) (SchemeCriterium, error)
+	// Used for getting a scheme criteria group by ID
+	GetSchemeCriteriaGroupByID(ctx context.Context, id uuid.UUID) (SchemeCriteriaGroup, error)
+	// db/query/scheme_criteria_groups.sql
+	// Used for getting all criteria groups for a scheme
+	GetSchemeCriteriaGroups(ctx context.Context, schemeID uuid.UUID) ([]SchemeCriteriaGroup, error)
+	// db/query/scheme_versions.sql
+	// Used for GET /api/schemes/versions/{version_id}
+	GetSchemeVersion(ctx context.Context, id uuid.UUID) (SchemeVersion, error)
 	// Used for getting a scheme with its benefits
 	GetSchemeWithBenefits(ctx context.Context, id uuid.UUID) ([]GetSchemeWithBenefitsRow, error)
 	// Used for getting a scheme with its criteria
 	GetSchemeWithCriteriaAndBenefits(ctx context.Context, id uuid.UUID) ([]GetSchemeWithCriteriaAndBenefitsRow, error)
+	// db/query/users.sql
+	// Used for GET /api/users/{id}
+	GetUser(ctx context.Context, id uuid.UUID) (User, error)
+	// Used for POST /api/auth/login
+	GetUserByEmail(ctx context.Context, lower string) (User, error)
 	// Used for GET /api/applicants
 	ListApplicants(ctx context.Context) ([]Applicant, error)
 	// Used for GET /api/applications
 	ListApplications(ctx context.Context) ([]Application, error)
 	// Used to get a list of all scheme benefits
 	ListBenefits(ctx context.Context) ([]Benefit, error)
+	// Used for GET /api/applicants/{id}/disbursements
+	ListDisbursementsByApplicant(ctx context.Context, applicantID uuid.UUID) ([]Disbursement, error)
+	// Used for GET /api/applications/{id}/disbursements
+	ListDisbursementsByApplication(ctx context.Context, applicationID uuid.UUID) ([]Disbursement, error)
+	// Used for finding the schemes an applicant can apply for as of a date
+	ListEffectiveSchemeVersions(ctx context.Context, asOf pgtype.Date) ([]SchemeVersion, error)
+	// Used for GET /api/applicants/{id}/relationships
+	ListRelationshipsByApplicant(ctx context.Context, applicantAID uuid.UUID) ([]Relationship, error)
 	// Used to get a list of all scheme criteria
 	ListSchemeCriteria(ctx context.Context) ([]SchemeCriterium, error)
+	// Used to get a list of all scheme criteria groups
+	ListSchemeCriteriaGroups(ctx context.Context) ([]SchemeCriteriaGroup, error)
+	// Used for GET /api/schemes/{scheme_id}/versions
+	ListSchemeVersions(ctx context.Context, schemeID uuid.UUID) ([]SchemeVersion, error)
 	// Used for GET /api/schemes
 	ListSchemes(ctx context.Context) ([]Scheme, error)
+	// Used for GET /api/users
+	ListUsers(ctx context.Context) ([]User, error)
+	// Used for POST /api/schemes/versions/{version_id}/publish
+	// Copies the current benefits and criteria of the scheme into the version
+	PublishSchemeVersion(ctx context.Context, id uuid.UUID) (SchemeVersion, error)
+	// Used for POST /api/schemes/versions/{version_id}/retire
+	RetireSchemeVersion(ctx context.Context, id uuid.UUID) (SchemeVersion, error)
 	// Used for PUT /api/applicants/{id}
 	UpdateApplicant(ctx context.Context, arg UpdateApplicantParams) (Applicant, error)
 	// Used for PUT /api/applications/{id}
 	UpdateApplication(ctx context.Context, arg UpdateApplicationParams) (Application, error)
+	// Used for the status transition endpoints under /api/applications/{id}
+	// Only updates the application if it is still in the expected status
+	UpdateApplicationStatus(ctx context.Context, arg UpdateApplicationStatusParams) (Application, error)
 	// Used when updating scheme benefits
 	UpdateBenefit(ctx context.Context, arg UpdateBenefitParams) (Benefit, error)
 	UpdateBenefitCriteria(ctx context.Context, arg UpdateBenefitCriteriaParams) error
+	// Used for the status transition endpoints under /api/applications/{id}/disbursements
+	// Only updates the disbursement if it is still in the expected status, and records when it is paid
+	UpdateDisbursementStatus(ctx context.Context, arg UpdateDisbursementStatusParams) (Disbursement, error)
+	// Used for PUT /api/applicants/{id}/relationships/{relationship_id}
+	UpdateRelationshipType(ctx context.Context, arg UpdateRelationshipTypeParams) error
 	// Used for PUT /api/schemes/{id}
 	UpdateScheme(ctx context.Context, arg UpdateSchemeParams) (Scheme, error)
 	// Used when updating scheme criteria
@@ -18,22 +18,29 @@ INSERT INTO scheme_criteria (
     created_at,
     name,
     value,
-    scheme_id
+    scheme_id,
+    group_id
 ) VALUES (
-             gen_random_uuid(), now(), $1, $2, $3
+             gen_random_uuid(), now(), $1, $2, $3, $4
          )
-RETURNING id, created_at, updated_at, deleted_at, name, value, scheme_id
+RETURNING id, created_at, updated_at, deleted_at, name, value, scheme_id, group_id
 `
 
 type CreateSchemeCriteriaParams struct {
 	Name     string
 	Value    pgtype.Text
 	SchemeID uuid.UUID
+	GroupID  uuid.NullUUID
 }
 
 // Used when creating a scheme with criteria
 func (q *Queries) CreateSchemeCriteria(ctx context.Context, arg CreateSchemeCriteriaParams) (SchemeCriterium, error) {
-	row := q.db.QueryRow(ctx, createSchemeCriteria, arg.Name, arg.Value, arg.SchemeID)
+	row := q.db.QueryRow(ctx, createSchemeCriteria,
+		arg.Name,
+		arg.Value,
+		arg.SchemeID,
+		arg.GroupID,
+	)
 	var i SchemeCriterium
 	err := row.Scan(
 		&i.ID,
@@ -43,6 +50,7 @@ func (q *Queries) CreateSchemeCriteria(ctx context.Context, arg CreateSchemeCrit
 		&i.Name,
 		&i.Value,
 		&i.SchemeID,
+		&i.GroupID,
 	)
 	return i, err
 }
@@ -62,7 +70,7 @@ func (q *Queries) DeleteSchemeCriteria(ctx context.Context, id uuid.UUID) error
 
 const getSchemeCriteria = `-- name: GetSchemeCriteria :many
 
-SELECT id, created_at, updated_at, deleted_at, name, value, scheme_id FROM scheme_criteria
+SELECT id, created_at, updated_at, deleted_at, name, value, scheme_id, group_id FROM scheme_criteria
 WHERE scheme_id = $1 AND deleted_at IS NULL
 `
 
@@ -85,6 +93,7 @@ func (q *Queries) GetSchemeCriteria(ctx context.Context, schemeID uuid.UUID) ([]
 			&i.Name,
 			&i.Value,
 			&i.SchemeID,
+			&i.GroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -97,7 +106,7 @@ func (q *Queries) GetSchemeCriteria(ctx context.Context, schemeID uuid.UUID) ([]
 }
 
 const getSchemeCriteriaByID = `-- name: GetSchemeCriteriaByID :one
-SELECT id, created_at, updated_at, deleted_at, name, value, scheme_id FROM scheme_criteria
+SELECT id, created_at, updated_at, deleted_at, name, value, scheme_id, group_id FROM scheme_criteria
 WHERE id = $1 AND deleted_at IS NULL
 LIMIT 1
 `
@@ -114,12 +123,13 @@ func (q *Queries) GetSchemeCriteriaByID(ctx context.Context, id uuid.UUID) (Sche
 		&i.Name,
 		&i.Value,
 		&i.SchemeID,
+		&i.GroupID,
 	)
 	return i, err
 }
 
 const listSchemeCriteria = `-- name: ListSchemeCriteria :many
-SELECT id, created_at, updated_at, deleted_at, name, value, scheme_id FROM scheme_criteria
+SELECT id, created_at, updated_at, deleted_at, name, value, scheme_id, group_id FROM scheme_criteria
 WHERE deleted_at is NULL
 `
 
@@ -141,6 +151,7 @@ func (q *Queries) ListSchemeCriteria(ctx context.Context) ([]SchemeCriterium, er
 			&i.Name,
 			&i.Value,
 			&i.SchemeID,
+			&i.GroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -158,7 +169,7 @@ SET
     name = $2,
     value = $3
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, created_at, updated_at, deleted_at, name, value, scheme_id
+RETURNING id, created_at, updated_at, deleted_at, name, value, scheme_id, group_id
 `
 
 type UpdateSchemeCriteriaParams struct {
@@ -179,6 +190,7 @@ func (q *Queries) UpdateSchemeCriteria(ctx context.Context, arg UpdateSchemeCrit
 		&i.Name,
 		&i.Value,
 		&i.SchemeID,
+		&i.GroupID,
 	)
 	return i, err
 }
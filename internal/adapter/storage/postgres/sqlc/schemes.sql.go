@@ -16,16 +16,22 @@ const createScheme = `-- name: CreateScheme :one
 INSERT INTO schemes (
     id,
     created_at,
-    name
+    name,
+    reapply_cooldown_days
 ) VALUES (
-            gen_random_uuid(), now(), $1
+            gen_random_uuid(), now(), $1, $2
          )
-RETURNING id, created_at, updated_at, deleted_at, name
+RETURNING id, created_at, updated_at, deleted_at, name, reapply_cooldown_days
 `
 
+type CreateSchemeParams struct {
+	Name                string
+	ReapplyCooldownDays int32
+}
+
 // Used for POST /api/schemes
-func (q *Queries) CreateScheme(ctx context.Context, name string) (Scheme, error) {
-	row := q.db.QueryRow(ctx, createScheme, name)
+func (q *Queries) CreateScheme(ctx context.Context, arg CreateSchemeParams) (Scheme, error) {
+	row := q.db.QueryRow(ctx, createScheme, arg.Name, arg.ReapplyCooldownDays)
 	var i Scheme
 	err := row.Scan(
 		&i.ID,
@@ -33,6 +39,7 @@ func (q *Queries) CreateScheme(ctx context.Context, name string) (Scheme, error)
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Name,
+		&i.ReapplyCooldownDays,
 	)
 	return i, err
 }
@@ -52,7 +59,7 @@ func (q *Queries) DeleteScheme(ctx context.Context, id uuid.UUID) error {
 
 const getScheme = `-- name: GetScheme :one
 
-SELECT id, created_at, updated_at, deleted_at, name FROM schemes
+SELECT id, created_at, updated_at, deleted_at, name, reapply_cooldown_days FROM schemes
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -67,13 +74,14 @@ func (q *Queries) GetScheme(ctx context.Context, id uuid.UUID) (Scheme, error) {
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Name,
+		&i.ReapplyCooldownDays,
 	)
 	return i, err
 }
 
 const getSchemeWithBenefits = `-- name: GetSchemeWithBenefits :many
 SELECT
-    s.id, s.created_at, s.updated_at, s.deleted_at, s.name,
+    s.id, s.created_at, s.updated_at, s.deleted_at, s.name, s.reapply_cooldown_days,
     b.id as benefit_id,
     b.name as benefit_name,
     b.amount as benefit_amount
@@ -83,14 +91,15 @@ WHERE s.id = $1 AND s.deleted_at IS NULL
 `
 
 type GetSchemeWithBenefitsRow struct {
-	ID            uuid.UUID
-	CreatedAt     pgtype.Timestamp
-	UpdatedAt     pgtype.Timestamp
-	DeletedAt     pgtype.Timestamp
-	Name          string
-	BenefitID     pgtype.UUID
-	BenefitName   pgtype.Text
-	BenefitAmount pgtype.Float8
+	ID                  uuid.UUID
+	CreatedAt           pgtype.Timestamp
+	UpdatedAt           pgtype.Timestamp
+	DeletedAt           pgtype.Timestamp
+	Name                string
+	ReapplyCooldownDays int32
+	BenefitID           pgtype.UUID
+	BenefitName         pgtype.Text
+	BenefitAmount       pgtype.Numeric
 }
 
 // Used for getting a scheme with its benefits
@@ -109,6 +118,7 @@ func (q *Queries) GetSchemeWithBenefits(ctx context.Context, id uuid.UUID) ([]Ge
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.Name,
+			&i.ReapplyCooldownDays,
 			&i.BenefitID,
 			&i.BenefitName,
 			&i.BenefitAmount,
@@ -125,7 +135,7 @@ func (q *Queries) GetSchemeWithBenefits(ctx context.Context, id uuid.UUID) ([]Ge
 
 const getSchemeWithCriteriaAndBenefits = `-- name: GetSchemeWithCriteriaAndBenefits :many
 SELECT
-    s.id, s.created_at, s.updated_at, s.deleted_at, s.name,
+    s.id, s.created_at, s.updated_at, s.deleted_at, s.name, s.reapply_cooldown_days,
     sc.id as criteria_id,
     sc.name as criteria_name,
     sc.value as criteria_value
@@ -135,14 +145,15 @@ WHERE s.id = $1 AND s.deleted_at IS NULL
 `
 
 type GetSchemeWithCriteriaAndBenefitsRow struct {
-	ID            uuid.UUID
-	CreatedAt     pgtype.Timestamp
-	UpdatedAt     pgtype.Timestamp
-	DeletedAt     pgtype.Timestamp
-	Name          string
-	CriteriaID    pgtype.UUID
-	CriteriaName  pgtype.Text
-	CriteriaValue pgtype.Text
+	ID                  uuid.UUID
+	CreatedAt           pgtype.Timestamp
+	UpdatedAt           pgtype.Timestamp
+	DeletedAt           pgtype.Timestamp
+	Name                string
+	ReapplyCooldownDays int32
+	CriteriaID          pgtype.UUID
+	CriteriaName        pgtype.Text
+	CriteriaValue       pgtype.Text
 }
 
 // Used for getting a scheme with its criteria
@@ -161,6 +172,7 @@ func (q *Queries) GetSchemeWithCriteriaAndBenefits(ctx context.Context, id uuid.
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.Name,
+			&i.ReapplyCooldownDays,
 			&i.CriteriaID,
 			&i.CriteriaName,
 			&i.CriteriaValue,
@@ -176,7 +188,7 @@ func (q *Queries) GetSchemeWithCriteriaAndBenefits(ctx context.Context, id uuid.
 }
 
 const listSchemes = `-- name: ListSchemes :many
-SELECT id, created_at, updated_at, deleted_at, name FROM schemes
+SELECT id, created_at, updated_at, deleted_at, name, reapply_cooldown_days FROM schemes
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
 `
@@ -197,6 +209,7 @@ func (q *Queries) ListSchemes(ctx context.Context) ([]Scheme, error) {
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.Name,
+			&i.ReapplyCooldownDays,
 		); err != nil {
 			return nil, err
 		}
@@ -213,7 +226,7 @@ UPDATE schemes
 SET
     name = $2
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, created_at, updated_at, deleted_at, name
+RETURNING id, created_at, updated_at, deleted_at, name, reapply_cooldown_days
 `
 
 type UpdateSchemeParams struct {
@@ -231,6 +244,7 @@ func (q *Queries) UpdateScheme(ctx context.Context, arg UpdateSchemeParams) (Sch
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Name,
+		&i.ReapplyCooldownDays,
 	)
 	return i, err
 }
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"log/slog"
+)
+
+const (
+	// SerializationFailureErrorCode is the error code returned by postgres when a transaction cannot be serialized
+	// with concurrent transactions
+	SerializationFailureErrorCode = "40001"
+	// DeadlockDetectedErrorCode is the error code returned by postgres when transactions wait on each other
+	DeadlockDetectedErrorCode = "40P01"
+)
+
+// maxTransactionAttempts is the number of times a transaction is attempted before its serialization failure is returned
+const maxTransactionAttempts = 3
+
+// txContextKey is the context key of the transaction a context takes part in
+type txContextKey struct{}
+
+// executor runs queries on either the connection pool or a transaction
+type executor interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// WithinTransaction runs fn in a serializable transaction. Every query made through the DB with the context passed to fn,
+// including the sqlc queries, takes part in it. The transaction is committed if fn returns nil and rolled back otherwise,
+// and it is retried when postgres cannot serialize it with concurrent transactions. Calls made with a context that is
+// already in a transaction join that transaction.
+func (db *DB) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if txFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	var err error
+	for attempt := 0; attempt < maxTransactionAttempts; attempt++ {
+		err = db.runTransaction(ctx, fn)
+
+		code := db.ErrorCode(err)
+		if code != SerializationFailureErrorCode && code != DeadlockDetectedErrorCode {
+			return err
+		}
+
+		slog.WarnContext(ctx, "Transaction could not be serialized with concurrent transactions",
+			"attempt", attempt+1, "max_attempts", maxTransactionAttempts, "code", code)
+	}
+
+	return err
+}
+
+// runTransaction runs fn in a new serializable transaction and commits it if fn succeeds
+func (db *DB) runTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := db.Pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.Serializable})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Begin starts a transaction. If ctx is already in a transaction, a savepoint is created in it instead,
+// so that repositories can group their own queries without leaving a transaction started by a service.
+func (db *DB) Begin(ctx context.Context) (pgx.Tx, error) {
+	if tx := txFromContext(ctx); tx != nil {
+		return tx.Begin(ctx)
+	}
+
+	return db.Pool.Begin(ctx)
+}
+
+// Exec executes a query on the transaction ctx is in, or on the connection pool otherwise
+func (db *DB) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	return db.executor(ctx).Exec(ctx, sql, arguments...)
+}
+
+// Query executes a query that returns rows on the transaction ctx is in, or on the connection pool otherwise
+func (db *DB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return db.executor(ctx).Query(ctx, sql, args...)
+}
+
+// QueryRow executes a query that returns at most one row on the transaction ctx is in, or on the connection pool otherwise
+func (db *DB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return db.executor(ctx).QueryRow(ctx, sql, args...)
+}
+
+// executor returns the transaction ctx is in, or the connection pool if it is not in one
+func (db *DB) executor(ctx context.Context) executor {
+	if tx := txFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return db.Pool
+}
+
+// txFromContext returns the transaction ctx is in, or nil if it is not in one
+func txFromContext(ctx context.Context) pgx.Tx {
+	tx, _ := ctx.Value(txContextKey{}).(pgx.Tx)
+	return tx
+}
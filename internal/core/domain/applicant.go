@@ -73,6 +73,47 @@ func (rt RelationshipType) IsValid() bool {
 	}
 }
 
+// Inverse returns the relationship type as seen from the other applicant,
+// e.g. if B is A's parent, then A is B's child.
+func (rt RelationshipType) Inverse() RelationshipType {
+	switch rt {
+	case RelationshipTypeChild:
+		return RelationshipTypeParent
+	case RelationshipTypeParent:
+		return RelationshipTypeChild
+	default:
+		return rt
+	}
+}
+
+// Family groups an applicant's family members by how they are related to the applicant.
+type Family map[RelationshipType][]Applicant
+
+// Count returns the number of family members with the given relationship type.
+func (f Family) Count(rt RelationshipType) int {
+	return len(f[rt])
+}
+
+// Members returns every family member, regardless of how they are related to the applicant.
+func (f Family) Members() []Applicant {
+	members := make([]Applicant, 0)
+	for _, rt := range []RelationshipType{RelationshipTypeSpouse, RelationshipTypeChild, RelationshipTypeParent, RelationshipTypeSibling} {
+		members = append(members, f[rt]...)
+	}
+	return members
+}
+
+// Dependants returns the family members an applicant may support, i.e. their spouse, children and parents.
+func (f Family) Dependants() []Applicant {
+	dependants := make([]Applicant, 0)
+	for _, rt := range []RelationshipType{RelationshipTypeSpouse, RelationshipTypeChild, RelationshipTypeParent} {
+		dependants = append(dependants, f[rt]...)
+	}
+	return dependants
+}
+
+// Applicant is a person who can apply for schemes. MonthlyIncome and Assets are used for means testing, are in the
+// DefaultCurrency, and are nil if they are unknown. HasDisability is used for criteria about the applicant's dependants.
 type Applicant struct {
 	ID               *uuid.UUID
 	Name             *string
@@ -80,7 +121,10 @@ type Applicant struct {
 	MaritalStatus    *MaritalStatus
 	Sex              *Sex
 	DateOfBirth      *time.Time
+	MonthlyIncome    *Money
+	Assets           *Money
+	HasDisability    *bool
 	CreatedAt        *time.Time
 	UpdatedAt        *time.Time
-	Family           map[RelationshipType]*Applicant
+	Family           Family
 }
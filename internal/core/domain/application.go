@@ -1,14 +1,92 @@
 package domain
 
 import (
+	"fmt"
 	"github.com/google/uuid"
 	"time"
 )
 
+type ApplicationStatus string
+
+const (
+	ApplicationStatusSubmitted   ApplicationStatus = "submitted"
+	ApplicationStatusUnderReview ApplicationStatus = "under_review"
+	ApplicationStatusApproved    ApplicationStatus = "approved"
+	ApplicationStatusRejected    ApplicationStatus = "rejected"
+	ApplicationStatusWithdrawn   ApplicationStatus = "withdrawn"
+	ApplicationStatusDisbursed   ApplicationStatus = "disbursed"
+)
+
+// applicationStatusTransitions lists the statuses an application can move to from each status.
+// Rejected, withdrawn and disbursed applications are final.
+var applicationStatusTransitions = map[ApplicationStatus][]ApplicationStatus{
+	ApplicationStatusSubmitted:   {ApplicationStatusUnderReview, ApplicationStatusWithdrawn},
+	ApplicationStatusUnderReview: {ApplicationStatusApproved, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+	ApplicationStatusApproved:    {ApplicationStatusDisbursed},
+}
+
+func (s ApplicationStatus) IsValid() bool {
+	switch s {
+	case ApplicationStatusSubmitted, ApplicationStatusUnderReview, ApplicationStatusApproved,
+		ApplicationStatusRejected, ApplicationStatusWithdrawn, ApplicationStatusDisbursed:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo reports whether an application in this status can move to the given status.
+func (s ApplicationStatus) CanTransitionTo(status ApplicationStatus) bool {
+	for _, next := range applicationStatusTransitions[s] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinal reports whether no further transitions are possible from this status.
+// An application that is not final is active, and an applicant can only have one active application per scheme.
+func (s ApplicationStatus) IsFinal() bool {
+	return len(applicationStatusTransitions[s]) == 0
+}
+
+// Application is an applicant's application to a scheme. SchemeVersionID is the version of the scheme
+// the application was assessed against.
 type Application struct {
-	ID          *uuid.UUID
-	ApplicantID *uuid.UUID
-	SchemeID    *uuid.UUID
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
+	ID              *uuid.UUID
+	ApplicantID     *uuid.UUID
+	SchemeID        *uuid.UUID
+	SchemeVersionID *uuid.UUID
+	Status          *ApplicationStatus
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+}
+
+// ActiveApplicationError names the active application that prevents an applicant from applying to a scheme again.
+// It wraps DuplicateApplicationError.
+type ActiveApplicationError struct {
+	ApplicationID uuid.UUID
+}
+
+func (e *ActiveApplicationError) Error() string {
+	return fmt.Sprintf("%s: %s", DuplicateApplicationError, e.ApplicationID)
+}
+
+func (e *ActiveApplicationError) Unwrap() error {
+	return DuplicateApplicationError
+}
+
+// ReapplicationCooldownError gives the date from which an applicant can apply to a scheme again.
+// It wraps ApplicationCooldownError.
+type ReapplicationCooldownError struct {
+	ReapplyFrom time.Time
+}
+
+func (e *ReapplicationCooldownError) Error() string {
+	return fmt.Sprintf("%s: reapply from %s", ApplicationCooldownError, e.ReapplyFrom.Format(time.DateOnly))
+}
+
+func (e *ReapplicationCooldownError) Unwrap() error {
+	return ApplicationCooldownError
 }
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+// AuditEntityType is the kind of record an audit log entry describes.
+type AuditEntityType string
+
+const (
+	AuditEntityApplicant       AuditEntityType = "applicant"
+	AuditEntityRelationship    AuditEntityType = "relationship"
+	AuditEntityScheme          AuditEntityType = "scheme"
+	AuditEntityBenefit         AuditEntityType = "benefit"
+	AuditEntityBenefitCriteria AuditEntityType = "benefit_criteria"
+	AuditEntitySchemeCriteria  AuditEntityType = "scheme_criteria"
+	AuditEntityCriteriaGroup   AuditEntityType = "criteria_group"
+	AuditEntitySchemeVersion   AuditEntityType = "scheme_version"
+	AuditEntityApplication     AuditEntityType = "application"
+	AuditEntityDisbursement    AuditEntityType = "disbursement"
+	AuditEntityUser            AuditEntityType = "user"
+)
+
+func (t AuditEntityType) IsValid() bool {
+	switch t {
+	case AuditEntityApplicant, AuditEntityRelationship, AuditEntityScheme, AuditEntityBenefit, AuditEntityBenefitCriteria,
+		AuditEntitySchemeCriteria, AuditEntityCriteriaGroup, AuditEntitySchemeVersion, AuditEntityApplication,
+		AuditEntityDisbursement, AuditEntityUser:
+		return true
+	default:
+		return false
+	}
+}
+
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+)
+
+func (a AuditAction) IsValid() bool {
+	switch a {
+	case AuditActionCreate, AuditActionUpdate, AuditActionDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// AuditLog is an append-only record of a change to an entity. For updates, OldValues and NewValues only hold the fields
+// that changed. Creates have no OldValues and deletes have no NewValues. ActorID is nil for changes made by the system itself.
+type AuditLog struct {
+	ID         *uuid.UUID
+	ActorID    *uuid.UUID
+	ActorRole  *Role
+	EntityType *AuditEntityType
+	EntityID   *uuid.UUID
+	Action     *AuditAction
+	OldValues  map[string]any
+	NewValues  map[string]any
+	CreatedAt  *time.Time
+}
+
+// AuditLogFilter narrows down a list of audit log entries. Nil fields are not filtered on.
+type AuditLogFilter struct {
+	ListOptions
+	EntityType *AuditEntityType
+	EntityID   *uuid.UUID
+	ActorID    *uuid.UUID
+	Action     *AuditAction
+}
+
+// actorContextKey is the context key of the user making a request
+type actorContextKey struct{}
+
+// ContextWithActor returns a copy of ctx that carries the user making a request, so that changes can be attributed to them.
+func ContextWithActor(ctx context.Context, actor *TokenPayload) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the user making a request, or nil if the request was not made by a user.
+func ActorFromContext(ctx context.Context) *TokenPayload {
+	actor, _ := ctx.Value(actorContextKey{}).(*TokenPayload)
+	return actor
+}
@@ -5,11 +5,48 @@ import (
 	"time"
 )
 
+// BenefitFrequency is how often a benefit is paid out.
+type BenefitFrequency string
+
+const (
+	BenefitFrequencyOneOff    BenefitFrequency = "one_off"
+	BenefitFrequencyMonthly   BenefitFrequency = "monthly"
+	BenefitFrequencyQuarterly BenefitFrequency = "quarterly"
+)
+
+func (f BenefitFrequency) IsValid() bool {
+	switch f {
+	case BenefitFrequencyOneOff, BenefitFrequencyMonthly, BenefitFrequencyQuarterly:
+		return true
+	default:
+		return false
+	}
+}
+
+// IntervalMonths returns the number of months between payouts of a recurring benefit, or 0 for one-off benefits.
+func (f BenefitFrequency) IntervalMonths() int {
+	switch f {
+	case BenefitFrequencyMonthly:
+		return 1
+	case BenefitFrequencyQuarterly:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Benefit is paid to applicants whose application to its scheme is approved. One-off benefits are paid once, and
+// recurring benefits are paid every month or quarter for DurationMonths months. The total paid never exceeds Cap,
+// if the benefit has one. The amount and cap are in the same currency.
 type Benefit struct {
-	ID        *uuid.UUID
-	SchemeID  *uuid.UUID
-	Name      *string
-	Amount    *float64
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
+	ID             *uuid.UUID
+	SchemeID       *uuid.UUID
+	Name           *string
+	Amount         *Money
+	Frequency      *BenefitFrequency
+	DurationMonths *int
+	Cap            *Money
+	Criteria       *[]BenefitCriteria
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
 }
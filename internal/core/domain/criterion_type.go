@@ -0,0 +1,27 @@
+package domain
+
+import "fmt"
+
+// CriterionType describes a kind of criterion that applicants can be assessed against, such as their age,
+// and the values it accepts.
+type CriterionType struct {
+	Name        string
+	Description string
+	Format      string
+	Values      []string
+	Examples    []string
+}
+
+// InvalidCriterionValueError describes the values accepted by the criterion whose value is invalid.
+// It wraps InvalidSchemeCriteriaValueError.
+type InvalidCriterionValueError struct {
+	Criterion CriterionType
+}
+
+func (e *InvalidCriterionValueError) Error() string {
+	return fmt.Sprintf("%s: %s", InvalidSchemeCriteriaValueError, e.Criterion.Name)
+}
+
+func (e *InvalidCriterionValueError) Unwrap() error {
+	return InvalidSchemeCriteriaValueError
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+type DisbursementStatus string
+
+const (
+	DisbursementStatusScheduled  DisbursementStatus = "scheduled"
+	DisbursementStatusPaid       DisbursementStatus = "paid"
+	DisbursementStatusFailed     DisbursementStatus = "failed"
+	DisbursementStatusClawedBack DisbursementStatus = "clawed_back"
+)
+
+// disbursementStatusTransitions lists the statuses a disbursement can move to from each status.
+// Failed disbursements can be scheduled again, and clawed back disbursements are final.
+var disbursementStatusTransitions = map[DisbursementStatus][]DisbursementStatus{
+	DisbursementStatusScheduled: {DisbursementStatusPaid, DisbursementStatusFailed},
+	DisbursementStatusFailed:    {DisbursementStatusScheduled},
+	DisbursementStatusPaid:      {DisbursementStatusClawedBack},
+}
+
+func (s DisbursementStatus) IsValid() bool {
+	switch s {
+	case DisbursementStatusScheduled, DisbursementStatusPaid, DisbursementStatusFailed, DisbursementStatusClawedBack:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo reports whether a disbursement in this status can move to the given status.
+func (s DisbursementStatus) CanTransitionTo(status DisbursementStatus) bool {
+	for _, next := range disbursementStatusTransitions[s] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Disbursement is an entry in the ledger of payouts. Approving an application schedules a disbursement for every
+// installment of each benefit the applicant receives. BenefitName is the name of the benefit when it was scheduled.
+type Disbursement struct {
+	ID            *uuid.UUID
+	ApplicationID *uuid.UUID
+	BenefitID     *uuid.UUID
+	BenefitName   *string
+	Installment   *int
+	Amount        *Money
+	DueDate       *time.Time
+	Status        *DisbursementStatus
+	PaidAt        *time.Time
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
+
+// PaidTotals returns the total amount of the disbursements that have been paid and not clawed back, with one total
+// for each currency in the order the currencies first appear. Returns AmountOutOfRangeError if a total overflows.
+func PaidTotals(disbursements []Disbursement) ([]Money, error) {
+	totals := make([]Money, 0, 1)
+	for _, d := range disbursements {
+		if d.Status == nil || *d.Status != DisbursementStatusPaid || d.Amount == nil {
+			continue
+		}
+
+		i := slices.IndexFunc(totals, func(total Money) bool { return total.Currency == d.Amount.Currency })
+		if i < 0 {
+			totals = append(totals, Money{Currency: d.Amount.Currency})
+			i = len(totals) - 1
+		}
+
+		var err error
+		if totals[i], err = totals[i].Add(*d.Amount); err != nil {
+			return nil, err
+		}
+	}
+	return totals, nil
+}
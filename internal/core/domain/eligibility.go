@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// CriterionResult describes how an applicant measured up against a single criterion,
+// e.g. the criterion "age" requires ">=65" while the applicant is actually "42".
+type CriterionResult struct {
+	CriteriaID *uuid.UUID
+	Name       *string
+	Required   *string
+	Actual     *string
+	Passed     bool
+}
+
+// CriteriaGroupResult describes how an applicant measured up against a criteria group and everything nested in it.
+type CriteriaGroupResult struct {
+	GroupID  *uuid.UUID
+	Operator *CriteriaGroupOperator
+	Criteria []CriterionResult
+	Groups   []CriteriaGroupResult
+	Passed   bool
+}
+
+// BenefitEligibilityResult describes whether an applicant receives a benefit of a scheme and why.
+type BenefitEligibilityResult struct {
+	BenefitID *uuid.UUID
+	Name      *string
+	Criteria  []CriterionResult
+	Eligible  bool
+}
+
+// EligibilityResult explains whether an applicant is eligible for a scheme by listing the outcome
+// of every criterion, criteria group and benefit of the scheme as of a given date.
+// SchemeVersionID is the version of the scheme that was in effect on that date.
+type EligibilityResult struct {
+	SchemeID        *uuid.UUID
+	SchemeVersionID *uuid.UUID
+	ApplicantID     *uuid.UUID
+	AsOf            *time.Time
+	Criteria        []CriterionResult
+	CriteriaGroups  []CriteriaGroupResult
+	Benefits        []BenefitEligibilityResult
+	Eligible        bool
+}
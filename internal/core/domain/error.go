@@ -3,23 +3,73 @@ package domain
 import "errors"
 
 var (
-	InvalidApplicantError                           = errors.New("invalid applicant id")
-	InvalidSchemeError                              = errors.New("invalid scheme id")
-	InvalidBenefitError                             = errors.New("invalid benefit id")
-	InvalidSchemeCriteriaError                      = errors.New("invalid scheme criteria id")
-	EmptySchemeCriteriaError                        = errors.New("empty scheme criteria")
-	InvalidSchemeCriteriaNameError                  = errors.New("invalid scheme criteria name")
-	InvalidSchemeCriteriaAgeValueError              = errors.New("invalid benefit criteria age value")
-	InvalidSchemeCriteriaEmploymentStatusValueError = errors.New("invalid benefit criteria employment status value")
-	InvalidSchemeCriteriaMaritalStatusValueError    = errors.New("invalid benefit criteria marital status value")
-	InvalidSchemeCriteriaHasChildrenValueError      = errors.New("invalid benefit criteria has children value")
-	InvalidApplicationError                         = errors.New("invalid application id")
-	NotFoundError                                   = errors.New("data not found")
-	NoUpdateFieldsError                             = errors.New("no fields to update")
-	ApplicantNotFoundError                          = errors.New("applicant not found")
-	SchemeNotFoundError                             = errors.New("scheme not found")
-	ApplicationNotFoundError                        = errors.New("application not found")
-	SchemeNotEligibleError                          = errors.New("scheme not eligible")
-	BenefitNotFoundError                            = errors.New("benefit not found")
-	SchemeCriteriaNotFoundError                     = errors.New("scheme criteria not found")
+	InvalidApplicantError                   = errors.New("invalid applicant id")
+	InvalidSchemeError                      = errors.New("invalid scheme id")
+	InvalidBenefitError                     = errors.New("invalid benefit id")
+	InvalidSchemeCriteriaError              = errors.New("invalid scheme criteria id")
+	EmptySchemeCriteriaError                = errors.New("empty scheme criteria")
+	InvalidSchemeCriteriaNameError          = errors.New("invalid scheme criteria name")
+	InvalidSchemeCriteriaValueError         = errors.New("invalid scheme criteria value")
+	InvalidComparisonConditionError         = errors.New("invalid comparison condition")
+	InvalidAgeBandConditionError            = errors.New("invalid age band condition")
+	InvalidBooleanConditionError            = errors.New("invalid boolean condition")
+	InvalidSetConditionError                = errors.New("invalid set condition")
+	InvalidCriteriaGroupError               = errors.New("invalid criteria group id")
+	InvalidCriteriaGroupOperatorError       = errors.New("invalid criteria group operator")
+	EmptyCriteriaGroupError                 = errors.New("empty criteria group")
+	InvalidNotCriteriaGroupError            = errors.New("not criteria group must contain exactly one item")
+	CriteriaGroupNotFoundError              = errors.New("criteria group not found")
+	NestedSchemeCriteriaError               = errors.New("criteria belongs to a criteria group")
+	InvalidAsOfDateError                    = errors.New("invalid as of date")
+	InvalidPageTokenError                   = errors.New("invalid page token")
+	InvalidSortFieldError                   = errors.New("invalid sort field")
+	InvalidDateRangeError                   = errors.New("invalid date range")
+	InvalidAgeRangeError                    = errors.New("invalid age range")
+	InvalidApplicationError                 = errors.New("invalid application id")
+	InvalidApplicationStatusTransitionError = errors.New("invalid application status transition")
+	ApplicationNotEditableError             = errors.New("application can no longer be edited")
+	DuplicateApplicationError               = errors.New("applicant already has an active application for this scheme")
+	ApplicationCooldownError                = errors.New("applicant cannot reapply to this scheme yet")
+	NotFoundError                           = errors.New("data not found")
+	NoUpdateFieldsError                     = errors.New("no fields to update")
+	ApplicantNotFoundError                  = errors.New("applicant not found")
+	SchemeNotFoundError                     = errors.New("scheme not found")
+	ApplicationNotFoundError                = errors.New("application not found")
+	SchemeNotEligibleError                  = errors.New("scheme not eligible")
+	BenefitNotFoundError                    = errors.New("benefit not found")
+	SchemeCriteriaNotFoundError             = errors.New("scheme criteria not found")
+	InvalidBenefitCriteriaError             = errors.New("invalid benefit criteria id")
+	BenefitCriteriaNotFoundError            = errors.New("benefit criteria not found")
+	InvalidRelationshipError                = errors.New("invalid relationship id")
+	InvalidUserError                        = errors.New("invalid user id")
+	UserNotFoundError                       = errors.New("user not found")
+	DuplicateUserEmailError                 = errors.New("email is already in use")
+	InvalidCredentialsError                 = errors.New("invalid email or password")
+	MissingTokenError                       = errors.New("authorization token is missing")
+	InvalidTokenError                       = errors.New("authorization token is invalid")
+	ExpiredTokenError                       = errors.New("authorization token has expired")
+	ForbiddenError                          = errors.New("user is not allowed to perform this action")
+	InvalidAuditEntityError                 = errors.New("invalid audit entity id")
+	RelationshipNotFoundError               = errors.New("relationship not found")
+	SelfRelationshipError                   = errors.New("applicant cannot be related to themselves")
+	DuplicateRelationshipError              = errors.New("relationship already exists")
+	InvalidSchemeVersionError               = errors.New("invalid scheme version id")
+	SchemeVersionNotFoundError              = errors.New("scheme version not found")
+	InvalidSchemeVersionTransitionError     = errors.New("invalid scheme version status transition")
+	InvalidEffectiveDatesError              = errors.New("effective to date must be after effective from date")
+	BackdatedSchemeVersionError             = errors.New("scheme version cannot take effect before it is published")
+	SchemeVersionOverlapError               = errors.New("scheme version overlaps with another published version")
+	DuplicateSchemeVersionDraftError        = errors.New("scheme already has a draft version")
+	SchemeVersionNotDraftError              = errors.New("only draft scheme versions can be deleted")
+	SchemeNotEffectiveError                 = errors.New("scheme has no version in effect on this date")
+	InvalidBenefitScheduleError             = errors.New("only recurring benefits have a duration, and they must have one")
+	MissingBenefitAmountError               = errors.New("benefit has no amount")
+	InvalidDisbursementError                = errors.New("invalid disbursement id")
+	DisbursementNotFoundError               = errors.New("disbursement not found")
+	InvalidDisbursementTransitionError      = errors.New("invalid disbursement status transition")
+	InvalidAmountError                      = errors.New("invalid amount")
+	AmountOutOfRangeError                   = errors.New("amount is out of range")
+	CurrencyMismatchError                   = errors.New("amounts are in different currencies")
+	DatabaseUnavailableError                = errors.New("database is unavailable")
+	OutdatedSchemaError                     = errors.New("database schema is behind the latest migration")
 )
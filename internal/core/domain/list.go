@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// ListOptions controls the paging and sorting of a list. A zero Limit returns every row.
+// An empty SortBy sorts by creation date, newest first, and an empty SortOrder otherwise sorts ascending.
+type ListOptions struct {
+	Limit     int
+	Offset    int
+	SortBy    string
+	SortOrder SortOrder
+}
+
+// ApplicantFilter narrows down a list of applicants. Nil fields are not filtered on.
+type ApplicantFilter struct {
+	ListOptions
+	EmploymentStatus *EmploymentStatus
+	MaritalStatus    *MaritalStatus
+	MinAge           *int
+	MaxAge           *int
+}
+
+// SchemeFilter narrows down a list of schemes. Name matches any scheme whose name contains it, ignoring case.
+type SchemeFilter struct {
+	ListOptions
+	Name *string
+}
+
+// ApplicationFilter narrows down a list of applications. CreatedFrom is inclusive and CreatedBefore is exclusive.
+type ApplicationFilter struct {
+	ListOptions
+	ApplicantID   *uuid.UUID
+	SchemeID      *uuid.UUID
+	Status        *ApplicationStatus
+	CreatedFrom   *time.Time
+	CreatedBefore *time.Time
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+const (
+	CurrencySGD Currency = "SGD"
+	CurrencyUSD Currency = "USD"
+	CurrencyEUR Currency = "EUR"
+	CurrencyGBP Currency = "GBP"
+	CurrencyMYR Currency = "MYR"
+)
+
+// DefaultCurrency is the currency of benefits that are not given one.
+const DefaultCurrency = CurrencySGD
+
+func (c Currency) IsValid() bool {
+	switch c {
+	case CurrencySGD, CurrencyUSD, CurrencyEUR, CurrencyGBP, CurrencyMYR:
+		return true
+	default:
+		return false
+	}
+}
+
+// Money is an exact amount of money in a currency. Every supported currency has two decimal places, so amounts are
+// kept as a whole number of cents.
+type Money struct {
+	Cents    int64
+	Currency Currency
+}
+
+// MaxMoneyCents is the largest number of cents an amount may have, which is what a NUMERIC(14, 2) column holds.
+const MaxMoneyCents int64 = 99_999_999_999_999
+
+// ParseMoney parses a decimal amount with at most two decimal places (e.g., "1500", "1500.5" or "-20.25") without
+// going through a floating point number. Returns InvalidAmountError if the amount is not in this format, and
+// AmountOutOfRangeError if it has more than MaxMoneyCents cents either way.
+func ParseMoney(amount string, currency Currency) (Money, error) {
+	negative := strings.HasPrefix(amount, "-")
+	whole, fraction, hasPoint := strings.Cut(strings.TrimPrefix(amount, "-"), ".")
+
+	if whole == "" || (hasPoint && fraction == "") || len(fraction) > 2 || !isDigits(whole) || !isDigits(fraction) {
+		return Money{}, InvalidAmountError
+	}
+
+	// The digits are valid, so parsing can only fail if there are too many of them
+	cents, err := strconv.ParseInt(whole+(fraction + "00")[:2], 10, 64)
+	if err != nil || cents > MaxMoneyCents {
+		return Money{}, AmountOutOfRangeError
+	}
+
+	if negative {
+		cents = -cents
+	}
+
+	return Money{Cents: cents, Currency: currency}, nil
+}
+
+// isDigits reports whether s only contains the digits 0 to 9.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats the amount with two decimal places (e.g., "1500.50"), without the currency.
+func (m Money) String() string {
+	cents := m.Cents
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return sign + strconv.FormatInt(cents/100, 10) + "." + strconv.FormatInt(100+cents%100, 10)[1:]
+}
+
+// IsNegative reports whether the amount is less than zero.
+func (m Money) IsNegative() bool {
+	return m.Cents < 0
+}
+
+// Add returns the sum of two amounts. Returns CurrencyMismatchError if they are in different currencies, and
+// AmountOutOfRangeError if the sum does not fit in an int64.
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, CurrencyMismatchError
+	}
+
+	sum := m.Cents + other.Cents
+	if (other.Cents > 0 && sum < m.Cents) || (other.Cents < 0 && sum > m.Cents) {
+		return Money{}, AmountOutOfRangeError
+	}
+	return Money{Cents: sum, Currency: m.Currency}, nil
+}
+
+// Sub returns the difference of two amounts. Returns CurrencyMismatchError if they are in different currencies, and
+// AmountOutOfRangeError if the difference does not fit in an int64.
+func (m Money) Sub(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, CurrencyMismatchError
+	}
+
+	difference := m.Cents - other.Cents
+	if (other.Cents > 0 && difference > m.Cents) || (other.Cents < 0 && difference < m.Cents) {
+		return Money{}, AmountOutOfRangeError
+	}
+	return Money{Cents: difference, Currency: m.Currency}, nil
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  string
+		want    int64
+		wantErr error
+	}{
+		{name: "whole", amount: "1500", want: 150000},
+		{name: "one decimal place", amount: "1500.5", want: 150050},
+		{name: "two decimal places", amount: "20.25", want: 2025},
+		{name: "negative", amount: "-20.25", want: -2025},
+		{name: "zero", amount: "0", want: 0},
+		{name: "leading zeros", amount: "0000000000000001.00", want: 100},
+		{name: "largest", amount: "999999999999.99", want: MaxMoneyCents},
+		{name: "most negative", amount: "-999999999999.99", want: -MaxMoneyCents},
+		{name: "empty", amount: "", wantErr: InvalidAmountError},
+		{name: "sign only", amount: "-", wantErr: InvalidAmountError},
+		{name: "point without fraction", amount: "1.", wantErr: InvalidAmountError},
+		{name: "fraction without whole", amount: ".5", wantErr: InvalidAmountError},
+		{name: "three decimal places", amount: "1.005", wantErr: InvalidAmountError},
+		{name: "plus sign", amount: "+1", wantErr: InvalidAmountError},
+		{name: "exponent", amount: "1e3", wantErr: InvalidAmountError},
+		{name: "two points", amount: "1.0.0", wantErr: InvalidAmountError},
+		{name: "above the column", amount: "1000000000000", wantErr: AmountOutOfRangeError},
+		{name: "below the column", amount: "-1000000000000.00", wantErr: AmountOutOfRangeError},
+		{name: "above int64", amount: "99999999999999999999", wantErr: AmountOutOfRangeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.amount, CurrencySGD)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseMoney(%q) error = %v, want %v", tt.amount, err, tt.wantErr)
+			}
+			if err == nil && (got.Cents != tt.want || got.Currency != CurrencySGD) {
+				t.Errorf("ParseMoney(%q) = %+v, want %d cents in SGD", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		cents int64
+		want  string
+	}{
+		{cents: 150050, want: "1500.50"},
+		{cents: 5, want: "0.05"},
+		{cents: 0, want: "0.00"},
+		{cents: -2025, want: "-20.25"},
+	}
+
+	for _, tt := range tests {
+		if got := (Money{Cents: tt.cents, Currency: CurrencySGD}).String(); got != tt.want {
+			t.Errorf("Money{Cents: %d}.String() = %q, want %q", tt.cents, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	sgd := func(cents int64) Money { return Money{Cents: cents, Currency: CurrencySGD} }
+
+	tests := []struct {
+		name    string
+		op      func(Money, Money) (Money, error)
+		a, b    Money
+		want    int64
+		wantErr error
+	}{
+		{name: "add", op: Money.Add, a: sgd(150), b: sgd(25), want: 175},
+		{name: "add negative", op: Money.Add, a: sgd(150), b: sgd(-200), want: -50},
+		{name: "add up to the largest int64", op: Money.Add, a: sgd(math.MaxInt64 - 1), b: sgd(1), want: math.MaxInt64},
+		{name: "add overflows", op: Money.Add, a: sgd(math.MaxInt64), b: sgd(1), wantErr: AmountOutOfRangeError},
+		{name: "add underflows", op: Money.Add, a: sgd(math.MinInt64), b: sgd(-1), wantErr: AmountOutOfRangeError},
+		{name: "add other currency", op: Money.Add, a: sgd(1), b: Money{Cents: 1, Currency: CurrencyUSD}, wantErr: CurrencyMismatchError},
+		{name: "sub", op: Money.Sub, a: sgd(150), b: sgd(200), want: -50},
+		{name: "sub negative", op: Money.Sub, a: sgd(150), b: sgd(-50), want: 200},
+		{name: "sub overflows", op: Money.Sub, a: sgd(math.MaxInt64), b: sgd(-1), wantErr: AmountOutOfRangeError},
+		{name: "sub underflows", op: Money.Sub, a: sgd(math.MinInt64), b: sgd(1), wantErr: AmountOutOfRangeError},
+		{name: "sub the smallest int64", op: Money.Sub, a: sgd(-1), b: sgd(math.MinInt64), want: math.MaxInt64},
+		{name: "sub other currency", op: Money.Sub, a: sgd(1), b: Money{Cents: 1, Currency: CurrencyUSD}, wantErr: CurrencyMismatchError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != sgd(tt.want) {
+				t.Errorf("got %+v, want %d cents in SGD", got, tt.want)
+			}
+		})
+	}
+}
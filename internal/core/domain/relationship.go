@@ -5,6 +5,8 @@ import (
 	"time"
 )
 
+// Relationship links two applicants. It reads as "applicant B is applicant A's <RelationshipType>",
+// e.g. a relationship with type child means applicant B is a child of applicant A.
 type Relationship struct {
 	ID               *uuid.UUID
 	ApplicantAID     *uuid.UUID
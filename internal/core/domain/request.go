@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+// requestIDContextKey is the context key of the ID of the request being handled
+type requestIDContextKey struct{}
+
+// ContextWithRequestID returns a copy of ctx that carries the ID of the request being handled, so that everything logged
+// while handling the request can be correlated.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext returns the ID of the request being handled, or an empty string if ctx does not carry one.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+	return requestID
+}
@@ -5,11 +5,19 @@ import (
 	"time"
 )
 
+// Scheme represents a financial assistance scheme. An applicant must meet every criteria
+// and every top-level criteria group of a scheme to be eligible. ReapplyCooldownDays is the number of days
+// an applicant must wait after a rejected or disbursed application before applying to the scheme again.
+// VersionID and Version are set when the scheme is the definition of a published scheme version.
 type Scheme struct {
-	ID        *uuid.UUID
-	Name      *string
-	Benefits  *[]Benefit
-	Criteria  *[]SchemeCriteria
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
+	ID                  *uuid.UUID
+	Name                *string
+	ReapplyCooldownDays *int
+	VersionID           *uuid.UUID
+	Version             *int
+	Benefits            *[]Benefit
+	Criteria            *[]SchemeCriteria
+	CriteriaGroups      *[]SchemeCriteriaGroup
+	CreatedAt           *time.Time
+	UpdatedAt           *time.Time
 }
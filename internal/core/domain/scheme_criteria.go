@@ -8,6 +8,7 @@ import (
 type SchemeCriteria struct {
 	ID        *uuid.UUID
 	SchemeID  *uuid.UUID
+	GroupID   *uuid.UUID
 	Name      *string
 	Value     *string
 	CreatedAt *time.Time
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type CriteriaGroupOperator string
+
+const (
+	CriteriaGroupOperatorAnd CriteriaGroupOperator = "and"
+	CriteriaGroupOperatorOr  CriteriaGroupOperator = "or"
+	CriteriaGroupOperatorNot CriteriaGroupOperator = "not"
+)
+
+func (o CriteriaGroupOperator) IsValid() bool {
+	switch o {
+	case CriteriaGroupOperatorAnd, CriteriaGroupOperatorOr, CriteriaGroupOperatorNot:
+		return true
+	default:
+		return false
+	}
+}
+
+// SchemeCriteriaGroup combines criteria and nested groups with a boolean operator,
+// e.g. "age >= 65 OR (unemployed AND has_children)".
+// A "not" group negates its single criteria or nested group.
+type SchemeCriteriaGroup struct {
+	ID            *uuid.UUID
+	SchemeID      *uuid.UUID
+	ParentGroupID *uuid.UUID
+	Operator      *CriteriaGroupOperator
+	Criteria      *[]SchemeCriteria
+	Groups        *[]SchemeCriteriaGroup
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SchemeVersionStatus string
+
+const (
+	SchemeVersionStatusDraft     SchemeVersionStatus = "draft"
+	SchemeVersionStatusPublished SchemeVersionStatus = "published"
+	SchemeVersionStatusRetired   SchemeVersionStatus = "retired"
+)
+
+// schemeVersionStatusTransitions lists the statuses a scheme version can move to from each status.
+var schemeVersionStatusTransitions = map[SchemeVersionStatus][]SchemeVersionStatus{
+	SchemeVersionStatusDraft:     {SchemeVersionStatusPublished},
+	SchemeVersionStatusPublished: {SchemeVersionStatusRetired},
+}
+
+func (s SchemeVersionStatus) IsValid() bool {
+	switch s {
+	case SchemeVersionStatusDraft, SchemeVersionStatusPublished, SchemeVersionStatusRetired:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo reports whether a scheme version in this status can move to the given status.
+func (s SchemeVersionStatus) CanTransitionTo(status SchemeVersionStatus) bool {
+	for _, next := range schemeVersionStatusTransitions[s] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// SchemeVersion is a version of the benefits and criteria of a scheme, in effect from EffectiveFrom until the day before
+// EffectiveTo, or indefinitely if EffectiveTo is nil. Drafts have no Definition; publishing a draft copies the current
+// benefits and criteria of the scheme into it, after which it cannot be changed. Retired versions are no longer in effect.
+type SchemeVersion struct {
+	ID            *uuid.UUID
+	SchemeID      *uuid.UUID
+	Version       *int
+	Status        *SchemeVersionStatus
+	EffectiveFrom *time.Time
+	EffectiveTo   *time.Time
+	Definition    *Scheme
+	PublishedAt   *time.Time
+	RetiredAt     *time.Time
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
+
+// Overlaps reports whether the effective dates of two scheme versions overlap.
+func (v SchemeVersion) Overlaps(other SchemeVersion) bool {
+	startsBeforeOtherEnds := other.EffectiveTo == nil || v.EffectiveFrom.Before(*other.EffectiveTo)
+	endsAfterOtherStarts := v.EffectiveTo == nil || other.EffectiveFrom.Before(*v.EffectiveTo)
+
+	return startsBeforeOtherEnds && endsAfterOtherStarts
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Role string
+
+const (
+	RoleViewer      Role = "viewer"
+	RoleCaseworker  Role = "caseworker"
+	RoleSchemeAdmin Role = "scheme_admin"
+	RoleSuperadmin  Role = "superadmin"
+)
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleViewer, RoleCaseworker, RoleSchemeAdmin, RoleSuperadmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// Permission is an action a user may be allowed to perform through the API.
+type Permission string
+
+const (
+	PermissionRead               Permission = "read"
+	PermissionManageApplicants   Permission = "manage_applicants"
+	PermissionManageApplications Permission = "manage_applications"
+	PermissionDecideApplications Permission = "decide_applications"
+	PermissionManageSchemes      Permission = "manage_schemes"
+	PermissionManageUsers        Permission = "manage_users"
+	PermissionReadAuditLogs      Permission = "read_audit_logs"
+)
+
+// rolePermissions lists the permissions granted to each role. Superadmins are granted every permission.
+var rolePermissions = map[Role][]Permission{
+	RoleViewer:      {PermissionRead},
+	RoleCaseworker:  {PermissionRead, PermissionManageApplicants, PermissionManageApplications, PermissionDecideApplications},
+	RoleSchemeAdmin: {PermissionRead, PermissionManageSchemes},
+}
+
+// Can reports whether users with this role are granted the given permission.
+func (r Role) Can(permission Permission) bool {
+	if r == RoleSuperadmin {
+		return true
+	}
+
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// User is an administrator of the system. PasswordHash is never exposed through the API.
+type User struct {
+	ID           *uuid.UUID
+	Email        *string
+	Name         *string
+	PasswordHash *string
+	Role         *Role
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+// TokenPayload is the verified content of an access token, identifying the user who made a request.
+type TokenPayload struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Role      Role
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
@@ -8,16 +8,16 @@ import (
 
 type ApplicantRepository interface {
 	GetApplicantById(ctx context.Context, id uuid.UUID) (*domain.Applicant, error)
-	ListApplicants(ctx context.Context) ([]domain.Applicant, error)
+	ListApplicants(ctx context.Context, filter domain.ApplicantFilter) ([]domain.Applicant, int, error)
 	CreateApplicant(ctx context.Context, applicant *domain.Applicant) (*domain.Applicant, error)
 	UpdateApplicant(ctx context.Context, applicant *domain.Applicant) (*domain.Applicant, error)
 	DeleteApplicant(ctx context.Context, id uuid.UUID) error
-	GetApplicantFamily(ctx context.Context, id uuid.UUID) (map[domain.RelationshipType]*domain.Applicant, error)
+	GetApplicantFamily(ctx context.Context, id uuid.UUID) (domain.Family, error)
 }
 
 type ApplicantService interface {
 	GetApplicantById(ctx context.Context, id uuid.UUID) (*domain.Applicant, error)
-	ListApplicants(ctx context.Context) ([]domain.Applicant, error)
+	ListApplicants(ctx context.Context, filter domain.ApplicantFilter) ([]domain.Applicant, int, error)
 	CreateApplicant(ctx context.Context, applicant *domain.Applicant) (*domain.Applicant, error)
 	UpdateApplicant(ctx context.Context, applicant *domain.Applicant) (*domain.Applicant, error)
 	DeleteApplicant(ctx context.Context, id uuid.UUID) error
@@ -8,16 +8,19 @@ import (
 
 type ApplicationRepository interface {
 	GetApplicationById(ctx context.Context, id uuid.UUID) (*domain.Application, error)
-	ListApplications(ctx context.Context) ([]domain.Application, error)
+	ListApplications(ctx context.Context, filter domain.ApplicationFilter) ([]domain.Application, int, error)
+	ListApplicationsByApplicantAndScheme(ctx context.Context, applicantID, schemeID uuid.UUID) ([]domain.Application, error)
 	CreateApplication(ctx context.Context, application *domain.Application) (*domain.Application, error)
 	UpdateApplication(ctx context.Context, application *domain.Application) (*domain.Application, error)
+	UpdateApplicationStatus(ctx context.Context, id uuid.UUID, currentStatus, newStatus domain.ApplicationStatus) (*domain.Application, error)
 	DeleteApplication(ctx context.Context, id uuid.UUID) error
 }
 
 type ApplicationService interface {
 	GetApplicationById(ctx context.Context, id uuid.UUID) (*domain.Application, error)
-	ListApplications(ctx context.Context) ([]domain.Application, error)
+	ListApplications(ctx context.Context, filter domain.ApplicationFilter) ([]domain.Application, int, error)
 	CreateApplication(ctx context.Context, application *domain.Application) (*domain.Application, error)
 	UpdateApplication(ctx context.Context, application *domain.Application) (*domain.Application, error)
+	TransitionApplication(ctx context.Context, id uuid.UUID, status domain.ApplicationStatus) (*domain.Application, error)
 	DeleteApplication(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+)
+
+// AuditRepository reads the audit log. Entries are written by the other repositories in the same transaction as each change.
+type AuditRepository interface {
+	ListAuditLogs(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditLog, int, error)
+}
+
+type AuditService interface {
+	ListAuditLogs(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditLog, int, error)
+}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+)
+
+// TokenService issues and verifies access tokens without calling an external identity provider.
+type TokenService interface {
+	CreateToken(user *domain.User) (string, *domain.TokenPayload, error)
+	VerifyToken(token string) (*domain.TokenPayload, error)
+}
+
+type AuthService interface {
+	Login(ctx context.Context, email string, password string) (string, *domain.TokenPayload, error)
+	Authenticate(ctx context.Context, token string) (*domain.TokenPayload, error)
+}
@@ -0,0 +1,21 @@
+package port
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+)
+
+type DisbursementRepository interface {
+	GetDisbursementByID(ctx context.Context, id uuid.UUID) (*domain.Disbursement, error)
+	ListApplicationDisbursements(ctx context.Context, applicationID uuid.UUID) ([]domain.Disbursement, error)
+	ListApplicantDisbursements(ctx context.Context, applicantID uuid.UUID) ([]domain.Disbursement, error)
+	CreateDisbursement(ctx context.Context, disbursement *domain.Disbursement) (*domain.Disbursement, error)
+	UpdateDisbursementStatus(ctx context.Context, id uuid.UUID, currentStatus, newStatus domain.DisbursementStatus) (*domain.Disbursement, error)
+}
+
+type DisbursementService interface {
+	ListApplicationDisbursements(ctx context.Context, applicationID uuid.UUID) ([]domain.Disbursement, error)
+	ListApplicantDisbursements(ctx context.Context, applicantID uuid.UUID) ([]domain.Disbursement, error)
+	TransitionDisbursement(ctx context.Context, applicationID, id uuid.UUID, status domain.DisbursementStatus) (*domain.Disbursement, error)
+}
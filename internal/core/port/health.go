@@ -0,0 +1,10 @@
+package port
+
+import "context"
+
+// HealthChecker checks if the dependencies of the API, such as the database, are ready to serve requests.
+type HealthChecker interface {
+	// CheckReadiness returns a domain.DatabaseUnavailableError if the database cannot be reached, or a
+	// domain.OutdatedSchemaError if migrations in the binary have not been applied to it.
+	CheckReadiness(ctx context.Context) error
+}
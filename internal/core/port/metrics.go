@@ -0,0 +1,15 @@
+package port
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// MetricsRecorder records the domain events the services want to be measured, such as eligibility checks.
+type MetricsRecorder interface {
+	// ApplicationCreated records that an application was created
+	ApplicationCreated()
+	// EligibilityChecked records the outcome of checking an applicant against the criteria of a scheme,
+	// and how long the criteria took to evaluate
+	EligibilityChecked(schemeID uuid.UUID, eligible bool, duration time.Duration)
+}
@@ -0,0 +1,24 @@
+package port
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type RelationshipRepository interface {
+	GetRelationshipByID(ctx context.Context, id uuid.UUID) (*domain.Relationship, error)
+	GetRelationshipBetweenApplicants(ctx context.Context, applicantAID uuid.UUID, applicantBID uuid.UUID) (*domain.Relationship, error)
+	ListApplicantRelationships(ctx context.Context, applicantID uuid.UUID) ([]domain.Relationship, error)
+	CreateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error)
+	UpdateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error)
+	DeleteRelationship(ctx context.Context, id uuid.UUID) error
+}
+
+type RelationshipService interface {
+	GetRelationshipByID(ctx context.Context, id uuid.UUID) (*domain.Relationship, error)
+	ListApplicantRelationships(ctx context.Context, applicantID uuid.UUID) ([]domain.Relationship, error)
+	CreateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error)
+	UpdateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error)
+	DeleteRelationship(ctx context.Context, id uuid.UUID) error
+}
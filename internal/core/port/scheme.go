@@ -4,11 +4,12 @@ import (
 	"context"
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
 	"github.com/google/uuid"
+	"time"
 )
 
 type SchemeRepository interface {
 	GetSchemeByID(ctx context.Context, id uuid.UUID) (*domain.Scheme, error)
-	ListSchemes(ctx context.Context) ([]domain.Scheme, error)
+	ListSchemes(ctx context.Context, filter domain.SchemeFilter) ([]domain.Scheme, int, error)
 	CreateScheme(ctx context.Context, scheme *domain.Scheme) (*domain.Scheme, error)
 	UpdateScheme(ctx context.Context, scheme *domain.Scheme) (*domain.Scheme, error)
 	DeleteScheme(ctx context.Context, id uuid.UUID) error
@@ -18,25 +19,61 @@ type SchemeRepository interface {
 	UpdateSchemeBenefit(ctx context.Context, benefit *domain.Benefit) (newBenefit *domain.Benefit, err error)
 	DeleteSchemeBenefit(ctx context.Context, benefitID uuid.UUID) error
 
+	GetBenefitCriteriaByID(ctx context.Context, criteriaID uuid.UUID) (*domain.BenefitCriteria, error)
+	ListBenefitCriteria(ctx context.Context, benefitID uuid.UUID) ([]domain.BenefitCriteria, error)
+	AddBenefitCriteria(ctx context.Context, criteria *domain.BenefitCriteria) (newCriteria *domain.BenefitCriteria, err error)
+	UpdateBenefitCriteria(ctx context.Context, criteria *domain.BenefitCriteria) (newCriteria *domain.BenefitCriteria, err error)
+	DeleteBenefitCriteria(ctx context.Context, criteriaID uuid.UUID) error
+
 	GetSchemeCriteriaByID(ctx context.Context, criteriaID uuid.UUID) (*domain.SchemeCriteria, error)
 	AddSchemeCriteria(ctx context.Context, criteria *domain.SchemeCriteria) (newCriteria *domain.SchemeCriteria, err error)
 	UpdateSchemeCriteria(ctx context.Context, criteria *domain.SchemeCriteria) (newCriteria *domain.SchemeCriteria, err error)
 	DeleteSchemeCriteria(ctx context.Context, criteriaID uuid.UUID) error
+
+	GetSchemeCriteriaGroupByID(ctx context.Context, groupID uuid.UUID) (*domain.SchemeCriteriaGroup, error)
+	AddSchemeCriteriaGroup(ctx context.Context, group *domain.SchemeCriteriaGroup) (newGroup *domain.SchemeCriteriaGroup, err error)
+	DeleteSchemeCriteriaGroup(ctx context.Context, groupID uuid.UUID) error
+
+	ListSchemeVersions(ctx context.Context, schemeID uuid.UUID) ([]domain.SchemeVersion, error)
+	GetSchemeVersionByID(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error)
+	GetEffectiveSchemeVersion(ctx context.Context, schemeID uuid.UUID, asOf time.Time) (*domain.SchemeVersion, error)
+	ListEffectiveSchemeVersions(ctx context.Context, asOf time.Time) ([]domain.SchemeVersion, error)
+	CreateSchemeVersion(ctx context.Context, version *domain.SchemeVersion) (*domain.SchemeVersion, error)
+	PublishSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error)
+	RetireSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error)
+	EndSchemeVersion(ctx context.Context, id uuid.UUID, effectiveTo time.Time) error
+	DeleteSchemeVersion(ctx context.Context, id uuid.UUID) error
 }
 
 type SchemeService interface {
 	GetSchemeByID(ctx context.Context, id uuid.UUID) (*domain.Scheme, error)
-	ListSchemes(ctx context.Context) ([]domain.Scheme, error)
+	ListSchemes(ctx context.Context, filter domain.SchemeFilter) ([]domain.Scheme, int, error)
 	CreateScheme(ctx context.Context, scheme *domain.Scheme) (*domain.Scheme, error)
 	UpdateScheme(ctx context.Context, scheme *domain.Scheme) (*domain.Scheme, error)
 	DeleteScheme(ctx context.Context, id uuid.UUID) error
-	ListApplicantAvailableSchemes(ctx context.Context, applicantID uuid.UUID) ([]domain.Scheme, error)
+	ListApplicantAvailableSchemes(ctx context.Context, applicantID uuid.UUID, asOf time.Time) ([]domain.Scheme, error)
+	CheckApplicantEligibility(ctx context.Context, schemeID uuid.UUID, applicantID uuid.UUID, asOf time.Time) (*domain.EligibilityResult, error)
 
 	AddSchemeBenefit(ctx context.Context, benefit *domain.Benefit) (newBenefit *domain.Benefit, err error)
 	UpdateSchemeBenefit(ctx context.Context, benefit *domain.Benefit) (newBenefit *domain.Benefit, err error)
 	DeleteSchemeBenefit(ctx context.Context, benefitID uuid.UUID) error
 
+	ListBenefitCriteria(ctx context.Context, benefitID uuid.UUID) ([]domain.BenefitCriteria, error)
+	AddBenefitCriteria(ctx context.Context, criteria *domain.BenefitCriteria) (newCriteria *domain.BenefitCriteria, err error)
+	UpdateBenefitCriteria(ctx context.Context, criteria *domain.BenefitCriteria) (newCriteria *domain.BenefitCriteria, err error)
+	DeleteBenefitCriteria(ctx context.Context, benefitID uuid.UUID, criteriaID uuid.UUID) error
+
 	AddSchemeCriteria(ctx context.Context, criteria *domain.SchemeCriteria) (newCriteria *domain.SchemeCriteria, err error)
 	UpdateSchemeCriteria(ctx context.Context, criteria *domain.SchemeCriteria) (newCriteria *domain.SchemeCriteria, err error)
 	DeleteSchemeCriteria(ctx context.Context, criteriaID uuid.UUID) error
+
+	AddSchemeCriteriaGroup(ctx context.Context, group *domain.SchemeCriteriaGroup) (newGroup *domain.SchemeCriteriaGroup, err error)
+	DeleteSchemeCriteriaGroup(ctx context.Context, groupID uuid.UUID) error
+
+	ListSchemeVersions(ctx context.Context, schemeID uuid.UUID) ([]domain.SchemeVersion, error)
+	GetSchemeVersionByID(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error)
+	CreateSchemeVersion(ctx context.Context, version *domain.SchemeVersion) (*domain.SchemeVersion, error)
+	PublishSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error)
+	RetireSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error)
+	DeleteSchemeVersion(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,11 @@
+package port
+
+import "context"
+
+// Transactor runs a unit of work in a transaction that spans every repository.
+type Transactor interface {
+	// WithinTransaction runs fn in a transaction. Every repository call made with the context passed to fn takes part in
+	// the transaction, which is committed if fn returns nil and rolled back otherwise. Calls made with a context that is
+	// already in a transaction join that transaction.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
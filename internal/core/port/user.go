@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+type UserRepository interface {
+	GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
+	ListUsers(ctx context.Context) ([]domain.User, error)
+	CountUsers(ctx context.Context) (int, error)
+	CreateUser(ctx context.Context, user *domain.User) (*domain.User, error)
+	UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error)
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+}
+
+type UserService interface {
+	GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
+	ListUsers(ctx context.Context) ([]domain.User, error)
+	CreateUser(ctx context.Context, user *domain.User, password string) (*domain.User, error)
+	UpdateUser(ctx context.Context, user *domain.User, password *string) (*domain.User, error)
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+	CreateFirstSuperadmin(ctx context.Context, user *domain.User, password string) (*domain.User, error)
+}
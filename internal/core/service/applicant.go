@@ -18,8 +18,12 @@ func (s *ApplicantService) GetApplicantById(ctx context.Context, id uuid.UUID) (
 	return s.ApplicantRepository.GetApplicantById(ctx, id)
 }
 
-func (s *ApplicantService) ListApplicants(ctx context.Context) ([]domain.Applicant, error) {
-	return s.ApplicantRepository.ListApplicants(ctx)
+func (s *ApplicantService) ListApplicants(ctx context.Context, filter domain.ApplicantFilter) ([]domain.Applicant, int, error) {
+	if filter.MinAge != nil && filter.MaxAge != nil && *filter.MinAge > *filter.MaxAge {
+		return nil, 0, domain.InvalidAgeRangeError
+	}
+
+	return s.ApplicantRepository.ListApplicants(ctx, filter)
 }
 
 func (s *ApplicantService) CreateApplicant(ctx context.Context, applicant *domain.Applicant) (*domain.Applicant, error) {
@@ -6,65 +6,255 @@ import (
 	"github.com/cxnub/fas-mgmt-system/internal/core/port"
 	"github.com/cxnub/fas-mgmt-system/internal/core/util"
 	"github.com/google/uuid"
+	"time"
 )
 
 type ApplicationService struct {
 	port.ApplicationRepository
 	port.ApplicantRepository
 	port.SchemeRepository
+	port.DisbursementRepository
+	port.Transactor
+	port.MetricsRecorder
 }
 
-func NewApplicationService(applicationRepo port.ApplicationRepository, applicantRepo port.ApplicantRepository, schemeRepo port.SchemeRepository) *ApplicationService {
-	return &ApplicationService{applicationRepo, applicantRepo, schemeRepo}
+func NewApplicationService(applicationRepo port.ApplicationRepository, applicantRepo port.ApplicantRepository, schemeRepo port.SchemeRepository, disbursementRepo port.DisbursementRepository, transactor port.Transactor, metrics port.MetricsRecorder) *ApplicationService {
+	return &ApplicationService{applicationRepo, applicantRepo, schemeRepo, disbursementRepo, transactor, metrics}
 }
 func (s *ApplicationService) GetApplicationById(ctx context.Context, id uuid.UUID) (*domain.Application, error) {
 	return s.ApplicationRepository.GetApplicationById(ctx, id)
 }
 
-func (s *ApplicationService) ListApplications(ctx context.Context) ([]domain.Application, error) {
-	return s.ApplicationRepository.ListApplications(ctx)
+func (s *ApplicationService) ListApplications(ctx context.Context, filter domain.ApplicationFilter) ([]domain.Application, int, error) {
+	if filter.CreatedFrom != nil && filter.CreatedBefore != nil && !filter.CreatedFrom.Before(*filter.CreatedBefore) {
+		return nil, 0, domain.InvalidDateRangeError
+	}
+
+	return s.ApplicationRepository.ListApplications(ctx, filter)
 }
 
-func (s *ApplicationService) checkApplicationValidity(ctx context.Context, application *domain.Application) error {
+// checkApplicationValidity checks if the applicant of an application is eligible for the version of its scheme in effect
+// on the given date, and that the applicant has no other active application and is not within the scheme's reapply
+// cooldown. The application records the version it was assessed against. The eligibility check is returned, if one was
+// made, so that it can be recorded once the transaction ends.
+func (s *ApplicationService) checkApplicationValidity(ctx context.Context, application *domain.Application, asOf time.Time) (*eligibilityCheck, error) {
 	applicant, err := s.ApplicantRepository.GetApplicantById(ctx, *application.ApplicantID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	scheme, err := s.SchemeRepository.GetSchemeByID(ctx, *application.SchemeID)
+	_, err = s.SchemeRepository.GetSchemeByID(ctx, *application.SchemeID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	version, err := s.SchemeRepository.GetEffectiveSchemeVersion(ctx, *application.SchemeID, asOf)
+	if err != nil {
+		return nil, err
 	}
+	scheme := version.Definition
 
-	// Get applicant relations
-	relationships, err := s.ApplicantRepository.GetApplicantFamily(ctx, *applicant.ID)
+	if err := s.checkExistingApplications(ctx, application, scheme, asOf); err != nil {
+		return nil, err
+	}
+
+	// Get applicant family
+	family, err := s.ApplicantRepository.GetApplicantFamily(ctx, *applicant.ID)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check applicant eligibility
-	if !util.CheckSchemeEligibility(*scheme, applicant, relationships) {
-		return domain.SchemeNotEligibleError
+	result, check := evaluateSchemeEligibility(*scheme, applicant, family, asOf)
+	if !result.Eligible {
+		return &check, domain.SchemeNotEligibleError
+	}
+
+	application.SchemeVersionID = version.ID
+
+	return &check, nil
+}
+
+// checkExistingApplications checks the applicant's other applications to the scheme. It returns a domain.ActiveApplicationError
+// if one of them is still active, or a domain.ReapplicationCooldownError if the latest rejected or disbursed application
+// ended less than the scheme's reapply cooldown before the given date.
+func (s *ApplicationService) checkExistingApplications(ctx context.Context, application *domain.Application, scheme *domain.Scheme, asOf time.Time) error {
+	applications, err := s.ApplicationRepository.ListApplicationsByApplicantAndScheme(ctx, *application.ApplicantID, *application.SchemeID)
+	if err != nil {
+		return err
+	}
+
+	var reapplyFrom time.Time
+	for _, a := range applications {
+		// Skip the application being updated
+		if application.ID != nil && *a.ID == *application.ID {
+			continue
+		}
+
+		if !a.Status.IsFinal() {
+			return &domain.ActiveApplicationError{ApplicationID: *a.ID}
+		}
+
+		if scheme.ReapplyCooldownDays == nil || *scheme.ReapplyCooldownDays == 0 {
+			continue
+		}
+
+		// Withdrawn applications do not start a cooldown
+		if *a.Status == domain.ApplicationStatusWithdrawn {
+			continue
+		}
+
+		// An application ends when it last changes status
+		if from := a.UpdatedAt.AddDate(0, 0, *scheme.ReapplyCooldownDays); from.After(reapplyFrom) {
+			reapplyFrom = from
+		}
+	}
+
+	if asOf.Before(reapplyFrom) {
+		return &domain.ReapplicationCooldownError{ReapplyFrom: reapplyFrom}
 	}
 
 	return nil
 }
 
+// CreateApplication checks the application and creates it in one transaction, so that the applicant, the scheme and
+// their other applications cannot change between the check and the insert.
 func (s *ApplicationService) CreateApplication(ctx context.Context, application *domain.Application) (*domain.Application, error) {
-	if err := s.checkApplicationValidity(ctx, application); err != nil {
+	var check *eligibilityCheck
+	newApplication, err := withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.Application, error) {
+		var err error
+		if check, err = s.checkApplicationValidity(ctx, application, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return s.ApplicationRepository.CreateApplication(ctx, application)
+	})
+
+	// Only the check of the last attempt is recorded if the transaction was retried
+	if check != nil {
+		check.record(s.MetricsRecorder)
+	}
+
+	if err != nil {
 		return nil, err
 	}
 
-	return s.ApplicationRepository.CreateApplication(ctx, application)
+	s.MetricsRecorder.ApplicationCreated()
+	return newApplication, nil
 }
 
+// UpdateApplication checks the changed application and updates it in one transaction.
 func (s *ApplicationService) UpdateApplication(ctx context.Context, application *domain.Application) (*domain.Application, error) {
-	if err := s.checkApplicationValidity(ctx, application); err != nil {
-		return nil, err
+	var check *eligibilityCheck
+	updatedApplication, err := withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.Application, error) {
+		existingApplication, err := s.ApplicationRepository.GetApplicationById(ctx, *application.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Applications can only be changed before they are picked up for review
+		if *existingApplication.Status != domain.ApplicationStatusSubmitted {
+			return nil, domain.ApplicationNotEditableError
+		}
+
+		// Keep the existing applicant and scheme if they are not being changed
+		if application.ApplicantID == nil {
+			application.ApplicantID = existingApplication.ApplicantID
+		}
+		if application.SchemeID == nil {
+			application.SchemeID = existingApplication.SchemeID
+		}
+
+		// Applications are assessed as of the date they were submitted
+		if check, err = s.checkApplicationValidity(ctx, application, *existingApplication.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		return s.ApplicationRepository.UpdateApplication(ctx, application)
+	})
+
+	// Only the check of the last attempt is recorded if the transaction was retried
+	if check != nil {
+		check.record(s.MetricsRecorder)
+	}
+
+	return updatedApplication, err
+}
+
+// scheduleDisbursements schedules the payouts of every benefit of an application's scheme version that the applicant
+// is eligible for. Benefits are assessed as of the date the application was submitted, and the first payouts are due today.
+func (s *ApplicationService) scheduleDisbursements(ctx context.Context, application *domain.Application) error {
+	var version *domain.SchemeVersion
+	var err error
+	if application.SchemeVersionID != nil {
+		version, err = s.SchemeRepository.GetSchemeVersionByID(ctx, *application.SchemeVersionID)
+	} else {
+		// Applications created before schemes were versioned are paid from the version in effect when they were submitted
+		version, err = s.SchemeRepository.GetEffectiveSchemeVersion(ctx, *application.SchemeID, *application.CreatedAt)
+	}
+	if err != nil {
+		return err
+	}
+
+	applicant, err := s.ApplicantRepository.GetApplicantById(ctx, *application.ApplicantID)
+	if err != nil {
+		return err
+	}
+
+	family, err := s.ApplicantRepository.GetApplicantFamily(ctx, *applicant.ID)
+	if err != nil {
+		return err
 	}
 
-	return s.ApplicationRepository.UpdateApplication(ctx, application)
+	if version.Definition.Benefits == nil {
+		return nil
+	}
+
+	start := util.Today()
+	for _, benefit := range util.FilterEligibleBenefits(*version.Definition.Benefits, applicant, family, *application.CreatedAt) {
+		disbursements, err := util.SchedulePayouts(benefit, start)
+		if err != nil {
+			return err
+		}
+
+		for _, disbursement := range disbursements {
+			disbursement.ApplicationID = application.ID
+			if _, err := s.DisbursementRepository.CreateDisbursement(ctx, &disbursement); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// TransitionApplication moves an application to the given status if the transition is allowed from its current status.
+// The status is read and changed in one transaction. Approving an application schedules the payouts of its benefits.
+func (s *ApplicationService) TransitionApplication(ctx context.Context, id uuid.UUID, status domain.ApplicationStatus) (*domain.Application, error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.Application, error) {
+		application, err := s.ApplicationRepository.GetApplicationById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !application.Status.CanTransitionTo(status) {
+			return nil, domain.InvalidApplicationStatusTransitionError
+		}
+
+		updated, err := s.ApplicationRepository.UpdateApplicationStatus(ctx, id, *application.Status, status)
+		if err != nil {
+			return nil, err
+		}
+
+		if status == domain.ApplicationStatusApproved {
+			if err := s.scheduleDisbursements(ctx, updated); err != nil {
+				return nil, err
+			}
+		}
+
+		return updated, nil
+	})
 }
 
 func (s *ApplicationService) DeleteApplication(ctx context.Context, id uuid.UUID) error {
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+)
+
+type AuditService struct {
+	port.AuditRepository
+}
+
+func NewAuditService(repo port.AuditRepository) *AuditService {
+	return &AuditService{repo}
+}
+
+func (s *AuditService) ListAuditLogs(ctx context.Context, filter domain.AuditLogFilter) ([]domain.AuditLog, int, error) {
+	return s.AuditRepository.ListAuditLogs(ctx, filter)
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/cxnub/fas-mgmt-system/internal/core/util"
+)
+
+type AuthService struct {
+	port.UserRepository
+	port.TokenService
+}
+
+func NewAuthService(userRepo port.UserRepository, tokenService port.TokenService) *AuthService {
+	return &AuthService{userRepo, tokenService}
+}
+
+// Login checks the email and password of a user and returns an access token for them.
+// Unknown emails and wrong passwords both return domain.InvalidCredentialsError.
+func (s *AuthService) Login(ctx context.Context, email string, password string) (string, *domain.TokenPayload, error) {
+	user, err := s.UserRepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.UserNotFoundError) {
+			return "", nil, domain.InvalidCredentialsError
+		}
+		return "", nil, err
+	}
+
+	if !util.ComparePassword(*user.PasswordHash, password) {
+		return "", nil, domain.InvalidCredentialsError
+	}
+
+	return s.TokenService.CreateToken(user)
+}
+
+// Authenticate verifies an access token and checks it against the user it was issued to, so that deleted users are
+// rejected and role changes take effect right away. The returned payload carries the current role of the user.
+// Tokens of users that no longer exist return domain.InvalidTokenError.
+func (s *AuthService) Authenticate(ctx context.Context, token string) (*domain.TokenPayload, error) {
+	payload, err := s.TokenService.VerifyToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepository.GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		if errors.Is(err, domain.UserNotFoundError) {
+			return nil, domain.InvalidTokenError
+		}
+		return nil, err
+	}
+
+	payload.Role = *user.Role
+	return payload, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/google/uuid"
+)
+
+type DisbursementService struct {
+	port.DisbursementRepository
+	port.ApplicationRepository
+	port.ApplicantRepository
+	port.Transactor
+}
+
+func NewDisbursementService(dr port.DisbursementRepository, apr port.ApplicationRepository, ar port.ApplicantRepository, t port.Transactor) *DisbursementService {
+	return &DisbursementService{dr, apr, ar, t}
+}
+
+func (s *DisbursementService) ListApplicationDisbursements(ctx context.Context, applicationID uuid.UUID) ([]domain.Disbursement, error) {
+	// Check if application exists
+	_, err := s.ApplicationRepository.GetApplicationById(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DisbursementRepository.ListApplicationDisbursements(ctx, applicationID)
+}
+
+func (s *DisbursementService) ListApplicantDisbursements(ctx context.Context, applicantID uuid.UUID) ([]domain.Disbursement, error) {
+	// Check if applicant exists
+	_, err := s.ApplicantRepository.GetApplicantById(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DisbursementRepository.ListApplicantDisbursements(ctx, applicantID)
+}
+
+// TransitionDisbursement moves a disbursement of an application to the given status if the transition is allowed from
+// its current status. The status is read and changed in one transaction.
+func (s *DisbursementService) TransitionDisbursement(ctx context.Context, applicationID, id uuid.UUID, status domain.DisbursementStatus) (*domain.Disbursement, error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.Disbursement, error) {
+		disbursement, err := s.DisbursementRepository.GetDisbursementByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// Disbursements are only reachable through their own application
+		if *disbursement.ApplicationID != applicationID {
+			return nil, domain.DisbursementNotFoundError
+		}
+
+		if !disbursement.Status.CanTransitionTo(status) {
+			return nil, domain.InvalidDisbursementTransitionError
+		}
+
+		return s.DisbursementRepository.UpdateDisbursementStatus(ctx, id, *disbursement.Status, status)
+	})
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/cxnub/fas-mgmt-system/internal/core/util"
+	"github.com/google/uuid"
+	"time"
+)
+
+// eligibilityCheck is the outcome of an eligibility check and how long the criteria took to evaluate.
+// Checks made inside a transaction are kept until it ends, since a retried transaction checks again.
+type eligibilityCheck struct {
+	schemeID uuid.UUID
+	eligible bool
+	duration time.Duration
+}
+
+// record records the check with the given metrics recorder
+func (c eligibilityCheck) record(metrics port.MetricsRecorder) {
+	metrics.EligibilityChecked(c.schemeID, c.eligible, c.duration)
+}
+
+// evaluateSchemeEligibility checks an applicant against the criteria of a scheme with util.CheckSchemeEligibility,
+// timing how long the criteria take to evaluate.
+func evaluateSchemeEligibility(scheme domain.Scheme, applicant *domain.Applicant, family domain.Family, asOf time.Time) (domain.EligibilityResult, eligibilityCheck) {
+	start := time.Now()
+	result := util.CheckSchemeEligibility(scheme, applicant, family, asOf)
+
+	return result, eligibilityCheck{schemeID: *scheme.ID, eligible: result.Eligible, duration: time.Since(start)}
+}
+
+// checkSchemeEligibility checks an applicant against the criteria of a scheme and records the check right away.
+// It must not be called inside a transaction.
+func checkSchemeEligibility(metrics port.MetricsRecorder, scheme domain.Scheme, applicant *domain.Applicant, family domain.Family, asOf time.Time) domain.EligibilityResult {
+	result, check := evaluateSchemeEligibility(scheme, applicant, family, asOf)
+	check.record(metrics)
+
+	return result
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/google/uuid"
+)
+
+type RelationshipService struct {
+	port.RelationshipRepository
+	port.ApplicantRepository
+	port.Transactor
+}
+
+func NewRelationshipService(rr port.RelationshipRepository, ar port.ApplicantRepository, t port.Transactor) *RelationshipService {
+	return &RelationshipService{rr, ar, t}
+}
+
+func (s *RelationshipService) GetRelationshipByID(ctx context.Context, id uuid.UUID) (*domain.Relationship, error) {
+	return s.RelationshipRepository.GetRelationshipByID(ctx, id)
+}
+
+func (s *RelationshipService) ListApplicantRelationships(ctx context.Context, applicantID uuid.UUID) ([]domain.Relationship, error) {
+	// Check if applicant exists
+	_, err := s.ApplicantRepository.GetApplicantById(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.RelationshipRepository.ListApplicantRelationships(ctx, applicantID)
+}
+
+// CreateRelationship checks both applicants and their existing links and creates the relationship in one transaction.
+func (s *RelationshipService) CreateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.Relationship, error) {
+		// Reject self-links
+		if *relationship.ApplicantAID == *relationship.ApplicantBID {
+			return nil, domain.SelfRelationshipError
+		}
+
+		// Check if both applicants exist
+		_, err := s.ApplicantRepository.GetApplicantById(ctx, *relationship.ApplicantAID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.ApplicantRepository.GetApplicantById(ctx, *relationship.ApplicantBID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Reject duplicate links in either direction
+		_, err = s.RelationshipRepository.GetRelationshipBetweenApplicants(ctx, *relationship.ApplicantAID, *relationship.ApplicantBID)
+		if err == nil {
+			return nil, domain.DuplicateRelationshipError
+		}
+
+		if !errors.Is(err, domain.RelationshipNotFoundError) {
+			return nil, err
+		}
+
+		return s.RelationshipRepository.CreateRelationship(ctx, relationship)
+	})
+}
+
+func (s *RelationshipService) UpdateRelationship(ctx context.Context, relationship *domain.Relationship) (*domain.Relationship, error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.Relationship, error) {
+		// Check if relationship exists
+		_, err := s.RelationshipRepository.GetRelationshipByID(ctx, *relationship.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.RelationshipRepository.UpdateRelationship(ctx, relationship)
+	})
+}
+
+func (s *RelationshipService) DeleteRelationship(ctx context.Context, id uuid.UUID) error {
+	return s.RelationshipRepository.DeleteRelationship(ctx, id)
+}
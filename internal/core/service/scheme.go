@@ -6,40 +6,374 @@ import (
 	"github.com/cxnub/fas-mgmt-system/internal/core/port"
 	"github.com/cxnub/fas-mgmt-system/internal/core/util"
 	"github.com/google/uuid"
+	"time"
 )
 
 type SchemeService struct {
 	port.SchemeRepository
 	port.ApplicantRepository
+	port.Transactor
+	port.MetricsRecorder
 }
 
-func NewSchemeService(sr port.SchemeRepository, ar port.ApplicantRepository) *SchemeService {
-	return &SchemeService{sr, ar}
+func NewSchemeService(sr port.SchemeRepository, ar port.ApplicantRepository, t port.Transactor, m port.MetricsRecorder) *SchemeService {
+	return &SchemeService{sr, ar, t, m}
 }
 
 func (s *SchemeService) GetSchemeById(ctx context.Context, id uuid.UUID) (*domain.Scheme, error) {
 	return s.SchemeRepository.GetSchemeByID(ctx, id)
 }
 
-func (s *SchemeService) ListSchemes(ctx context.Context) ([]domain.Scheme, error) {
-	return s.SchemeRepository.ListSchemes(ctx)
+func (s *SchemeService) ListSchemes(ctx context.Context, filter domain.SchemeFilter) ([]domain.Scheme, int, error) {
+	return s.SchemeRepository.ListSchemes(ctx, filter)
 }
 
+// CreateScheme creates a scheme together with its benefits, benefit criteria, criteria and criteria groups in one transaction.
+// Every criteria is validated before anything is written. The first version of the scheme is published with it, in effect from today.
 func (s *SchemeService) CreateScheme(ctx context.Context, scheme *domain.Scheme) (*domain.Scheme, error) {
-	return s.SchemeRepository.CreateScheme(ctx, scheme)
+	if err := validateSchemeCriteria(scheme); err != nil {
+		return nil, err
+	}
+
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.Scheme, error) {
+		newScheme, err := s.SchemeRepository.CreateScheme(ctx, scheme)
+		if err != nil {
+			return nil, err
+		}
+
+		if scheme.Benefits != nil {
+			for i := range *scheme.Benefits {
+				if err := s.addBenefit(ctx, *newScheme.ID, &(*scheme.Benefits)[i]); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if err := s.addCriteria(ctx, *newScheme.ID, scheme.Criteria, scheme.CriteriaGroups); err != nil {
+			return nil, err
+		}
+
+		today := util.Today()
+		version, err := s.SchemeRepository.CreateSchemeVersion(ctx, &domain.SchemeVersion{SchemeID: newScheme.ID, EffectiveFrom: &today})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.SchemeRepository.PublishSchemeVersion(ctx, *version.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.SchemeRepository.GetSchemeByID(ctx, *newScheme.ID)
+	})
 }
 
+// UpdateScheme updates the working copy of a scheme in one transaction. The changes only affect eligibility once they are
+// published in a new version of the scheme. If benefits are given, they replace the benefits of the scheme:
+// benefits with an ID are updated, benefits without one are added and the other benefits of the scheme are deleted.
+// The criteria of a benefit are replaced if they are given. Criteria and criteria groups replace the top-level criteria
+// and criteria groups of the scheme if they are given. Every criteria is validated before anything is written.
 func (s *SchemeService) UpdateScheme(ctx context.Context, scheme *domain.Scheme) (*domain.Scheme, error) {
-	return s.SchemeRepository.UpdateScheme(ctx, scheme)
+	updateDetails := scheme.Name != nil || scheme.ReapplyCooldownDays != nil
+
+	if !updateDetails && scheme.Benefits == nil && scheme.Criteria == nil && scheme.CriteriaGroups == nil {
+		return nil, domain.NoUpdateFieldsError
+	}
+
+	if err := validateSchemeCriteria(scheme); err != nil {
+		return nil, err
+	}
+
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.Scheme, error) {
+		existingScheme, err := s.SchemeRepository.GetSchemeByID(ctx, *scheme.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if updateDetails {
+			_, err = s.SchemeRepository.UpdateScheme(ctx, scheme)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if scheme.Benefits != nil {
+			if err := s.replaceBenefits(ctx, existingScheme, *scheme.Benefits); err != nil {
+				return nil, err
+			}
+		}
+
+		if scheme.Criteria != nil {
+			for _, criteria := range *existingScheme.Criteria {
+				if err := s.SchemeRepository.DeleteSchemeCriteria(ctx, *criteria.ID); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if scheme.CriteriaGroups != nil {
+			for _, group := range *existingScheme.CriteriaGroups {
+				if err := s.SchemeRepository.DeleteSchemeCriteriaGroup(ctx, *group.ID); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if err := s.addCriteria(ctx, *scheme.ID, scheme.Criteria, scheme.CriteriaGroups); err != nil {
+			return nil, err
+		}
+
+		return s.SchemeRepository.GetSchemeByID(ctx, *scheme.ID)
+	})
+}
+
+// validateSchemeCriteria checks the criteria of a scheme, its criteria groups and the criteria of its benefits
+func validateSchemeCriteria(scheme *domain.Scheme) error {
+	if scheme.Criteria != nil {
+		for i := range *scheme.Criteria {
+			if err := util.IsValidCriteria(&(*scheme.Criteria)[i]); err != nil {
+				return *err
+			}
+		}
+	}
+
+	if scheme.CriteriaGroups != nil {
+		for i := range *scheme.CriteriaGroups {
+			if err := util.IsValidCriteriaGroup(&(*scheme.CriteriaGroups)[i]); err != nil {
+				return *err
+			}
+		}
+	}
+
+	if scheme.Benefits != nil {
+		for _, benefit := range *scheme.Benefits {
+			if benefit.Criteria == nil {
+				continue
+			}
+
+			for i := range *benefit.Criteria {
+				if err := util.IsValidBenefitCriteria(&(*benefit.Criteria)[i]); err != nil {
+					return *err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateBenefitSchedule checks that a one-off benefit has no duration and that a recurring benefit is paid for at least
+// one month. Benefits without a frequency are paid once.
+func validateBenefitSchedule(benefit *domain.Benefit) error {
+	frequency := domain.BenefitFrequencyOneOff
+	if benefit.Frequency != nil {
+		frequency = *benefit.Frequency
+	}
+
+	if frequency == domain.BenefitFrequencyOneOff {
+		if benefit.DurationMonths != nil {
+			return domain.InvalidBenefitScheduleError
+		}
+		return nil
+	}
+
+	if benefit.DurationMonths == nil || *benefit.DurationMonths <= 0 {
+		return domain.InvalidBenefitScheduleError
+	}
+
+	return nil
+}
+
+// mergeBenefitSchedule fills in the parts of a benefit's schedule that are not being changed from the existing benefit,
+// since the frequency, duration and cap of a benefit are replaced together. A new frequency is taken with only the
+// duration given alongside it.
+func mergeBenefitSchedule(benefit *domain.Benefit, existingBenefit *domain.Benefit) {
+	if benefit.Frequency == nil && benefit.DurationMonths == nil && benefit.Cap == nil {
+		return
+	}
+
+	if benefit.Frequency == nil {
+		benefit.Frequency = existingBenefit.Frequency
+		if benefit.DurationMonths == nil {
+			benefit.DurationMonths = existingBenefit.DurationMonths
+		}
+	}
+
+	if benefit.Cap == nil {
+		benefit.Cap = existingBenefit.Cap
+	}
+}
+
+// resolveBenefitCurrency gives the amount and cap of a benefit that were given without a currency the currency of the
+// benefit. That is the currency the amount was given in, or otherwise the currency of the existing benefit, or the
+// default currency for new benefits. Returns domain.CurrencyMismatchError if the cap is in another currency.
+func resolveBenefitCurrency(benefit *domain.Benefit, existingBenefit *domain.Benefit) error {
+	currency := domain.DefaultCurrency
+	if existingBenefit != nil && existingBenefit.Amount != nil {
+		currency = existingBenefit.Amount.Currency
+	}
+	if benefit.Amount != nil && benefit.Amount.Currency != "" {
+		currency = benefit.Amount.Currency
+	}
+
+	for _, amount := range []*domain.Money{benefit.Amount, benefit.Cap} {
+		if amount == nil {
+			continue
+		}
+
+		if amount.Currency == "" {
+			amount.Currency = currency
+		} else if amount.Currency != currency {
+			return domain.CurrencyMismatchError
+		}
+	}
+
+	return nil
+}
+
+// addBenefit adds a benefit and its criteria to a scheme
+func (s *SchemeService) addBenefit(ctx context.Context, schemeID uuid.UUID, benefit *domain.Benefit) error {
+	benefit.SchemeID = &schemeID
+
+	if benefit.Amount == nil {
+		return domain.MissingBenefitAmountError
+	}
+
+	if err := resolveBenefitCurrency(benefit, nil); err != nil {
+		return err
+	}
+
+	if err := validateBenefitSchedule(benefit); err != nil {
+		return err
+	}
+
+	newBenefit, err := s.SchemeRepository.AddSchemeBenefit(ctx, benefit)
+	if err != nil {
+		return err
+	}
+
+	return s.addBenefitCriteria(ctx, *newBenefit.ID, benefit.Criteria)
+}
+
+// addBenefitCriteria adds the given criteria to a benefit
+func (s *SchemeService) addBenefitCriteria(ctx context.Context, benefitID uuid.UUID, criteria *[]domain.BenefitCriteria) error {
+	if criteria == nil {
+		return nil
+	}
+
+	for i := range *criteria {
+		c := &(*criteria)[i]
+		c.BenefitID = &benefitID
+
+		if _, err := s.SchemeRepository.AddBenefitCriteria(ctx, c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replaceBenefits makes the given benefits the benefits of a scheme. Benefits with an ID must belong to the scheme and are
+// updated, benefits without one are added, and the other benefits of the scheme are deleted.
+func (s *SchemeService) replaceBenefits(ctx context.Context, scheme *domain.Scheme, benefits []domain.Benefit) error {
+	existingBenefits := make(map[uuid.UUID]domain.Benefit, len(*scheme.Benefits))
+	for _, benefit := range *scheme.Benefits {
+		existingBenefits[*benefit.ID] = benefit
+	}
+
+	for i := range benefits {
+		benefit := &benefits[i]
+
+		if benefit.ID == nil {
+			if err := s.addBenefit(ctx, *scheme.ID, benefit); err != nil {
+				return err
+			}
+			continue
+		}
+
+		existingBenefit, ok := existingBenefits[*benefit.ID]
+		if !ok {
+			return domain.BenefitNotFoundError
+		}
+		delete(existingBenefits, *benefit.ID)
+
+		benefit.SchemeID = scheme.ID
+
+		mergeBenefitSchedule(benefit, &existingBenefit)
+		if err := resolveBenefitCurrency(benefit, &existingBenefit); err != nil {
+			return err
+		}
+
+		if benefit.Frequency != nil {
+			if err := validateBenefitSchedule(benefit); err != nil {
+				return err
+			}
+		}
+
+		if _, err := s.SchemeRepository.UpdateSchemeBenefit(ctx, benefit); err != nil {
+			return err
+		}
+
+		if benefit.Criteria == nil {
+			continue
+		}
+
+		for _, criteria := range *existingBenefit.Criteria {
+			if err := s.SchemeRepository.DeleteBenefitCriteria(ctx, *criteria.ID); err != nil {
+				return err
+			}
+		}
+
+		if err := s.addBenefitCriteria(ctx, *benefit.ID, benefit.Criteria); err != nil {
+			return err
+		}
+	}
+
+	// Delete the benefits that were left out
+	for id := range existingBenefits {
+		if err := s.SchemeRepository.DeleteSchemeBenefit(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addCriteria adds the given top-level criteria and criteria groups to a scheme
+func (s *SchemeService) addCriteria(ctx context.Context, schemeID uuid.UUID, criteria *[]domain.SchemeCriteria, groups *[]domain.SchemeCriteriaGroup) error {
+	if criteria != nil {
+		for i := range *criteria {
+			c := &(*criteria)[i]
+			c.SchemeID = &schemeID
+
+			if _, err := s.SchemeRepository.AddSchemeCriteria(ctx, c); err != nil {
+				return err
+			}
+		}
+	}
+
+	if groups != nil {
+		for i := range *groups {
+			g := &(*groups)[i]
+			g.SchemeID = &schemeID
+
+			if _, err := s.SchemeRepository.AddSchemeCriteriaGroup(ctx, g); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 func (s *SchemeService) DeleteScheme(ctx context.Context, id uuid.UUID) error {
 	return s.SchemeRepository.DeleteScheme(ctx, id)
 }
 
-func (s *SchemeService) ListApplicantAvailableSchemes(ctx context.Context, applicantID uuid.UUID) ([]domain.Scheme, error) {
-	// Get all schemes
-	schemes, err := s.SchemeRepository.ListSchemes(ctx)
+// ListApplicantAvailableSchemes returns the versions of the schemes in effect on the given date that the applicant is eligible for.
+func (s *SchemeService) ListApplicantAvailableSchemes(ctx context.Context, applicantID uuid.UUID, asOf time.Time) ([]domain.Scheme, error) {
+	// Get the versions of every scheme in effect
+	versions, err := s.SchemeRepository.ListEffectiveSchemeVersions(ctx, asOf)
 
 	if err != nil {
 		return nil, err
@@ -52,8 +386,8 @@ func (s *SchemeService) ListApplicantAvailableSchemes(ctx context.Context, appli
 		return nil, err
 	}
 
-	// Get applicant relations
-	relationships, err := s.ApplicantRepository.GetApplicantFamily(ctx, applicantID)
+	// Get applicant family
+	family, err := s.ApplicantRepository.GetApplicantFamily(ctx, applicantID)
 
 	if err != nil {
 		return nil, err
@@ -61,8 +395,15 @@ func (s *SchemeService) ListApplicantAvailableSchemes(ctx context.Context, appli
 
 	result := make([]domain.Scheme, 0)
 
-	for _, scheme := range schemes {
-		if util.CheckSchemeEligibility(scheme, applicant, relationships) {
+	for _, version := range versions {
+		scheme := *version.Definition
+		if checkSchemeEligibility(s.MetricsRecorder, scheme, applicant, family, asOf).Eligible {
+			// Only include the benefits the applicant is eligible for
+			if scheme.Benefits != nil {
+				benefits := util.FilterEligibleBenefits(*scheme.Benefits, applicant, family, asOf)
+				scheme.Benefits = &benefits
+			}
+
 			result = append(result, scheme)
 		}
 	}
@@ -70,86 +411,395 @@ func (s *SchemeService) ListApplicantAvailableSchemes(ctx context.Context, appli
 	return result, nil
 }
 
-func (s *SchemeService) AddSchemeBenefit(ctx context.Context, benefit *domain.Benefit) (newBenefit *domain.Benefit, err error) {
+// CheckApplicantEligibility checks the applicant against the version of the scheme in effect on the given date.
+func (s *SchemeService) CheckApplicantEligibility(ctx context.Context, schemeID uuid.UUID, applicantID uuid.UUID, asOf time.Time) (*domain.EligibilityResult, error) {
 	// Check if scheme exists
-	_, err = s.SchemeRepository.GetSchemeByID(ctx, *benefit.SchemeID)
+	_, err := s.SchemeRepository.GetSchemeByID(ctx, schemeID)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.SchemeRepository.AddSchemeBenefit(ctx, benefit)
-}
+	// Get the version of the scheme in effect
+	version, err := s.SchemeRepository.GetEffectiveSchemeVersion(ctx, schemeID, asOf)
+	if err != nil {
+		return nil, err
+	}
 
-func (s *SchemeService) UpdateSchemeBenefit(ctx context.Context, benefit *domain.Benefit) (newBenefit *domain.Benefit, err error) {
-	// Check if scheme exists
-	_, err = s.SchemeRepository.GetSchemeByID(ctx, *benefit.SchemeID)
+	// Get applicant
+	applicant, err := s.ApplicantRepository.GetApplicantById(ctx, applicantID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if benefit exists
-	_, err = s.SchemeRepository.GetBenefitByID(ctx, *benefit.ID)
+	// Get applicant family
+	family, err := s.ApplicantRepository.GetApplicantFamily(ctx, applicantID)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.SchemeRepository.UpdateSchemeBenefit(ctx, benefit)
+	result := checkSchemeEligibility(s.MetricsRecorder, *version.Definition, applicant, family, asOf)
+	result.SchemeVersionID = version.ID
+	return &result, nil
+}
+
+func (s *SchemeService) AddSchemeBenefit(ctx context.Context, benefit *domain.Benefit) (newBenefit *domain.Benefit, err error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (newBenefit *domain.Benefit, err error) {
+		// Check if scheme exists
+		_, err = s.SchemeRepository.GetSchemeByID(ctx, *benefit.SchemeID)
+		if err != nil {
+			return nil, err
+		}
+
+		if benefit.Amount == nil {
+			return nil, domain.MissingBenefitAmountError
+		}
+
+		if err = resolveBenefitCurrency(benefit, nil); err != nil {
+			return nil, err
+		}
+
+		if err = validateBenefitSchedule(benefit); err != nil {
+			return nil, err
+		}
+
+		return s.SchemeRepository.AddSchemeBenefit(ctx, benefit)
+	})
+}
+
+func (s *SchemeService) UpdateSchemeBenefit(ctx context.Context, benefit *domain.Benefit) (newBenefit *domain.Benefit, err error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (newBenefit *domain.Benefit, err error) {
+		// Check if scheme exists
+		_, err = s.SchemeRepository.GetSchemeByID(ctx, *benefit.SchemeID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if benefit exists
+		existingBenefit, err := s.SchemeRepository.GetBenefitByID(ctx, *benefit.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		mergeBenefitSchedule(benefit, existingBenefit)
+		if err = resolveBenefitCurrency(benefit, existingBenefit); err != nil {
+			return nil, err
+		}
+
+		if benefit.Frequency != nil {
+			if err = validateBenefitSchedule(benefit); err != nil {
+				return nil, err
+			}
+		}
+
+		return s.SchemeRepository.UpdateSchemeBenefit(ctx, benefit)
+	})
 }
 
 func (s *SchemeService) DeleteSchemeBenefit(ctx context.Context, benefitID uuid.UUID) error {
+	return s.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Check if benefit exists
+		_, err := s.SchemeRepository.GetBenefitByID(ctx, benefitID)
+		if err != nil {
+			return err
+		}
+
+		return s.SchemeRepository.DeleteSchemeBenefit(ctx, benefitID)
+	})
+}
+
+func (s *SchemeService) ListBenefitCriteria(ctx context.Context, benefitID uuid.UUID) ([]domain.BenefitCriteria, error) {
 	// Check if benefit exists
 	_, err := s.SchemeRepository.GetBenefitByID(ctx, benefitID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return s.SchemeRepository.DeleteSchemeBenefit(ctx, benefitID)
+	return s.SchemeRepository.ListBenefitCriteria(ctx, benefitID)
+}
+
+func (s *SchemeService) AddBenefitCriteria(ctx context.Context, criteria *domain.BenefitCriteria) (newCriteria *domain.BenefitCriteria, err error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (newCriteria *domain.BenefitCriteria, err error) {
+		// Check if criteria is valid
+		invalidCriteriaErr := util.IsValidBenefitCriteria(criteria)
+		if invalidCriteriaErr != nil {
+			return nil, *invalidCriteriaErr
+		}
+
+		// Check if benefit exists
+		_, err = s.SchemeRepository.GetBenefitByID(ctx, *criteria.BenefitID)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.SchemeRepository.AddBenefitCriteria(ctx, criteria)
+	})
+}
+
+func (s *SchemeService) UpdateBenefitCriteria(ctx context.Context, criteria *domain.BenefitCriteria) (newCriteria *domain.BenefitCriteria, err error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (newCriteria *domain.BenefitCriteria, err error) {
+		// Check if benefit exists
+		_, err = s.SchemeRepository.GetBenefitByID(ctx, *criteria.BenefitID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if criteria exists and belongs to the benefit
+		existingCriteria, err := s.SchemeRepository.GetBenefitCriteriaByID(ctx, *criteria.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if *existingCriteria.BenefitID != *criteria.BenefitID {
+			return nil, domain.BenefitCriteriaNotFoundError
+		}
+
+		// Check if the resulting criteria is valid
+		merged := *existingCriteria
+		if criteria.Name != nil {
+			merged.Name = criteria.Name
+		}
+		if criteria.Value != nil {
+			merged.Value = criteria.Value
+		}
+
+		invalidCriteriaErr := util.IsValidBenefitCriteria(&merged)
+		if invalidCriteriaErr != nil {
+			return nil, *invalidCriteriaErr
+		}
+
+		return s.SchemeRepository.UpdateBenefitCriteria(ctx, criteria)
+	})
+}
+
+func (s *SchemeService) DeleteBenefitCriteria(ctx context.Context, benefitID uuid.UUID, criteriaID uuid.UUID) error {
+	return s.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Check if criteria exists and belongs to the benefit
+		criteria, err := s.SchemeRepository.GetBenefitCriteriaByID(ctx, criteriaID)
+		if err != nil {
+			return err
+		}
+
+		if *criteria.BenefitID != benefitID {
+			return domain.BenefitCriteriaNotFoundError
+		}
+
+		return s.SchemeRepository.DeleteBenefitCriteria(ctx, criteriaID)
+	})
 }
 
 func (s *SchemeService) AddSchemeCriteria(ctx context.Context, criteria *domain.SchemeCriteria) (newCriteria *domain.SchemeCriteria, err error) {
-	// Check if criteria is valid
-	invalidCriteriaErr := util.IsValidCriteria(criteria)
-	if invalidCriteriaErr != nil {
-		return nil, *invalidCriteriaErr
-	}
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (newCriteria *domain.SchemeCriteria, err error) {
+		// Check if criteria is valid
+		invalidCriteriaErr := util.IsValidCriteria(criteria)
+		if invalidCriteriaErr != nil {
+			return nil, *invalidCriteriaErr
+		}
 
-	// Check if scheme exists
-	_, err = s.SchemeRepository.GetSchemeByID(ctx, *criteria.SchemeID)
-	if err != nil {
-		return nil, err
-	}
+		// Check if scheme exists
+		_, err = s.SchemeRepository.GetSchemeByID(ctx, *criteria.SchemeID)
+		if err != nil {
+			return nil, err
+		}
 
-	return s.SchemeRepository.AddSchemeCriteria(ctx, criteria)
+		return s.SchemeRepository.AddSchemeCriteria(ctx, criteria)
+	})
 }
 
 func (s *SchemeService) UpdateSchemeCriteria(ctx context.Context, criteria *domain.SchemeCriteria) (newCriteria *domain.SchemeCriteria, err error) {
-	// Check if criteria is valid
-	invalidCriteriaErr := util.IsValidCriteria(criteria)
-	if invalidCriteriaErr != nil {
-		return nil, *invalidCriteriaErr
-	}
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (newCriteria *domain.SchemeCriteria, err error) {
+		// Check if criteria is valid
+		invalidCriteriaErr := util.IsValidCriteria(criteria)
+		if invalidCriteriaErr != nil {
+			return nil, *invalidCriteriaErr
+		}
+
+		// Check if scheme exists
+		_, err = s.SchemeRepository.GetSchemeByID(ctx, *criteria.SchemeID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if criteria exists
+		_, err = s.SchemeRepository.GetSchemeCriteriaByID(ctx, *criteria.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.SchemeRepository.UpdateSchemeCriteria(ctx, criteria)
+	})
+}
+
+func (s *SchemeService) DeleteSchemeCriteria(ctx context.Context, criteriaID uuid.UUID) error {
+	return s.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Check if criteria exists
+		criteria, err := s.SchemeRepository.GetSchemeCriteriaByID(ctx, criteriaID)
+		if err != nil {
+			return err
+		}
+
+		// Removing a single criteria from a group could leave the group invalid, so the group has to be deleted instead
+		if criteria.GroupID != nil {
+			return domain.NestedSchemeCriteriaError
+		}
 
+		return s.SchemeRepository.DeleteSchemeCriteria(ctx, criteriaID)
+	})
+}
+
+func (s *SchemeService) AddSchemeCriteriaGroup(ctx context.Context, group *domain.SchemeCriteriaGroup) (newGroup *domain.SchemeCriteriaGroup, err error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (newGroup *domain.SchemeCriteriaGroup, err error) {
+		// Check if the whole criteria group tree is valid
+		invalidGroupErr := util.IsValidCriteriaGroup(group)
+		if invalidGroupErr != nil {
+			return nil, *invalidGroupErr
+		}
+
+		// Check if scheme exists
+		_, err = s.SchemeRepository.GetSchemeByID(ctx, *group.SchemeID)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.SchemeRepository.AddSchemeCriteriaGroup(ctx, group)
+	})
+}
+
+func (s *SchemeService) DeleteSchemeCriteriaGroup(ctx context.Context, groupID uuid.UUID) error {
+	return s.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Check if criteria group exists
+		group, err := s.SchemeRepository.GetSchemeCriteriaGroupByID(ctx, groupID)
+		if err != nil {
+			return err
+		}
+
+		// Only top-level groups can be deleted, as removing a nested group could leave its parent invalid
+		if group.ParentGroupID != nil {
+			return domain.NestedSchemeCriteriaError
+		}
+
+		return s.SchemeRepository.DeleteSchemeCriteriaGroup(ctx, groupID)
+	})
+}
+
+// ListSchemeVersions returns every version of a scheme, latest first.
+func (s *SchemeService) ListSchemeVersions(ctx context.Context, schemeID uuid.UUID) ([]domain.SchemeVersion, error) {
 	// Check if scheme exists
-	_, err = s.SchemeRepository.GetSchemeByID(ctx, *criteria.SchemeID)
+	_, err := s.SchemeRepository.GetSchemeByID(ctx, schemeID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if criteria exists
-	_, err = s.SchemeRepository.GetSchemeCriteriaByID(ctx, *criteria.ID)
-	if err != nil {
+	return s.SchemeRepository.ListSchemeVersions(ctx, schemeID)
+}
+
+// CreateSchemeVersion creates a draft version of a scheme. The draft cannot be in effect from a date in the past.
+func (s *SchemeService) CreateSchemeVersion(ctx context.Context, version *domain.SchemeVersion) (*domain.SchemeVersion, error) {
+	if err := validateEffectiveDates(*version); err != nil {
 		return nil, err
 	}
 
-	return s.SchemeRepository.UpdateSchemeCriteria(ctx, criteria)
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.SchemeVersion, error) {
+		// Check if scheme exists
+		_, err := s.SchemeRepository.GetSchemeByID(ctx, *version.SchemeID)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.SchemeRepository.CreateSchemeVersion(ctx, version)
+	})
 }
 
-func (s *SchemeService) DeleteSchemeCriteria(ctx context.Context, criteriaID uuid.UUID) error {
-	// Check if criteria exists
-	_, err := s.SchemeRepository.GetSchemeCriteriaByID(ctx, criteriaID)
-	if err != nil {
-		return err
+// PublishSchemeVersion publishes a draft version in one transaction, freezing the current benefits and criteria of the
+// scheme into it. A published version of the scheme that is in effect indefinitely from an earlier date is ended when
+// the new version comes into effect, and one in effect indefinitely from the same date is superseded by it and retired,
+// so that a version can be corrected on the day it comes into effect. Any other overlap with a published version is rejected.
+func (s *SchemeService) PublishSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.SchemeVersion, error) {
+		version, err := s.SchemeRepository.GetSchemeVersionByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !version.Status.CanTransitionTo(domain.SchemeVersionStatusPublished) {
+			return nil, domain.InvalidSchemeVersionTransitionError
+		}
+
+		if err := validateEffectiveDates(*version); err != nil {
+			return nil, err
+		}
+
+		versions, err := s.SchemeRepository.ListSchemeVersions(ctx, *version.SchemeID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, other := range versions {
+			if *other.Status != domain.SchemeVersionStatusPublished || !version.Overlaps(other) {
+				continue
+			}
+
+			if other.EffectiveTo == nil && other.EffectiveFrom.Equal(*version.EffectiveFrom) {
+				if _, err = s.SchemeRepository.RetireSchemeVersion(ctx, *other.ID); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			if other.EffectiveTo != nil || !other.EffectiveFrom.Before(*version.EffectiveFrom) {
+				return nil, domain.SchemeVersionOverlapError
+			}
+
+			err = s.SchemeRepository.EndSchemeVersion(ctx, *other.ID, *version.EffectiveFrom)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return s.SchemeRepository.PublishSchemeVersion(ctx, id)
+	})
+}
+
+// RetireSchemeVersion retires a published version so that it is no longer in effect.
+func (s *SchemeService) RetireSchemeVersion(ctx context.Context, id uuid.UUID) (*domain.SchemeVersion, error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.SchemeVersion, error) {
+		version, err := s.SchemeRepository.GetSchemeVersionByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !version.Status.CanTransitionTo(domain.SchemeVersionStatusRetired) {
+			return nil, domain.InvalidSchemeVersionTransitionError
+		}
+
+		return s.SchemeRepository.RetireSchemeVersion(ctx, id)
+	})
+}
+
+// DeleteSchemeVersion deletes a draft version. Published and retired versions are kept.
+func (s *SchemeService) DeleteSchemeVersion(ctx context.Context, id uuid.UUID) error {
+	return s.WithinTransaction(ctx, func(ctx context.Context) error {
+		version, err := s.SchemeRepository.GetSchemeVersionByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if *version.Status != domain.SchemeVersionStatusDraft {
+			return domain.SchemeVersionNotDraftError
+		}
+
+		return s.SchemeRepository.DeleteSchemeVersion(ctx, id)
+	})
+}
+
+// validateEffectiveDates checks that a version comes into effect today or later, and ends after it comes into effect
+func validateEffectiveDates(version domain.SchemeVersion) error {
+	if version.EffectiveFrom.Before(util.Today()) {
+		return domain.BackdatedSchemeVersionError
+	}
+
+	if version.EffectiveTo != nil && !version.EffectiveTo.After(*version.EffectiveFrom) {
+		return domain.InvalidEffectiveDatesError
 	}
 
-	return s.SchemeRepository.DeleteSchemeCriteria(ctx, criteriaID)
+	return nil
 }
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+)
+
+// withinTransaction runs fn in a transaction started by the transactor and returns its result.
+// The transaction is rolled back if fn returns an error.
+func withinTransaction[T any](ctx context.Context, t port.Transactor, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+
+	err := t.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+
+	return result, err
+}
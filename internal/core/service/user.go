@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/cxnub/fas-mgmt-system/internal/core/port"
+	"github.com/cxnub/fas-mgmt-system/internal/core/util"
+	"github.com/google/uuid"
+)
+
+type UserService struct {
+	port.UserRepository
+	port.Transactor
+}
+
+func NewUserService(repo port.UserRepository, transactor port.Transactor) *UserService {
+	return &UserService{repo, transactor}
+}
+
+func (s *UserService) GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	return s.UserRepository.GetUserByID(ctx, id)
+}
+
+func (s *UserService) ListUsers(ctx context.Context) ([]domain.User, error) {
+	return s.UserRepository.ListUsers(ctx)
+}
+
+// CreateUser stores a new user with the bcrypt hash of the given password.
+func (s *UserService) CreateUser(ctx context.Context, user *domain.User, password string) (*domain.User, error) {
+	hash, err := util.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	user.PasswordHash = &hash
+
+	return s.UserRepository.CreateUser(ctx, user)
+}
+
+// UpdateUser updates the details of a user, and their password if one is given.
+func (s *UserService) UpdateUser(ctx context.Context, user *domain.User, password *string) (*domain.User, error) {
+	if password != nil {
+		hash, err := util.HashPassword(*password)
+		if err != nil {
+			return nil, err
+		}
+		user.PasswordHash = &hash
+	}
+
+	return s.UserRepository.UpdateUser(ctx, user)
+}
+
+func (s *UserService) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	return s.UserRepository.DeleteUser(ctx, id)
+}
+
+// CreateFirstSuperadmin creates a superadmin if there are no users yet, so that the API can be accessed after it is first set up.
+// Nothing is created and nil is returned if users already exist. Users are counted and the superadmin is created in one
+// transaction, so that instances starting at the same time cannot both create one.
+func (s *UserService) CreateFirstSuperadmin(ctx context.Context, user *domain.User, password string) (*domain.User, error) {
+	return withinTransaction(ctx, s.Transactor, func(ctx context.Context) (*domain.User, error) {
+		count, err := s.UserRepository.CountUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if count > 0 {
+			return nil, nil
+		}
+
+		role := domain.RoleSuperadmin
+		user.Role = &role
+
+		return s.CreateUser(ctx, user, password)
+	})
+}
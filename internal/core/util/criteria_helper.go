@@ -2,7 +2,7 @@ package util
 
 import (
 	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
-	"slices"
+	"github.com/google/uuid"
 	"strconv"
 	"strings"
 	"time"
@@ -11,131 +11,491 @@ import (
 // Possible operators
 var operators = []string{">=", "<=", ">", "<", "=="}
 
-// CompareNumber checks if a given number satisfies the condition string (e.g., ">=65").
-func CompareNumber(condition string, num int) (bool, error) {
-	var operator string
-	var valueStr string
-
+// splitCondition splits a condition string (e.g., ">=65") into its operator and the value it compares against.
+// The operator is empty if the condition does not start with one.
+func splitCondition(condition string) (string, string) {
 	// Find which operator exists in the condition string
 	for _, op := range operators {
 		if strings.HasPrefix(condition, op) {
-			operator = op
-			valueStr = strings.TrimPrefix(condition, op)
-			break
+			return op, strings.TrimSpace(strings.TrimPrefix(condition, op))
 		}
 	}
 
+	return "", ""
+}
+
+// compare checks if num satisfies the operator when compared against value.
+func compare[T int | int64](operator string, num T, value T) bool {
+	switch operator {
+	case ">=":
+		return num >= value
+	case "<=":
+		return num <= value
+	case ">":
+		return num > value
+	case "<":
+		return num < value
+	case "==":
+		return num == value
+	default:
+		return false
+	}
+}
+
+// Comparison is a parsed criterion value that compares a number against a value with an operator (e.g., ">=65").
+// Amounts of money are compared as a whole number of cents.
+type Comparison[T int | int64] struct {
+	Operator string
+	Value    T
+}
+
+// Matches checks if a given number satisfies the comparison.
+func (c Comparison[T]) Matches(num T) bool {
+	return compare(c.Operator, num, c.Value)
+}
+
+// ParseNumberComparison parses a condition string that compares against a whole number (e.g., ">=65").
+func ParseNumberComparison(condition string) (*Comparison[int], error) {
+	operator, valueStr := splitCondition(condition)
 	if operator == "" {
-		return false, domain.InvalidSchemeCriteriaAgeValueError
+		return nil, domain.InvalidComparisonConditionError
 	}
 
 	// Convert the number string to an integer
-	value, err := strconv.Atoi(strings.TrimSpace(valueStr))
+	value, err := strconv.Atoi(valueStr)
 
 	if err != nil {
-		return false, domain.InvalidSchemeCriteriaAgeValueError
+		return nil, domain.InvalidComparisonConditionError
 	}
 
-	// Perform the comparison
-	switch operator {
-	case ">=":
-		return num >= value, nil
-	case "<=":
-		return num <= value, nil
-	case ">":
-		return num > value, nil
-	case "<":
-		return num < value, nil
-	case "==":
-		return num == value, nil
+	return &Comparison[int]{Operator: operator, Value: value}, nil
+}
+
+// ParseAmountComparison parses a condition string that compares against an amount of money (e.g., "<=1500.50").
+// The amount is compared exactly, as a whole number of cents.
+func ParseAmountComparison(condition string) (*Comparison[int64], error) {
+	operator, valueStr := splitCondition(condition)
+	if operator == "" {
+		return nil, domain.InvalidComparisonConditionError
+	}
+
+	value, err := domain.ParseMoney(valueStr, domain.DefaultCurrency)
+
+	if err != nil {
+		return nil, domain.InvalidComparisonConditionError
+	}
+
+	return &Comparison[int64]{Operator: operator, Value: value.Cents}, nil
+}
+
+// CompareNumber checks if a given number satisfies the condition string (e.g., ">=65").
+func CompareNumber(condition string, num int) (bool, error) {
+	comparison, err := ParseNumberComparison(condition)
+	if err != nil {
+		return false, err
+	}
+
+	return comparison.Matches(num), nil
+}
+
+// CompareAmount checks if a given amount of money satisfies the condition string (e.g., "<=1500.50").
+func CompareAmount(condition string, amount domain.Money) (bool, error) {
+	comparison, err := ParseAmountComparison(condition)
+	if err != nil {
+		return false, err
+	}
+
+	return comparison.Matches(amount.Cents), nil
+}
+
+// ParseBooleanCondition parses a condition string that is either true or false.
+func ParseBooleanCondition(condition string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(condition)) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
 	default:
-		return false, domain.InvalidSchemeCriteriaAgeValueError
+		return false, domain.InvalidBooleanConditionError
 	}
 }
 
-func CheckSchemeEligibility(scheme domain.Scheme, applicant *domain.Applicant, relationships map[domain.RelationshipType]*domain.Applicant) bool {
-	if scheme.Criteria == nil {
-		return true
+// AgeBand is a parsed criterion value for an inclusive range of ages (e.g., "7-12").
+type AgeBand struct {
+	Min int
+	Max int
+}
+
+// Contains checks if a given age is within the age band.
+func (b AgeBand) Contains(age int) bool {
+	return age >= b.Min && age <= b.Max
+}
+
+// ParseAgeBand parses a condition string into an AgeBand. Both ages must be whole numbers, and the first must not be
+// greater than the second.
+func ParseAgeBand(condition string) (*AgeBand, error) {
+	minStr, maxStr, found := strings.Cut(condition, "-")
+	if !found {
+		return nil, domain.InvalidAgeBandConditionError
 	}
 
-	relations := make([]domain.RelationshipType, 0, len(relationships))
-	for k := range relationships {
-		relations = append(relations, k)
+	minAge, err := strconv.Atoi(strings.TrimSpace(minStr))
+	if err != nil || minAge < 0 {
+		return nil, domain.InvalidAgeBandConditionError
 	}
 
-	for _, criterion := range *scheme.Criteria {
-		criterionName := strings.ToLower(strings.TrimSpace(*criterion.Name))
-		criterionValue := strings.ToLower(strings.TrimSpace(*criterion.Value))
-		switch criterionName {
-		case "employment_status":
-			if domain.EmploymentStatus(criterionValue) != *applicant.EmploymentStatus {
-				return false
-			}
-		case "marital_status":
-			if domain.MaritalStatus(criterionValue) != *applicant.MaritalStatus {
-				return false
-			}
-		case "has_children":
-			if criterionValue == "true" && !slices.Contains(relations, domain.RelationshipTypeChild) {
-				return false
-			}
-		case "age":
-			if applicant.DateOfBirth != nil {
-				age := time.Now().Year() - applicant.DateOfBirth.Year()
-				if valid, _ := CompareNumber(criterionValue, age); !valid {
-					return false
-				}
-			} else {
-				return false
-			}
+	maxAge, err := strconv.Atoi(strings.TrimSpace(maxStr))
+	if err != nil || maxAge < minAge {
+		return nil, domain.InvalidAgeBandConditionError
+	}
+
+	return &AgeBand{Min: minAge, Max: maxAge}, nil
+}
+
+// negationPrefix negates a set condition (e.g., "!employed")
+const negationPrefix = "!"
+
+// SetCondition is a parsed criterion value that matches any of a set of values (e.g., "single,widowed,divorce").
+// A negated set condition matches none of the values instead (e.g., "!employed").
+type SetCondition struct {
+	Values  []string
+	Negated bool
+}
+
+// ParseSetCondition parses a condition string into a SetCondition, checking every value with isValid.
+func ParseSetCondition(condition string, isValid func(string) bool) (*SetCondition, error) {
+	condition = strings.ToLower(strings.TrimSpace(condition))
+
+	setCondition := SetCondition{}
+
+	if strings.HasPrefix(condition, negationPrefix) {
+		setCondition.Negated = true
+		condition = strings.TrimPrefix(condition, negationPrefix)
+	}
+
+	for _, value := range strings.Split(condition, ",") {
+		value = strings.TrimSpace(value)
+
+		if !isValid(value) {
+			return nil, domain.InvalidSetConditionError
 		}
+
+		setCondition.Values = append(setCondition.Values, value)
 	}
-	return true
+
+	return &setCondition, nil
+}
+
+// contains checks if the value is one of the values in the set.
+func (c SetCondition) contains(value string) bool {
+	for _, v := range c.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches checks if a given value satisfies the set condition.
+func (c SetCondition) Matches(value string) bool {
+	return c.contains(value) != c.Negated
+}
+
+// MatchesAny checks if a collection of values satisfies the set condition, i.e. whether any of the values
+// is in the set, or none of them are if the condition is negated.
+func (c SetCondition) MatchesAny(values []string) bool {
+	for _, value := range values {
+		if c.contains(value) {
+			return !c.Negated
+		}
+	}
+	return c.Negated
+}
+
+// Age band of children attending primary school
+var primarySchoolAgeBand = AgeBand{Min: 7, Max: 12}
+
+// applicantAge returns the age of an applicant as of the given date, or false if the date of birth is unknown.
+func applicantAge(applicant *domain.Applicant, asOf time.Time) (int, bool) {
+	if applicant == nil || applicant.DateOfBirth == nil {
+		return 0, false
+	}
+
+	return AgeOn(*applicant.DateOfBirth, asOf), true
+}
+
+// memberAges returns the ages of family members as of the given date, skipping those whose date of birth is unknown,
+// together with a description of every member's age as the actual value of a criterion.
+func memberAges(members []domain.Applicant, asOf time.Time) ([]int, string) {
+	if len(members) == 0 {
+		return nil, "none"
+	}
+
+	var ages []int
+	descriptions := make([]string, 0, len(members))
+	for _, member := range members {
+		age, ok := applicantAge(&member, asOf)
+		if !ok {
+			descriptions = append(descriptions, unknownValue)
+			continue
+		}
+
+		ages = append(ages, age)
+		descriptions = append(descriptions, strconv.Itoa(age))
+	}
+
+	return ages, strings.Join(descriptions, ",")
+}
+
+// unknownValue is reported as the actual value when an applicant's details are missing
+const unknownValue = "unknown"
+
+// householdTotal sums an amount, such as monthly income, over the household of the applicant, which is made up of the
+// applicant and their dependants (spouse, children and parents), and returns it together with the size of the household.
+// Siblings are not part of the household. Dependants whose amount is unknown are counted as having none, but the total
+// is unknown if the amount of the applicant themselves is unknown.
+func householdTotal(applicant *domain.Applicant, family domain.Family, amount func(domain.Applicant) *domain.Money) (domain.Money, int, bool) {
+	total := amount(*applicant)
+	if total == nil {
+		return domain.Money{}, 0, false
+	}
+
+	dependants := family.Dependants()
+	sum := *total
+	for _, dependant := range dependants {
+		value := amount(dependant)
+		if value == nil {
+			continue
+		}
+
+		var err error
+		if sum, err = sum.Add(*value); err != nil {
+			return domain.Money{}, 0, false
+		}
+	}
+
+	return sum, 1 + len(dependants), true
+}
+
+// monthlyIncome returns the monthly income of an applicant, or nil if it is unknown
+func monthlyIncome(applicant domain.Applicant) *domain.Money {
+	return applicant.MonthlyIncome
+}
+
+// assets returns the assets of an applicant, or nil if they are unknown
+func assets(applicant domain.Applicant) *domain.Money {
+	return applicant.Assets
+}
+
+// evaluateCriterion checks a single criterion as of the given date and describes the outcome.
+// Criteria without a name or value, or that are not registered, are never met.
+func evaluateCriterion(id *uuid.UUID, name *string, value *string, applicant *domain.Applicant, family domain.Family, asOf time.Time) domain.CriterionResult {
+	passed, actual := false, unknownValue
+
+	if name != nil && value != nil {
+		if criterion, ok := LookupCriterion(*name); ok {
+			passed, actual = criterion.Evaluate(strings.ToLower(strings.TrimSpace(*value)), applicant, family, asOf)
+		}
+	}
+
+	return domain.CriterionResult{
+		CriteriaID: id,
+		Name:       name,
+		Required:   value,
+		Actual:     &actual,
+		Passed:     passed,
+	}
+}
+
+// CheckCriteriaGroup checks if an applicant with the given family satisfies a criteria group as of the given date,
+// evaluating its criteria and nested groups with the group's operator.
+func CheckCriteriaGroup(group domain.SchemeCriteriaGroup, applicant *domain.Applicant, family domain.Family, asOf time.Time) domain.CriteriaGroupResult {
+	result := domain.CriteriaGroupResult{
+		GroupID:  group.ID,
+		Operator: group.Operator,
+		Criteria: []domain.CriterionResult{},
+		Groups:   []domain.CriteriaGroupResult{},
+	}
+
+	allMet := true
+	anyMet := false
+
+	if group.Criteria != nil {
+		for _, criterion := range *group.Criteria {
+			criterionResult := evaluateCriterion(criterion.ID, criterion.Name, criterion.Value, applicant, family, asOf)
+			result.Criteria = append(result.Criteria, criterionResult)
+
+			allMet = allMet && criterionResult.Passed
+			anyMet = anyMet || criterionResult.Passed
+		}
+	}
+
+	if group.Groups != nil {
+		for _, nestedGroup := range *group.Groups {
+			groupResult := CheckCriteriaGroup(nestedGroup, applicant, family, asOf)
+			result.Groups = append(result.Groups, groupResult)
+
+			allMet = allMet && groupResult.Passed
+			anyMet = anyMet || groupResult.Passed
+		}
+	}
+
+	switch *group.Operator {
+	case domain.CriteriaGroupOperatorOr:
+		result.Passed = anyMet
+	case domain.CriteriaGroupOperatorNot:
+		result.Passed = !allMet
+	default:
+		result.Passed = allMet
+	}
+
+	return result
+}
+
+// CheckSchemeEligibility checks if an applicant with the given family meets all the criteria
+// and criteria groups of a scheme as of the given date, and which of the scheme's benefits they would receive.
+// The result lists the outcome of every criterion so that ineligible applicants can be told why.
+func CheckSchemeEligibility(scheme domain.Scheme, applicant *domain.Applicant, family domain.Family, asOf time.Time) domain.EligibilityResult {
+	result := domain.EligibilityResult{
+		SchemeID:       scheme.ID,
+		ApplicantID:    applicant.ID,
+		AsOf:           &asOf,
+		Criteria:       []domain.CriterionResult{},
+		CriteriaGroups: []domain.CriteriaGroupResult{},
+		Benefits:       []domain.BenefitEligibilityResult{},
+		Eligible:       true,
+	}
+
+	if scheme.Criteria != nil {
+		for _, criterion := range *scheme.Criteria {
+			criterionResult := evaluateCriterion(criterion.ID, criterion.Name, criterion.Value, applicant, family, asOf)
+			result.Criteria = append(result.Criteria, criterionResult)
+			result.Eligible = result.Eligible && criterionResult.Passed
+		}
+	}
+
+	if scheme.CriteriaGroups != nil {
+		for _, group := range *scheme.CriteriaGroups {
+			groupResult := CheckCriteriaGroup(group, applicant, family, asOf)
+			result.CriteriaGroups = append(result.CriteriaGroups, groupResult)
+			result.Eligible = result.Eligible && groupResult.Passed
+		}
+	}
+
+	if scheme.Benefits != nil {
+		for _, benefit := range *scheme.Benefits {
+			result.Benefits = append(result.Benefits, CheckBenefitEligibility(benefit, applicant, family, asOf))
+		}
+	}
+
+	return result
+}
+
+// CheckBenefitEligibility checks if an applicant with the given family meets all the criteria of a benefit as of the given date.
+func CheckBenefitEligibility(benefit domain.Benefit, applicant *domain.Applicant, family domain.Family, asOf time.Time) domain.BenefitEligibilityResult {
+	result := domain.BenefitEligibilityResult{
+		BenefitID: benefit.ID,
+		Name:      benefit.Name,
+		Criteria:  []domain.CriterionResult{},
+		Eligible:  true,
+	}
+
+	if benefit.Criteria == nil {
+		return result
+	}
+
+	for _, criterion := range *benefit.Criteria {
+		criterionResult := evaluateCriterion(criterion.ID, criterion.Name, criterion.Value, applicant, family, asOf)
+		result.Criteria = append(result.Criteria, criterionResult)
+		result.Eligible = result.Eligible && criterionResult.Passed
+	}
+
+	return result
+}
+
+// FilterEligibleBenefits returns the benefits whose criteria are met by an applicant with the given family as of the given date.
+func FilterEligibleBenefits(benefits []domain.Benefit, applicant *domain.Applicant, family domain.Family, asOf time.Time) []domain.Benefit {
+	result := make([]domain.Benefit, 0, len(benefits))
+
+	for _, benefit := range benefits {
+		if CheckBenefitEligibility(benefit, applicant, family, asOf).Eligible {
+			result = append(result, benefit)
+		}
+	}
+
+	return result
+}
+
+// validateCriterion checks if the given criterion name and value are valid and can be used.
+func validateCriterion(name *string, value *string) *error {
+	if name == nil || value == nil {
+		return &domain.EmptySchemeCriteriaError
+	}
+
+	// Retrieve the criterion registered under the given name and check if it exists
+	criterion, exists := LookupCriterion(*name)
+	if !exists {
+		return &domain.InvalidSchemeCriteriaNameError
+	}
+
+	if err := criterion.Validate(strings.ToLower(strings.TrimSpace(*value))); err != nil {
+		return &err
+	}
+
+	return nil
 }
 
 // IsValidCriteria checks if the given criteria is valid and can be used.
 func IsValidCriteria(criterion *domain.SchemeCriteria) *error {
-	if criterion == nil || criterion.Name == nil || criterion.Value == nil {
+	if criterion == nil {
 		return &domain.EmptySchemeCriteriaError
 	}
 
-	// Trim and convert the criterion name to lowercase for comparison
-	criterionName := strings.ToLower(strings.TrimSpace(*criterion.Name))
+	return validateCriterion(criterion.Name, criterion.Value)
+}
 
-	// Define a map of valid criteria names and their corresponding validation functions
-	validCriteria := map[string]func(string) *error{
-		"employment_status": func(value string) *error {
-			if !domain.EmploymentStatus(value).IsValid() {
-				return &domain.InvalidSchemeCriteriaEmploymentStatusValueError
-			}
-			return nil
-		},
-		"marital_status": func(value string) *error {
-			if !domain.MaritalStatus(value).IsValid() {
-				return &domain.InvalidSchemeCriteriaMaritalStatusValueError
-			}
-			return nil
-		},
-		"has_children": func(value string) *error {
-			if value != "true" && value != "false" {
-				return &domain.InvalidSchemeCriteriaHasChildrenValueError
+// IsValidBenefitCriteria checks if the given benefit criteria is valid and can be used.
+func IsValidBenefitCriteria(criterion *domain.BenefitCriteria) *error {
+	if criterion == nil {
+		return &domain.EmptySchemeCriteriaError
+	}
+
+	return validateCriterion(criterion.Name, criterion.Value)
+}
+
+// IsValidCriteriaGroup checks if the given criteria group, including all of its criteria and nested groups, is valid and can be used.
+func IsValidCriteriaGroup(group *domain.SchemeCriteriaGroup) *error {
+	if group == nil || group.Operator == nil || !group.Operator.IsValid() {
+		return &domain.InvalidCriteriaGroupOperatorError
+	}
+
+	items := 0
+
+	if group.Criteria != nil {
+		for i := range *group.Criteria {
+			if err := IsValidCriteria(&(*group.Criteria)[i]); err != nil {
+				return err
 			}
-			return nil
-		},
-		"age": func(value string) *error {
-			if _, err := CompareNumber(value, 0); err != nil {
-				return &domain.InvalidSchemeCriteriaAgeValueError
+		}
+		items += len(*group.Criteria)
+	}
+
+	if group.Groups != nil {
+		for i := range *group.Groups {
+			if err := IsValidCriteriaGroup(&(*group.Groups)[i]); err != nil {
+				return err
 			}
-			return nil
-		},
+		}
+		items += len(*group.Groups)
 	}
 
-	//
-	// Retrieve the validation function for the given criteria name and check if it exists
-	validate, exists := validCriteria[criterionName]
-	if !exists {
-		return &domain.InvalidSchemeCriteriaNameError
+	if items == 0 {
+		return &domain.EmptyCriteriaGroupError
+	}
+
+	if *group.Operator == domain.CriteriaGroupOperatorNot && items != 1 {
+		return &domain.InvalidNotCriteriaGroupError
 	}
 
-	return validate(strings.ToLower(strings.TrimSpace(*criterion.Value)))
+	return nil
 }
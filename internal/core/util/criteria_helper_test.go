@@ -0,0 +1,387 @@
+package util
+
+import (
+	"errors"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// sgd parses an amount of money in the default currency
+func sgd(amount string) *domain.Money {
+	money, err := domain.ParseMoney(amount, domain.DefaultCurrency)
+	if err != nil {
+		panic(err)
+	}
+	return &money
+}
+
+func TestCompareNumber(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		num       int
+		want      bool
+		wantErr   error
+	}{
+		{name: "greater or equal, equal", condition: ">=65", num: 65, want: true},
+		{name: "greater or equal, below", condition: ">=65", num: 64, want: false},
+		{name: "less or equal", condition: "<=3", num: 3, want: true},
+		{name: "greater than", condition: ">2", num: 2, want: false},
+		{name: "less than", condition: "<21", num: 20, want: true},
+		{name: "equal", condition: "==0", num: 0, want: true},
+		{name: "space after operator", condition: ">= 18", num: 30, want: true},
+		{name: "negative value", condition: ">-1", num: 0, want: true},
+		{name: "missing operator", condition: "65", wantErr: domain.InvalidComparisonConditionError},
+		{name: "unknown operator", condition: "!=65", wantErr: domain.InvalidComparisonConditionError},
+		{name: "missing value", condition: ">=", wantErr: domain.InvalidComparisonConditionError},
+		{name: "decimal value", condition: ">=6.5", wantErr: domain.InvalidComparisonConditionError},
+		{name: "empty", condition: "", wantErr: domain.InvalidComparisonConditionError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CompareNumber(tt.condition, tt.num)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CompareNumber(%q, %d) error = %v, want %v", tt.condition, tt.num, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CompareNumber(%q, %d) = %v, want %v", tt.condition, tt.num, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidCriteria(t *testing.T) {
+	tests := []struct {
+		name      string
+		criterion *domain.SchemeCriteria
+		wantErr   error
+	}{
+		{name: "nil", criterion: nil, wantErr: domain.EmptySchemeCriteriaError},
+		{name: "missing name", criterion: &domain.SchemeCriteria{Value: ptr(">=65")}, wantErr: domain.EmptySchemeCriteriaError},
+		{name: "missing value", criterion: &domain.SchemeCriteria{Name: ptr("age")}, wantErr: domain.EmptySchemeCriteriaError},
+		{name: "unknown name", criterion: &domain.SchemeCriteria{Name: ptr("height"), Value: ptr(">=150")}, wantErr: domain.InvalidSchemeCriteriaNameError},
+		{name: "number comparison", criterion: &domain.SchemeCriteria{Name: ptr("age"), Value: ptr(">=65")}},
+		{name: "amount comparison", criterion: &domain.SchemeCriteria{Name: ptr("household_income"), Value: ptr("<=1500.50")}},
+		{name: "set", criterion: &domain.SchemeCriteria{Name: ptr("marital_status"), Value: ptr("single,widowed")}},
+		{name: "negated set", criterion: &domain.SchemeCriteria{Name: ptr("employment_status"), Value: ptr("!employed")}},
+		{name: "value is case insensitive", criterion: &domain.SchemeCriteria{Name: ptr("sex"), Value: ptr(" Female ")}},
+		{name: "boolean", criterion: &domain.SchemeCriteria{Name: ptr("has_children"), Value: ptr("true")}},
+		{name: "age band", criterion: &domain.SchemeCriteria{Name: ptr("has_children_aged"), Value: ptr("7-12")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := IsValidCriteria(tt.criterion)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("IsValidCriteria() = %v, want nil", *err)
+				}
+				return
+			}
+			if err == nil || !errors.Is(*err, tt.wantErr) {
+				t.Fatalf("IsValidCriteria() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	invalidValues := []struct {
+		name  string
+		value string
+	}{
+		{name: "age", value: "65"},
+		{name: "household_income", value: "<=abc"},
+		{name: "marital_status", value: "engaged"},
+		{name: "has_children", value: "yes"},
+		{name: "has_children_aged", value: "12-7"},
+	}
+
+	for _, tt := range invalidValues {
+		t.Run("invalid "+tt.name, func(t *testing.T) {
+			err := IsValidCriteria(&domain.SchemeCriteria{Name: ptr(tt.name), Value: ptr(tt.value)})
+			if err == nil {
+				t.Fatalf("IsValidCriteria(%s %q) = nil, want an error", tt.name, tt.value)
+			}
+
+			var valueErr *domain.InvalidCriterionValueError
+			if !errors.As(*err, &valueErr) {
+				t.Fatalf("IsValidCriteria(%s %q) = %v, want a domain.InvalidCriterionValueError", tt.name, tt.value, *err)
+			}
+			if valueErr.Criterion.Name != tt.name {
+				t.Errorf("InvalidCriterionValueError names criterion %q, want %q", valueErr.Criterion.Name, tt.name)
+			}
+		})
+	}
+}
+
+func TestCheckSchemeEligibility(t *testing.T) {
+	asOf := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	// birthday returns the date of birth of someone who is the given age as of the date of assessment
+	birthday := func(age int) *time.Time {
+		return ptr(asOf.AddDate(-age, 0, 0))
+	}
+	criteria := func(pairs ...string) *[]domain.SchemeCriteria {
+		result := make([]domain.SchemeCriteria, 0, len(pairs)/2)
+		for i := 0; i < len(pairs); i += 2 {
+			result = append(result, domain.SchemeCriteria{ID: ptr(uuid.New()), Name: ptr(pairs[i]), Value: ptr(pairs[i+1])})
+		}
+		return &result
+	}
+	group := func(operator domain.CriteriaGroupOperator, criteria *[]domain.SchemeCriteria, groups ...domain.SchemeCriteriaGroup) domain.SchemeCriteriaGroup {
+		return domain.SchemeCriteriaGroup{ID: ptr(uuid.New()), Operator: ptr(operator), Criteria: criteria, Groups: &groups}
+	}
+
+	applicant := &domain.Applicant{
+		ID:               ptr(uuid.New()),
+		EmploymentStatus: ptr(domain.EmploymentStatusUnemployed),
+		MaritalStatus:    ptr(domain.MaritalStatusSingle),
+		Sex:              ptr(domain.SexFemale),
+		DateOfBirth:      birthday(40),
+		MonthlyIncome:    sgd("500"),
+	}
+	family := domain.Family{
+		domain.RelationshipTypeChild: {
+			{ID: ptr(uuid.New()), DateOfBirth: birthday(8), MonthlyIncome: sgd("0")},
+			{ID: ptr(uuid.New()), DateOfBirth: birthday(15), MonthlyIncome: sgd("300")},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		scheme   domain.Scheme
+		family   domain.Family
+		eligible bool
+		benefits []bool
+	}{
+		{
+			name:     "no criteria",
+			scheme:   domain.Scheme{},
+			family:   family,
+			eligible: true,
+		},
+		{
+			name:     "all criteria met",
+			scheme:   domain.Scheme{Criteria: criteria("employment_status", "unemployed", "age", ">=21", "has_primary_school_children", "true")},
+			family:   family,
+			eligible: true,
+		},
+		{
+			name:     "one criterion not met",
+			scheme:   domain.Scheme{Criteria: criteria("employment_status", "unemployed", "age", ">=65")},
+			family:   family,
+			eligible: false,
+		},
+		{
+			name:     "family criterion not met without family",
+			scheme:   domain.Scheme{Criteria: criteria("has_children", "true")},
+			family:   domain.Family{},
+			eligible: false,
+		},
+		{
+			name:     "household income includes family",
+			scheme:   domain.Scheme{Criteria: criteria("household_income", "<=800", "per_capita_income", "<300")},
+			family:   family,
+			eligible: true,
+		},
+		{
+			name:     "unregistered criteria are not met",
+			scheme:   domain.Scheme{Criteria: criteria("height", ">=150")},
+			family:   family,
+			eligible: false,
+		},
+		{
+			name:     "criteria without a value are not met",
+			scheme:   domain.Scheme{Criteria: &[]domain.SchemeCriteria{{Name: ptr("sex")}}},
+			family:   family,
+			eligible: false,
+		},
+		{
+			name:     "criteria without a name are not met",
+			scheme:   domain.Scheme{Criteria: &[]domain.SchemeCriteria{{Value: ptr("female")}}},
+			family:   family,
+			eligible: false,
+		},
+		{
+			name: "or group met by one criterion",
+			scheme: domain.Scheme{CriteriaGroups: &[]domain.SchemeCriteriaGroup{
+				group(domain.CriteriaGroupOperatorOr, criteria("marital_status", "married", "has_children_aged", "13-18")),
+			}},
+			family:   family,
+			eligible: true,
+		},
+		{
+			name: "and group with nested not group",
+			scheme: domain.Scheme{CriteriaGroups: &[]domain.SchemeCriteriaGroup{
+				group(domain.CriteriaGroupOperatorAnd, criteria("sex", "female"),
+					group(domain.CriteriaGroupOperatorNot, criteria("employment_status", "unemployed")),
+				),
+			}},
+			family:   family,
+			eligible: false,
+		},
+		{
+			name: "benefits are checked separately from the scheme",
+			scheme: domain.Scheme{
+				Criteria: criteria("age", ">=65"),
+				Benefits: &[]domain.Benefit{
+					{ID: ptr(uuid.New()), Name: ptr("Grocery vouchers")},
+					{ID: ptr(uuid.New()), Name: ptr("School meals"), Criteria: &[]domain.BenefitCriteria{
+						{Name: ptr("has_children_aged"), Value: ptr("7-12")},
+					}},
+					{ID: ptr(uuid.New()), Name: ptr("Elder care"), Criteria: &[]domain.BenefitCriteria{
+						{Name: ptr("has_parent_aged"), Value: ptr(">=65")},
+					}},
+				},
+			},
+			family:   family,
+			eligible: false,
+			benefits: []bool{true, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CheckSchemeEligibility(tt.scheme, applicant, tt.family, asOf)
+
+			if result.Eligible != tt.eligible {
+				t.Errorf("Eligible = %v, want %v (result: %+v)", result.Eligible, tt.eligible, result)
+			}
+			if tt.scheme.Criteria != nil && len(result.Criteria) != len(*tt.scheme.Criteria) {
+				t.Errorf("got %d criterion results, want %d", len(result.Criteria), len(*tt.scheme.Criteria))
+			}
+			if len(result.Benefits) != len(tt.benefits) {
+				t.Fatalf("got %d benefit results, want %d", len(result.Benefits), len(tt.benefits))
+			}
+			for i, want := range tt.benefits {
+				if result.Benefits[i].Eligible != want {
+					t.Errorf("benefit %q Eligible = %v, want %v", *result.Benefits[i].Name, result.Benefits[i].Eligible, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckSchemeEligibilityActualValues(t *testing.T) {
+	asOf := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	applicant := &domain.Applicant{
+		ID:          ptr(uuid.New()),
+		DateOfBirth: ptr(time.Date(1960, time.June, 2, 0, 0, 0, 0, time.UTC)),
+	}
+	scheme := domain.Scheme{Criteria: &[]domain.SchemeCriteria{
+		{Name: ptr("age"), Value: ptr(">=65")},
+		{Name: ptr("employment_status"), Value: ptr("unemployed")},
+		{Name: ptr("age"), Value: ptr("old")},
+		{Name: ptr("height"), Value: ptr(">=150")},
+	}}
+
+	result := CheckSchemeEligibility(scheme, applicant, domain.Family{}, asOf)
+
+	want := []struct {
+		actual string
+		passed bool
+	}{
+		// The applicant turns 65 the day after the date of assessment
+		{actual: "64", passed: false},
+		// The employment status of the applicant is not known
+		{actual: unknownValue, passed: false},
+		// The value of the criterion cannot be parsed
+		{actual: unknownValue, passed: false},
+		// The criterion is not registered
+		{actual: unknownValue, passed: false},
+	}
+
+	if len(result.Criteria) != len(want) {
+		t.Fatalf("got %d criterion results, want %d", len(result.Criteria), len(want))
+	}
+	for i, w := range want {
+		got := result.Criteria[i]
+		if *got.Actual != w.actual || got.Passed != w.passed {
+			t.Errorf("criterion %q = (%q, %v), want (%q, %v)", *got.Name, *got.Actual, got.Passed, w.actual, w.passed)
+		}
+	}
+}
+
+func TestHouseholdCriteria(t *testing.T) {
+	asOf := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	scheme := domain.Scheme{Criteria: &[]domain.SchemeCriteria{
+		{Name: ptr("household_income"), Value: ptr("<=1000")},
+		{Name: ptr("per_capita_income"), Value: ptr("<=400")},
+		{Name: ptr("household_assets"), Value: ptr("<20000")},
+	}}
+
+	tests := []struct {
+		name      string
+		applicant domain.Applicant
+		family    domain.Family
+		want      []string
+		eligible  bool
+	}{
+		{
+			name:      "applicant alone",
+			applicant: domain.Applicant{MonthlyIncome: sgd("900"), Assets: sgd("5000")},
+			family:    domain.Family{},
+			want:      []string{"900.00", "900.00", "5000.00"},
+			eligible:  false,
+		},
+		{
+			name:      "dependants are part of the household",
+			applicant: domain.Applicant{MonthlyIncome: sgd("600"), Assets: sgd("5000")},
+			family: domain.Family{
+				domain.RelationshipTypeSpouse: {{MonthlyIncome: sgd("400"), Assets: sgd("1000.50")}},
+				domain.RelationshipTypeChild:  {{MonthlyIncome: sgd("0")}},
+			},
+			want:     []string{"1000.00", "333.33", "6000.50"},
+			eligible: true,
+		},
+		{
+			name:      "siblings are not part of the household",
+			applicant: domain.Applicant{MonthlyIncome: sgd("300"), Assets: sgd("0")},
+			family: domain.Family{
+				domain.RelationshipTypeSibling: {{MonthlyIncome: sgd("5000"), Assets: sgd("100000")}},
+			},
+			want:     []string{"300.00", "300.00", "0.00"},
+			eligible: true,
+		},
+		{
+			name:      "dependants with unknown amounts count as having none",
+			applicant: domain.Applicant{MonthlyIncome: sgd("800"), Assets: sgd("1000")},
+			family: domain.Family{
+				domain.RelationshipTypeParent: {{}, {}},
+			},
+			want:     []string{"800.00", "266.66", "1000.00"},
+			eligible: true,
+		},
+		{
+			name:      "unknown amounts of the applicant are unknown",
+			applicant: domain.Applicant{},
+			family: domain.Family{
+				domain.RelationshipTypeSpouse: {{MonthlyIncome: sgd("400"), Assets: sgd("1000")}},
+			},
+			want:     []string{unknownValue, unknownValue, unknownValue},
+			eligible: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.applicant.ID = ptr(uuid.New())
+			result := CheckSchemeEligibility(scheme, &tt.applicant, tt.family, asOf)
+
+			if result.Eligible != tt.eligible {
+				t.Errorf("Eligible = %v, want %v", result.Eligible, tt.eligible)
+			}
+			for i, want := range tt.want {
+				if got := result.Criteria[i]; *got.Actual != want {
+					t.Errorf("criterion %q actual = %q, want %q", *got.Name, *got.Actual, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package util
+
+import (
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"strings"
+	"time"
+)
+
+// CriterionEvaluator is a kind of criterion that applicants can be assessed against, such as their age.
+// Criteria are registered by name, and validation, evaluation and the criterion types offered to clients
+// are all derived from the registered criteria.
+type CriterionEvaluator interface {
+	// Describe describes the criterion and the values it accepts.
+	Describe() domain.CriterionType
+
+	// Validate checks if a value can be used with the criterion. It returns a domain.InvalidCriterionValueError
+	// if it cannot.
+	Validate(value string) error
+
+	// Evaluate checks if an applicant with the given family satisfies a value of the criterion as of the given date,
+	// and returns the applicant's actual value for the criterion. Invalid values are never satisfied, and their actual
+	// value is unknown.
+	Evaluate(value string, applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string)
+}
+
+// criterion is a CriterionEvaluator that parses criterion values into conditions of type C before evaluating them.
+type criterion[C any] struct {
+	criterionType domain.CriterionType
+	parse         func(value string) (C, error)
+	evaluate      func(condition C, applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string)
+}
+
+func (c criterion[C]) Describe() domain.CriterionType {
+	return c.criterionType
+}
+
+func (c criterion[C]) Validate(value string) error {
+	if _, err := c.parse(value); err != nil {
+		return &domain.InvalidCriterionValueError{Criterion: c.criterionType}
+	}
+	return nil
+}
+
+func (c criterion[C]) Evaluate(value string, applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string) {
+	condition, err := c.parse(value)
+	if err != nil {
+		return false, unknownValue
+	}
+
+	return c.evaluate(condition, applicant, family, asOf)
+}
+
+var (
+	// criteria maps the name of every registered criterion to its evaluator
+	criteria = map[string]CriterionEvaluator{}
+
+	// criteriaNames lists the names of the registered criteria in the order they were registered
+	criteriaNames []string
+)
+
+// RegisterCriterion makes a criterion available to schemes and benefits under the name it is described with.
+// It panics if the name is empty or already registered. RegisterCriterion is not safe for concurrent use and is
+// meant to be called when the program starts, such as from an init function.
+func RegisterCriterion(evaluator CriterionEvaluator) {
+	name := strings.ToLower(strings.TrimSpace(evaluator.Describe().Name))
+
+	if name == "" {
+		panic("criterion name is empty")
+	}
+
+	if _, exists := criteria[name]; exists {
+		panic(fmt.Sprintf("criterion %s is already registered", name))
+	}
+
+	criteria[name] = evaluator
+	criteriaNames = append(criteriaNames, name)
+}
+
+// LookupCriterion returns the criterion registered under the given name, ignoring case and surrounding whitespace.
+func LookupCriterion(name string) (CriterionEvaluator, bool) {
+	evaluator, ok := criteria[strings.ToLower(strings.TrimSpace(name))]
+	return evaluator, ok
+}
+
+// CriterionNames returns the names of the registered criteria in the order they were registered.
+func CriterionNames() []string {
+	return append([]string(nil), criteriaNames...)
+}
+
+// CriterionTypes describes the registered criteria in the order they were registered.
+func CriterionTypes() []domain.CriterionType {
+	criterionTypes := make([]domain.CriterionType, 0, len(criteriaNames))
+
+	for _, name := range criteriaNames {
+		criterionTypes = append(criterionTypes, criteria[name].Describe())
+	}
+
+	return criterionTypes
+}
+
+// JoinAlternatives joins values into a human-readable list of alternatives (e.g., "single, married or widowed").
+func JoinAlternatives(values []string) string {
+	if len(values) < 2 {
+		return strings.Join(values, "")
+	}
+
+	return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
+}
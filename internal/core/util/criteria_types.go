@@ -0,0 +1,301 @@
+package util
+
+import (
+	"fmt"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formats of the values accepted by comparison and boolean criteria
+const (
+	numberComparisonFormat = "an operator followed by a whole number, where the operator is one of >, >=, <, <= or =="
+	amountComparisonFormat = "an operator followed by an amount, where the operator is one of >, >=, <, <= or =="
+	booleanFormat          = "either true or false"
+)
+
+// householdRule describes how household criteria treat unknown amounts
+const householdRule = "Siblings are not part of the household. Dependants whose amount is not recorded are counted as having none, " +
+	"and the criterion fails if the applicant's own amount is not recorded."
+
+func init() {
+	RegisterCriterion(attributeCriterion(
+		"employment_status",
+		"Employment status of the applicant.",
+		[]string{string(domain.EmploymentStatusEmployed), string(domain.EmploymentStatusUnemployed)},
+		[]string{"unemployed", "!employed"},
+		func(applicant *domain.Applicant) (string, bool) {
+			if applicant.EmploymentStatus == nil {
+				return "", false
+			}
+			return string(*applicant.EmploymentStatus), true
+		},
+	))
+	RegisterCriterion(attributeCriterion(
+		"marital_status",
+		"Marital status of the applicant.",
+		[]string{
+			string(domain.MaritalStatusSingle),
+			string(domain.MaritalStatusMarried),
+			string(domain.MaritalStatusWidowed),
+			string(domain.MaritalStatusDivorce),
+		},
+		[]string{"single,widowed,divorce", "!married"},
+		func(applicant *domain.Applicant) (string, bool) {
+			if applicant.MaritalStatus == nil {
+				return "", false
+			}
+			return string(*applicant.MaritalStatus), true
+		},
+	))
+	RegisterCriterion(attributeCriterion(
+		"sex",
+		"Sex of the applicant.",
+		[]string{string(domain.SexMale), string(domain.SexFemale)},
+		[]string{"female", "!male"},
+		func(applicant *domain.Applicant) (string, bool) {
+			if applicant.Sex == nil {
+				return "", false
+			}
+			return string(*applicant.Sex), true
+		},
+	))
+	RegisterCriterion(hasRelationshipCriterion())
+	RegisterCriterion(familyCriterion(
+		"has_children",
+		"Whether the applicant must have at least one child. Applicants without children also meet the criterion when it is false.",
+		func(family domain.Family, asOf time.Time) bool {
+			return family.Count(domain.RelationshipTypeChild) > 0
+		},
+	))
+	RegisterCriterion(familyCriterion(
+		"has_primary_school_children",
+		fmt.Sprintf("Whether the applicant must have at least one child aged %d to %d. Applicants without such children also meet the criterion when it is false.", primarySchoolAgeBand.Min, primarySchoolAgeBand.Max),
+		func(family domain.Family, asOf time.Time) bool {
+			ages, _ := memberAges(family[domain.RelationshipTypeChild], asOf)
+			return slices.ContainsFunc(ages, primarySchoolAgeBand.Contains)
+		},
+	))
+	RegisterCriterion(criterion[*Comparison[int]]{
+		criterionType: domain.CriterionType{
+			Name:        "number_of_children",
+			Description: "Number of children linked to the applicant.",
+			Format:      numberComparisonFormat,
+			Examples:    []string{">=3", "==0"},
+		},
+		parse: ParseNumberComparison,
+		evaluate: func(condition *Comparison[int], applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string) {
+			children := family.Count(domain.RelationshipTypeChild)
+			return condition.Matches(children), strconv.Itoa(children)
+		},
+	})
+	RegisterCriterion(criterion[*AgeBand]{
+		criterionType: domain.CriterionType{
+			Name:        "has_children_aged",
+			Description: "Requires the applicant to have at least one child whose age as of the date of assessment is within the band, inclusive.",
+			Format:      "a minimum and a maximum age in whole numbers separated by a hyphen",
+			Examples:    []string{"0-6", "13-18"},
+		},
+		parse: ParseAgeBand,
+		evaluate: func(condition *AgeBand, applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string) {
+			ages, actual := memberAges(family[domain.RelationshipTypeChild], asOf)
+			return slices.ContainsFunc(ages, condition.Contains), actual
+		},
+	})
+	RegisterCriterion(criterion[*Comparison[int]]{
+		criterionType: domain.CriterionType{
+			Name:        "has_parent_aged",
+			Description: "Requires the applicant to have at least one parent whose age as of the date of assessment satisfies the comparison.",
+			Format:      numberComparisonFormat,
+			Examples:    []string{">=65", ">80"},
+		},
+		parse: ParseNumberComparison,
+		evaluate: func(condition *Comparison[int], applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string) {
+			ages, actual := memberAges(family[domain.RelationshipTypeParent], asOf)
+			return slices.ContainsFunc(ages, condition.Matches), actual
+		},
+	})
+	RegisterCriterion(familyCriterion(
+		"has_disabled_dependant",
+		"Whether the applicant must have a spouse, child or parent with a disability. Applicants without such dependants also meet the criterion when it is false.",
+		func(family domain.Family, asOf time.Time) bool {
+			return slices.ContainsFunc(family.Dependants(), func(dependant domain.Applicant) bool {
+				return dependant.HasDisability != nil && *dependant.HasDisability
+			})
+		},
+	))
+	RegisterCriterion(criterion[*Comparison[int]]{
+		criterionType: domain.CriterionType{
+			Name:        "age",
+			Description: "Age of the applicant in years as of the date of assessment.",
+			Format:      numberComparisonFormat,
+			Examples:    []string{">=65", "<21"},
+		},
+		parse: ParseNumberComparison,
+		evaluate: func(condition *Comparison[int], applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string) {
+			age, ok := applicantAge(applicant, asOf)
+			if !ok {
+				return false, unknownValue
+			}
+
+			return condition.Matches(age), strconv.Itoa(age)
+		},
+	})
+	RegisterCriterion(amountCriterion(
+		"individual_income",
+		"Monthly income of the applicant.",
+		func(applicant *domain.Applicant, family domain.Family) (domain.Money, bool) {
+			if applicant.MonthlyIncome == nil {
+				return domain.Money{}, false
+			}
+			return *applicant.MonthlyIncome, true
+		},
+	))
+	RegisterCriterion(amountCriterion(
+		"household_income",
+		"Total monthly income of the household, made up of the applicant and their spouse, children and parents. "+householdRule,
+		func(applicant *domain.Applicant, family domain.Family) (domain.Money, bool) {
+			income, _, ok := householdTotal(applicant, family, monthlyIncome)
+			return income, ok
+		},
+	))
+	RegisterCriterion(amountCriterion(
+		"per_capita_income",
+		"Household monthly income divided by the number of people in the household, rounded down to the cent. "+householdRule,
+		func(applicant *domain.Applicant, family domain.Family) (domain.Money, bool) {
+			income, size, ok := householdTotal(applicant, family, monthlyIncome)
+			if !ok {
+				return domain.Money{}, false
+			}
+			return domain.Money{Cents: income.Cents / int64(size), Currency: income.Currency}, true
+		},
+	))
+	RegisterCriterion(amountCriterion(
+		"household_assets",
+		"Total assets of the household, made up of the applicant and their spouse, children and parents. "+householdRule,
+		func(applicant *domain.Applicant, family domain.Family) (domain.Money, bool) {
+			total, _, ok := householdTotal(applicant, family, assets)
+			return total, ok
+		},
+	))
+}
+
+// setFormat describes the values accepted by a criterion that matches a set of values.
+func setFormat(values []string) string {
+	return fmt.Sprintf("a comma separated list of %s, optionally prefixed with %s to negate", JoinAlternatives(values), negationPrefix)
+}
+
+// parseSetConditionOf returns a function that parses set conditions of the given values.
+func parseSetConditionOf(values []string) func(string) (*SetCondition, error) {
+	return func(condition string) (*SetCondition, error) {
+		return ParseSetCondition(condition, func(value string) bool {
+			return slices.Contains(values, value)
+		})
+	}
+}
+
+// attributeCriterion creates a criterion that matches an attribute of the applicant, such as their sex,
+// against a set of values. The criterion fails if the attribute is unknown.
+func attributeCriterion(name string, description string, values []string, examples []string, attribute func(*domain.Applicant) (string, bool)) CriterionEvaluator {
+	return criterion[*SetCondition]{
+		criterionType: domain.CriterionType{
+			Name:        name,
+			Description: description,
+			Format:      setFormat(values),
+			Values:      values,
+			Examples:    examples,
+		},
+		parse: parseSetConditionOf(values),
+		evaluate: func(condition *SetCondition, applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string) {
+			actual, ok := attribute(applicant)
+			if !ok {
+				return false, unknownValue
+			}
+
+			return condition.Matches(actual), actual
+		},
+	}
+}
+
+// hasRelationshipCriterion creates the criterion that matches the relationship types the applicant has
+// at least one family member for.
+func hasRelationshipCriterion() CriterionEvaluator {
+	values := []string{
+		string(domain.RelationshipTypeSpouse),
+		string(domain.RelationshipTypeChild),
+		string(domain.RelationshipTypeParent),
+		string(domain.RelationshipTypeSibling),
+	}
+
+	return criterion[*SetCondition]{
+		criterionType: domain.CriterionType{
+			Name:        "has_relationship",
+			Description: "Relationship types the applicant has at least one family member for. Any of the listed types is enough.",
+			Format:      setFormat(values),
+			Values:      values,
+			Examples:    []string{"spouse,parent", "!spouse"},
+		},
+		parse: parseSetConditionOf(values),
+		evaluate: func(condition *SetCondition, applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string) {
+			// Collect the relationship types the applicant has at least one family member for
+			var relationshipTypes []string
+			for relationshipType, members := range family {
+				if len(members) > 0 {
+					relationshipTypes = append(relationshipTypes, string(relationshipType))
+				}
+			}
+			sort.Strings(relationshipTypes)
+
+			actual := strings.Join(relationshipTypes, ",")
+			if actual == "" {
+				actual = "none"
+			}
+
+			return condition.MatchesAny(relationshipTypes), actual
+		},
+	}
+}
+
+// familyCriterion creates a criterion that, when true, requires the applicant's family to have a property,
+// such as including children. The criterion is always met when false.
+func familyCriterion(name string, description string, has func(family domain.Family, asOf time.Time) bool) CriterionEvaluator {
+	return criterion[bool]{
+		criterionType: domain.CriterionType{
+			Name:        name,
+			Description: description,
+			Format:      booleanFormat,
+			Values:      []string{"true", "false"},
+			Examples:    []string{"true"},
+		},
+		parse: ParseBooleanCondition,
+		evaluate: func(condition bool, applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string) {
+			actual := has(family, asOf)
+			return !condition || actual, strconv.FormatBool(actual)
+		},
+	}
+}
+
+// amountCriterion creates a criterion that compares an amount of money, such as the household income, with an operator.
+// The criterion fails if the amount is unknown.
+func amountCriterion(name string, description string, amount func(applicant *domain.Applicant, family domain.Family) (domain.Money, bool)) CriterionEvaluator {
+	return criterion[*Comparison[int64]]{
+		criterionType: domain.CriterionType{
+			Name:        name,
+			Description: description,
+			Format:      amountComparisonFormat,
+			Examples:    []string{"<=1500", "<500.50"},
+		},
+		parse: ParseAmountComparison,
+		evaluate: func(condition *Comparison[int64], applicant *domain.Applicant, family domain.Family, asOf time.Time) (bool, string) {
+			actual, ok := amount(applicant, family)
+			if !ok {
+				return false, unknownValue
+			}
+
+			return condition.Matches(actual.Cents), actual.String()
+		},
+	}
+}
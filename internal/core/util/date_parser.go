@@ -1 +1,32 @@
 package util
+
+import (
+	"time"
+)
+
+// DateLayout is the layout of dates accepted and returned by the API (e.g., "2006-01-02")
+const DateLayout = "2006-01-02"
+
+// ParseDate parses a date string in the DateLayout format.
+func ParseDate(value string) (time.Time, error) {
+	return time.Parse(DateLayout, value)
+}
+
+// Today returns the current date, without a time of day.
+func Today() time.Time {
+	year, month, day := time.Now().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// AgeOn returns the age in completed years of someone born on dateOfBirth as of the given date.
+// The age only increases once the birthday has been reached in that year.
+func AgeOn(dateOfBirth time.Time, asOf time.Time) int {
+	age := asOf.Year() - dateOfBirth.Year()
+
+	// Birthday has not been reached yet this year
+	if asOf.Month() < dateOfBirth.Month() || (asOf.Month() == dateOfBirth.Month() && asOf.Day() < dateOfBirth.Day()) {
+		age--
+	}
+
+	return age
+}
@@ -0,0 +1,74 @@
+package util
+
+import (
+	"time"
+
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+)
+
+// SchedulePayouts returns the disbursements of a benefit, the first of which is due on start.
+// One-off benefits are paid once, while monthly and quarterly benefits are paid every interval for their duration.
+// Each installment pays the benefit amount, limited by whatever remains of the cap, and the schedule ends once
+// the cap is exhausted. Returns domain.MissingBenefitAmountError if the benefit has no amount, and
+// domain.InvalidBenefitScheduleError if it is recurring without a duration.
+func SchedulePayouts(benefit domain.Benefit, start time.Time) ([]domain.Disbursement, error) {
+	if benefit.Amount == nil {
+		return nil, domain.MissingBenefitAmountError
+	}
+	amount := *benefit.Amount
+
+	frequency := domain.BenefitFrequencyOneOff
+	if benefit.Frequency != nil {
+		frequency = *benefit.Frequency
+	}
+
+	installments := 1
+	interval := frequency.IntervalMonths()
+	if interval > 0 {
+		if benefit.DurationMonths == nil || *benefit.DurationMonths <= 0 {
+			return nil, domain.InvalidBenefitScheduleError
+		}
+
+		// A partial interval at the end of the duration still pays out
+		installments = (*benefit.DurationMonths + interval - 1) / interval
+	}
+
+	var remaining int64
+	if benefit.Cap != nil {
+		remaining = benefit.Cap.Cents
+	}
+
+	status := domain.DisbursementStatusScheduled
+	disbursements := make([]domain.Disbursement, 0, installments)
+	for i := 0; i < installments; i++ {
+		payout := amount
+		if benefit.Cap != nil {
+			if remaining <= 0 {
+				break
+			}
+			payout.Cents = min(payout.Cents, remaining)
+			remaining -= payout.Cents
+		}
+
+		installment := i + 1
+		dueDate := addMonths(start, interval*i)
+		disbursements = append(disbursements, domain.Disbursement{
+			BenefitID:   benefit.ID,
+			BenefitName: benefit.Name,
+			Installment: &installment,
+			Amount:      &payout,
+			DueDate:     &dueDate,
+			Status:      &status,
+		})
+	}
+
+	return disbursements, nil
+}
+
+// addMonths adds months to a date, moving to the last day of the month when the day does not exist in it
+// (e.g., 31 January plus one month is 28 or 29 February).
+func addMonths(date time.Time, months int) time.Time {
+	firstOfMonth := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, date.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	return firstOfMonth.AddDate(0, 0, min(date.Day(), lastDay)-1)
+}
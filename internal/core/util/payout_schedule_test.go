@@ -0,0 +1,80 @@
+package util
+
+import (
+	"errors"
+	"github.com/cxnub/fas-mgmt-system/internal/core/domain"
+	"testing"
+	"time"
+)
+
+func TestSchedulePayouts(t *testing.T) {
+	start := time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		benefit domain.Benefit
+		want    []string
+		wantErr error
+	}{
+		{
+			name:    "one-off",
+			benefit: domain.Benefit{Amount: sgd("150.00")},
+			want:    []string{"2025-01-31 150.00"},
+		},
+		{
+			name:    "monthly, ends on the last day of shorter months",
+			benefit: domain.Benefit{Amount: sgd("100.00"), Frequency: ptr(domain.BenefitFrequencyMonthly), DurationMonths: ptr(3)},
+			want:    []string{"2025-01-31 100.00", "2025-02-28 100.00", "2025-03-31 100.00"},
+		},
+		{
+			name:    "quarterly, partial quarter still pays out",
+			benefit: domain.Benefit{Amount: sgd("300.00"), Frequency: ptr(domain.BenefitFrequencyQuarterly), DurationMonths: ptr(4)},
+			want:    []string{"2025-01-31 300.00", "2025-04-30 300.00"},
+		},
+		{
+			name: "cap limits the last installment",
+			benefit: domain.Benefit{Amount: sgd("100.00"), Frequency: ptr(domain.BenefitFrequencyMonthly), DurationMonths: ptr(12),
+				Cap: sgd("250.00")},
+			want: []string{"2025-01-31 100.00", "2025-02-28 100.00", "2025-03-31 50.00"},
+		},
+		{
+			name:    "no amount",
+			benefit: domain.Benefit{Frequency: ptr(domain.BenefitFrequencyOneOff)},
+			wantErr: domain.MissingBenefitAmountError,
+		},
+		{
+			name:    "recurring without duration",
+			benefit: domain.Benefit{Amount: sgd("100.00"), Frequency: ptr(domain.BenefitFrequencyMonthly)},
+			wantErr: domain.InvalidBenefitScheduleError,
+		},
+		{
+			name:    "recurring with zero duration",
+			benefit: domain.Benefit{Amount: sgd("100.00"), Frequency: ptr(domain.BenefitFrequencyQuarterly), DurationMonths: ptr(0)},
+			wantErr: domain.InvalidBenefitScheduleError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			disbursements, err := SchedulePayouts(tt.benefit, start)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SchedulePayouts() error = %v, want %v", err, tt.wantErr)
+			}
+
+			got := make([]string, 0, len(disbursements))
+			for _, d := range disbursements {
+				got = append(got, d.DueDate.Format(time.DateOnly)+" "+d.Amount.String())
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("SchedulePayouts() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("SchedulePayouts() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}